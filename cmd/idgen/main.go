@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jessevdk/go-flags"
+
+	"github.com/spkaeros/rscgo/pkg/config"
+	"github.com/spkaeros/rscgo/pkg/db"
+)
+
+//idgen generates the constant tables in pkg/ids from the item, NPC, scenery and boundary definitions in the entity
+// database.  It is ran through go generate:
+//
+// Example: go generate ./pkg/ids
+
+var cliFlags struct {
+	Driver string `long:"driver" description:"The database/sql driver of the entity database" default:"sqlite3"`
+	DB     string `long:"db" description:"The address of the entity database" default:"file:./data/world.db"`
+	Out    string `short:"o" long:"out" description:"The directory to write the generated files into" default:"./pkg/ids"`
+}
+
+//table A set of definitions to generate constants for.
+type table struct {
+	// file is the name of the file the table is written to, and kind names the things in it.
+	file, kind string
+	// prefix is put in front of every constant name, so that tables can share a package.
+	prefix string
+	// mapName is the name of the map from unprefixed constant names to ids.
+	mapName string
+	names   []string
+}
+
+func main() {
+	if _, err := flags.Parse(&cliFlags); err != nil {
+		os.Exit(1)
+	}
+	// The sql services share one connection, which is opened from the player database settings.
+	config.TomlConfig.Database.WorldDriver = cliFlags.Driver
+	config.TomlConfig.Database.WorldDB = cliFlags.DB
+	config.TomlConfig.Database.PlayerDriver = cliFlags.Driver
+	config.TomlConfig.Database.PlayerDB = cliFlags.DB
+	db.ConnectEntityService()
+	s := db.DefaultEntityService
+
+	tables := []table{{file: "items.go", kind: "item", mapName: "Items"},
+		{file: "npcs.go", kind: "NPC", prefix: "NPC_", mapName: "Npcs"},
+		{file: "objects.go", kind: "scenery object", prefix: "OBJECT_", mapName: "Objects"},
+		{file: "boundaries.go", kind: "boundary", prefix: "BOUNDARY_", mapName: "Boundaries"}}
+	for _, def := range s.Items() {
+		tables[0].names = append(tables[0].names, def.Name)
+	}
+	for _, def := range s.Npcs() {
+		tables[1].names = append(tables[1].names, def.Name)
+	}
+	for _, def := range s.Objects() {
+		tables[2].names = append(tables[2].names, def.Name)
+	}
+	for _, def := range s.Boundarys() {
+		tables[3].names = append(tables[3].names, def.Name)
+	}
+	for _, t := range tables {
+		if len(t.names) == 0 {
+			fmt.Printf("No %s definitions found in %s\n", t.kind, cliFlags.DB)
+			os.Exit(1)
+		}
+		if err := write(t); err != nil {
+			fmt.Println("Error generating", t.file+":", err)
+			os.Exit(1)
+		}
+	}
+}
+
+var nonWord = regexp.MustCompile("[^A-Za-z0-9]+")
+
+//constName Returns name as an upper case, underscore separated identifier.  Names that do not start with a letter are
+// given the prefix N, e.g N1ST_PLAGUE_SHEEP.
+func constName(name string) string {
+	name = strings.ToUpper(strings.Trim(nonWord.ReplaceAllString(name, "_"), "_"))
+	if name != "" && (name[0] < 'A' || name[0] > 'Z') {
+		name = "N" + name
+	}
+	return name
+}
+
+//constNames Returns a unique constant name for each of names, which are ordered by id.  Where names collide, the one
+// with the lowest id keeps the plain name, and the rest have their id appended.  New definitions only ever get added
+// with higher ids, so the name of an existing id never changes.
+func constNames(names []string) []string {
+	taken := make(map[string]bool, len(names))
+	consts := make([]string, len(names))
+	for id, name := range names {
+		c := constName(name)
+		if c == "" {
+			continue
+		}
+		// a definition can be named just like another's suffixed name, e.g "Foo 5" next to a second "Foo" with id 5, so
+		// keep suffixing until the name is free
+		for taken[c] {
+			c += "_" + strconv.Itoa(id)
+		}
+		taken[c] = true
+		consts[id] = c
+	}
+	return consts
+}
+
+func write(t table) error {
+	consts := constNames(t.names)
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by idgen from the entity database. DO NOT EDIT.\n\npackage ids\n\n")
+	fmt.Fprintf(&buf, "// The ids of every %s definition.\nconst (\n", t.kind)
+	for id, c := range consts {
+		if c != "" {
+			fmt.Fprintf(&buf, "\t%s%s = %d // %s\n", t.prefix, c, id, t.names[id])
+		}
+	}
+	fmt.Fprintf(&buf, ")\n\n//%s Maps the name of every %s constant, without any prefix, to its id.\nvar %s = map[string]int{\n", t.mapName, t.kind, t.mapName)
+	for _, c := range consts {
+		if c != "" {
+			fmt.Fprintf(&buf, "\t%q: %s%s,\n", c, t.prefix, c)
+		}
+	}
+	fmt.Fprintf(&buf, "}\n")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(cliFlags.Out, t.file), src, 0644)
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/jessevdk/go-flags"
+
+	"github.com/spkaeros/rscgo/pkg/config"
+	"github.com/spkaeros/rscgo/pkg/db"
+	"github.com/spkaeros/rscgo/pkg/definitions"
+	"github.com/spkaeros/rscgo/pkg/strutil"
+)
+
+//ledger is a small tool to trace where a players items came from, using the item provenance ledger.
+//
+// Example: ledger -u zezima -i 10 -d 5
+
+var cliFlags struct {
+	Username string `short:"u" long:"user" description:"The account holding the items to trace" required:"true"`
+	ItemID   int    `short:"i" long:"item" description:"The item ID to trace" required:"true"`
+	Depth    int    `short:"d" long:"depth" description:"How many hops back to follow the items" default:"5"`
+	DbioDefs string `short:"c" long:"config" description:"The database configuration file to load" default:"./data/dbio.conf"`
+}
+
+func main() {
+	if _, err := flags.Parse(&cliFlags); err != nil {
+		os.Exit(1)
+	}
+	config.TomlConfig.Database.PlayerDriver = "sqlite3"
+	config.TomlConfig.Database.PlayerDB = "file:./data/players.db"
+	if _, err := toml.DecodeFile(cliFlags.DbioDefs, &config.TomlConfig.Database); err != nil {
+		fmt.Println("Error reading database config file:", err)
+		os.Exit(1)
+	}
+
+	nodes := db.NewLedgerServiceSql().LedgerTrace(strutil.Base37.Decode(strutil.Base37.Encode(cliFlags.Username)), cliFlags.ItemID, cliFlags.Depth)
+	if len(nodes) == 0 {
+		fmt.Printf("No ledger entries found moving item %d to %s\n", cliFlags.ItemID, cliFlags.Username)
+		return
+	}
+	printNodes(nodes, 0)
+}
+
+func account(name string) string {
+	if len(name) == 0 {
+		return "<world>"
+	}
+	return name
+}
+
+func printNodes(nodes []*db.LedgerNode, indent int) {
+	for _, n := range nodes {
+		name := fmt.Sprintf("item %d", n.ItemID)
+		if n.ItemID >= 0 && n.ItemID < len(definitions.Items) {
+			name = definitions.Items[n.ItemID].Name
+		}
+		fmt.Printf("%s[%s] %s: %dx %s from %s to %s at (%d,%d) tick %d\n", strings.Repeat("  ", indent),
+			n.Time.Format("2006-01-02 15:04:05"), n.Event, n.Amount, name, account(n.From), account(n.To), n.X, n.Y, n.Tick)
+		printNodes(n.Sources, indent+1)
+	}
+}
@@ -21,6 +21,20 @@ var TomlConfig struct {
 		HashMemory     int    `toml:"hash_memory"`
 		HashLength     int    `toml:"hash_length"`
 	} `toml:"crypto"`
+	Death struct {
+		Policy     string  `toml:"policy"`
+		Gravestone int     `toml:"gravestone"`
+		SafeZones  [][]int `toml:"safe_zones"`
+	} `toml:"death"`
+	Pvp struct {
+		Preset     string  `toml:"preset"`
+		MultiZones [][]int `toml:"multi_zones"`
+	} `toml:"pvp"`
+	Scripts struct {
+		Steps   int64    `toml:"steps"`
+		Timeout int      `toml:"timeout"`
+		Imports []string `toml:"imports"`
+	} `toml:"scripts"`
 }
 
 //Verbosity Represents the level of verbosity with which the game should output debug information.
@@ -88,3 +102,46 @@ func PlayerDriver() string {
 func WorldDriver() string {
 	return TomlConfig.Database.WorldDriver
 }
+
+//DeathPolicy Returns the name of the rules that decide what players keep when they die.
+func DeathPolicy() string {
+	return TomlConfig.Death.Policy
+}
+
+//Gravestone Returns how many seconds a dead players items are held for them before anyone else may take them.
+// 0 means no gravestone.
+func Gravestone() int {
+	return TomlConfig.Death.Gravestone
+}
+
+//PvpPreset Returns the name of the rules deciding when players may attack each other.
+func PvpPreset() string {
+	return TomlConfig.Pvp.Preset
+}
+
+//MultiZones Returns the areas in which players may be attacked by more than one player at once, as
+// [minX, minY, maxX, maxY] lists.
+func MultiZones() [][]int {
+	return TomlConfig.Pvp.MultiZones
+}
+
+//SafeZones Returns the areas in which players keep everything when they die, as [minX, minY, maxX, maxY] lists.
+func SafeZones() [][]int {
+	return TomlConfig.Death.SafeZones
+}
+
+//ScriptSteps Returns how many statements a single call into a script may run before it is interrupted.  0 means no
+// limit.
+func ScriptSteps() int64 {
+	return TomlConfig.Scripts.Steps
+}
+
+//ScriptTimeout Returns how many seconds a script file may take to run from top to bottom.  0 means no limit.
+func ScriptTimeout() int {
+	return TomlConfig.Scripts.Timeout
+}
+
+//ScriptImports Returns the names of the packages that scripts are allowed to import.
+func ScriptImports() []string {
+	return TomlConfig.Scripts.Imports
+}
@@ -0,0 +1,111 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/spkaeros/rscgo/pkg/config"
+	"github.com/spkaeros/rscgo/pkg/game/world"
+	"github.com/spkaeros/rscgo/pkg/log"
+)
+
+//LedgerService An interface for writing to and tracing through the item provenance ledger.
+type LedgerService interface {
+	LedgerWrite([]world.LedgerEntry)
+	LedgerTrace(username string, itemID int, depth int) []*LedgerNode
+}
+
+//LedgerNode A ledger entry along with the entries that explain where its items came from.
+type LedgerNode struct {
+	world.LedgerEntry
+	Sources []*LedgerNode
+}
+
+//NewLedgerServiceSql Returns a new LedgerService backed by the default players database.
+func NewLedgerServiceSql() LedgerService {
+	s := newSqlService(config.PlayerDriver())
+	s.sqlOpen(config.PlayerDB())
+	return dbConn
+}
+
+//LedgerWrite Inserts the provided ledger entries into the item_ledger table within a single transaction.
+func (s *sqlService) LedgerWrite(entries []world.LedgerEntry) {
+	db := s.connect(context.Background())
+	if db == nil {
+		log.Warn("LedgerWrite(): Could not connect to database; dropped", len(entries), "entries")
+		return
+	}
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		log.Warn("LedgerWrite(): Could not begin transaction:", err)
+		return
+	}
+	for _, e := range entries {
+		if _, err := tx.Exec("INSERT INTO item_ledger(event, from_user, to_user, item_id, amount, x, y, tick, time) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+			e.Event, e.From, e.To, e.ItemID, e.Amount, e.X, e.Y, e.Tick, e.Time.UnixNano()); err != nil {
+			log.Warn("LedgerWrite(): INSERT failed for ledger entry:", err)
+			if err := tx.Rollback(); err != nil {
+				log.Warn("LedgerWrite(): Transaction rollback failed:", err)
+			}
+			return
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		log.Warn("LedgerWrite(): Error committing transaction:", err)
+	}
+}
+
+//LedgerTrace Traces where the given users stacks of itemID came from, walking backwards through the ledger up to depth
+// hops.  Items that came off the ground are traced to whoever put them on the ground at that location.
+func (s *sqlService) LedgerTrace(username string, itemID int, depth int) []*LedgerNode {
+	return s.ledgerSources(username, itemID, -1, -1, time.Now().UnixNano(), depth)
+}
+
+//ledgerSources returns every ledger entry that moved itemID to the account named to before the provided time.
+// When to is the game world, x and y narrow the search to entries that placed items on that tile.
+func (s *sqlService) ledgerSources(to string, itemID, x, y int, before int64, depth int) []*LedgerNode {
+	if depth <= 0 {
+		return nil
+	}
+	db := s.connect(context.Background())
+	if db == nil {
+		return nil
+	}
+	query := "SELECT event, from_user, to_user, amount, x, y, tick, time FROM item_ledger WHERE to_user=$1 AND item_id=$2 AND time<$3 ORDER BY time DESC"
+	args := []interface{}{to, itemID, before}
+	if len(to) == 0 && x >= 0 && y >= 0 {
+		query = "SELECT event, from_user, to_user, amount, x, y, tick, time FROM item_ledger WHERE to_user=$1 AND item_id=$2 AND time<$3 AND x=$4 AND y=$5 ORDER BY time DESC"
+		args = append(args, x, y)
+	}
+	rows, err := db.QueryContext(context.Background(), query, args...)
+	if err != nil {
+		log.Warn("LedgerTrace(): Could not query ledger entries:", err)
+		return nil
+	}
+	var nodes []*LedgerNode
+	var times []int64
+	for rows.Next() {
+		node := &LedgerNode{LedgerEntry: world.LedgerEntry{ItemID: itemID}}
+		var stamp int64
+		if err := rows.Scan(&node.Event, &node.From, &node.To, &node.Amount, &node.X, &node.Y, &node.Tick, &stamp); err != nil {
+			log.Warn("LedgerTrace(): Could not scan ledger entry:", err)
+			continue
+		}
+		node.Time = time.Unix(0, stamp)
+		nodes = append(nodes, node)
+		times = append(times, stamp)
+	}
+	rows.Close()
+	for i, node := range nodes {
+		if len(node.From) == 0 && node.Event != world.LedgerPickup {
+			// Items that entered the game from nowhere, e.g NPC drops, have no further history.
+			continue
+		}
+		if len(to) > 0 && node.From == to {
+			// Bank deposits and withdrawals stay within one account, whose history is already being listed here.
+			continue
+		}
+		node.Sources = s.ledgerSources(node.From, itemID, node.X, node.Y, times[i], depth-1)
+	}
+	return nodes
+}
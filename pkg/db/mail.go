@@ -0,0 +1,138 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/spkaeros/rscgo/pkg/config"
+	"github.com/spkaeros/rscgo/pkg/game/world"
+	"github.com/spkaeros/rscgo/pkg/log"
+)
+
+//NewMailServiceSql Returns a new world.MailService that holds mail in escrow within the default players database.
+func NewMailServiceSql() world.MailService {
+	s := newSqlService(config.PlayerDriver())
+	s.sqlOpen(config.PlayerDB())
+	return dbConn
+}
+
+//MailSend Stores mail and its items in the mail tables within a single transaction.  Returns true if it was stored.
+func (s *sqlService) MailSend(mail *world.Mail) bool {
+	db := s.connect(context.Background())
+	if db == nil {
+		log.Warn("MailSend(): Could not connect to database")
+		return false
+	}
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		log.Warn("MailSend(): Could not begin transaction:", err)
+		return false
+	}
+	var mailID int
+	if config.PlayerDriver() != "postgres" {
+		rs, err := tx.Exec("INSERT INTO mail(sender, recipient, message, sent) VALUES($1, $2, $3, $4)", mail.From, mail.To, mail.Message, mail.Sent.UnixNano())
+		if err != nil {
+			log.Warn("MailSend(): INSERT failed for mail:", err)
+			tx.Rollback()
+			return false
+		}
+		id, err := rs.LastInsertId()
+		if err != nil {
+			log.Warn("MailSend(): Could not retrieve mail ID:", err)
+			tx.Rollback()
+			return false
+		}
+		mailID = int(id)
+	} else if err := tx.QueryRow("INSERT INTO mail(sender, recipient, message, sent) VALUES($1, $2, $3, $4) RETURNING id", mail.From, mail.To, mail.Message, mail.Sent.UnixNano()).Scan(&mailID); err != nil {
+		log.Warn("MailSend(): INSERT failed for mail:", err)
+		tx.Rollback()
+		return false
+	}
+	for _, item := range mail.Items {
+		if _, err := tx.Exec("INSERT INTO mail_items(mailid, itemid, amount) VALUES($1, $2, $3)", mailID, item.ID, item.Amount); err != nil {
+			log.Warn("MailSend(): INSERT failed for mail item:", err)
+			if err := tx.Rollback(); err != nil {
+				log.Warn("MailSend(): Transaction rollback failed:", err)
+			}
+			return false
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		log.Warn("MailSend(): Error committing transaction:", err)
+		return false
+	}
+	mail.ID = mailID
+	return true
+}
+
+//MailPending Returns every piece of mail waiting in escrow for username, oldest first.
+func (s *sqlService) MailPending(username string) []*world.Mail {
+	db := s.connect(context.Background())
+	if db == nil {
+		return nil
+	}
+	rows, err := db.QueryContext(context.Background(), "SELECT id, sender, recipient, message, sent FROM mail WHERE recipient=$1 ORDER BY id", username)
+	if err != nil {
+		log.Warn("MailPending(): Could not query mail:", err)
+		return nil
+	}
+	var mail []*world.Mail
+	for rows.Next() {
+		m := &world.Mail{}
+		var sent int64
+		if err := rows.Scan(&m.ID, &m.From, &m.To, &m.Message, &sent); err != nil {
+			log.Warn("MailPending(): Could not scan mail:", err)
+			continue
+		}
+		m.Sent = time.Unix(0, sent)
+		mail = append(mail, m)
+	}
+	rows.Close()
+	for _, m := range mail {
+		rows, err := db.QueryContext(context.Background(), "SELECT itemid, amount FROM mail_items WHERE mailid=$1", m.ID)
+		if err != nil {
+			log.Warn("MailPending(): Could not query mail items:", err)
+			return nil
+		}
+		for rows.Next() {
+			item := &world.Item{}
+			rows.Scan(&item.ID, &item.Amount)
+			m.Items = append(m.Items, item)
+		}
+		rows.Close()
+	}
+	return mail
+}
+
+//MailDelete Removes the mail with the provided ID and its items from escrow.  Returns true only if the mail was there.
+func (s *sqlService) MailDelete(id int) bool {
+	db := s.connect(context.Background())
+	if db == nil {
+		return false
+	}
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		log.Warn("MailDelete(): Could not begin transaction:", err)
+		return false
+	}
+	rs, err := tx.Exec("DELETE FROM mail WHERE id=$1", id)
+	if err != nil {
+		log.Warn("MailDelete(): DELETE failed for mail:", err)
+		tx.Rollback()
+		return false
+	}
+	if count, err := rs.RowsAffected(); err != nil || count <= 0 {
+		tx.Rollback()
+		return false
+	}
+	if _, err := tx.Exec("DELETE FROM mail_items WHERE mailid=$1", id); err != nil {
+		log.Warn("MailDelete(): DELETE failed for mail items:", err)
+		tx.Rollback()
+		return false
+	}
+	if err := tx.Commit(); err != nil {
+		log.Warn("MailDelete(): Error committing transaction:", err)
+		return false
+	}
+	return true
+}
@@ -93,7 +93,7 @@ func (s *sqlService) PlayerCreate(username, password, ip string) bool {
 		log.Info.Println("PlayerCreate(): Could not insert new player profile information:", err)
 		return false
 	}
-	_, err = tx.Exec("INSERT INTO bank (playerid, itemid, amount) VALUES ($1, 546, 96000), ($1, 373, 96000)", playerID)
+	_, err = tx.Exec("INSERT INTO bank (playerid, itemid, amount, position) VALUES ($1, 546, 96000, 0), ($1, 373, 96000, 1)", playerID)
 	if err != nil {
 		log.Info.Println("PlayerCreate(): Could not insert new player profile information:", err)
 		return false
@@ -337,7 +337,7 @@ func (s *sqlService) PlayerLoad(player *world.Player) bool {
 	loadBank := func() error {
 		database := s.connect(context.Background())
 		// defer database.Close()
-		rows, err := database.QueryContext(context.Background(), "SELECT itemid, amount FROM bank WHERE playerid=$1", player.DatabaseIndex)
+		rows, err := database.QueryContext(context.Background(), "SELECT itemid, amount FROM bank WHERE playerid=$1 ORDER BY position", player.DatabaseIndex)
 		if err != nil {
 			log.Info.Println("Load error: Could not prepare statement:", err)
 			return errors.NewDatabaseError(err.Error())
@@ -346,6 +346,10 @@ func (s *sqlService) PlayerLoad(player *world.Player) bool {
 		for rows.Next() {
 			var id, amt int
 			rows.Scan(&id, &amt)
+			if amt == 0 {
+				player.Bank().Placeholder(-1, id)
+				continue
+			}
 			player.Bank().Add(id, amt)
 		}
 		return nil
@@ -549,8 +553,8 @@ func (s *sqlService) PlayerSave(player *world.Player) {
 			log.Info.Println("Save(): Affected nothing for item insertion!")
 		}
 	}
-	insertBank := func(id, amt int) {
-		rs, _ := tx.Exec("INSERT INTO bank(playerid, itemid, amount) VALUES($1, $2, $3)", player.DatabaseIndex, id, amt)
+	insertBank := func(id, amt, position int) {
+		rs, _ := tx.Exec("INSERT INTO bank(playerid, itemid, amount, position) VALUES($1, $2, $3, $4)", player.DatabaseIndex, id, amt, position)
 		count, err := rs.RowsAffected()
 		if err != nil {
 			log.Warning.Println("Save(): INSERT failed for player bank items:", err)
@@ -612,8 +616,10 @@ func (s *sqlService) PlayerSave(player *world.Player) {
 		insertItem(item.ID, item.Amount, item.Worn)
 		return true
 	})
+	position := 0
 	player.Bank().Range(func(item *world.Item) bool {
-		insertBank(item.ID, item.Amount)
+		insertBank(item.ID, item.Amount, position)
+		position++
 		return true
 	})
 
@@ -0,0 +1,141 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/spkaeros/rscgo/pkg/config"
+	"github.com/spkaeros/rscgo/pkg/game/world"
+	"github.com/spkaeros/rscgo/pkg/log"
+)
+
+//NewShopServiceSql Returns a new world.ShopService that keeps live shop stock levels in the world database.
+func NewShopServiceSql() world.ShopService {
+	s := newSqlService(config.WorldDriver())
+	s.sqlOpen(config.WorldDB())
+	return s
+}
+
+//LoadShops Loads every shop definition, along with its base stock, from the world database and adds them to the game.
+func LoadShops() {
+	database := DefaultEntityService.sqlOpen(config.WorldDB())
+	rows, err := database.Query("SELECT id, name, general, buy_percent, sell_percent, restock, restock_step, decay_step, price_model, price_rate, price_limit FROM shops ORDER BY id")
+	if err != nil {
+		log.Warn("Couldn't load shop definitions:", err)
+		return
+	}
+	var shops []*world.Shop
+	ids := make(map[int]*world.Shop)
+	for rows.Next() {
+		var id, buyPercent, sellPercent, restock, step, decay, rate, limit int
+		var name, model string
+		var general bool
+		if err := rows.Scan(&id, &name, &general, &buyPercent, &sellPercent, &restock, &step, &decay, &model, &rate, &limit); err != nil {
+			log.Warn("Couldn't load shop definition:", err)
+			continue
+		}
+		shop := world.NewShop(buyPercent, sellPercent, nil, name)
+		shop.BuysUnstocked = general
+		switch model {
+		case "curve":
+			shop.Model = &world.CurveShopModel{Ticks: restock, Step: step, Decay: decay, Elasticity: rate, Limit: limit}
+		default:
+			if model != "classic" {
+				log.Warn("Unknown price model '" + model + "' for shop '" + name + "'; using classic")
+			}
+			shop.Model = &world.ClassicShopModel{Ticks: restock, Step: step, Decay: decay, DeltaRate: rate, ItemRates: make(map[int]int)}
+		}
+		ids[id] = shop
+		shops = append(shops, shop)
+	}
+	rows.Close()
+
+	rows, err = database.Query("SELECT storeid, itemid, amount, price_delta FROM shop_items")
+	if err != nil {
+		log.Warn("Couldn't load shop stock:", err)
+		return
+	}
+	for rows.Next() {
+		var storeID, itemID, amount, delta int
+		if err := rows.Scan(&storeID, &itemID, &amount, &delta); err != nil {
+			log.Warn("Couldn't load shop stock:", err)
+			continue
+		}
+		if shop, ok := ids[storeID]; ok {
+			shop.Stock.Add(&world.Item{ID: itemID, Amount: amount})
+			shop.Inventory.Add(&world.Item{ID: itemID, Amount: amount})
+			if model, ok := shop.Model.(*world.ClassicShopModel); ok && delta >= 0 {
+				model.ItemRates[itemID] = delta
+			}
+		}
+	}
+	rows.Close()
+
+	for _, shop := range shops {
+		world.AddShop(shop)
+	}
+}
+
+//ShopStock Returns the persisted live stock of the named shop, or nil if it has never been saved.
+func (s *sqlService) ShopStock(name string) []*world.Item {
+	if s.database == nil {
+		return nil
+	}
+	rows, err := s.database.Query("SELECT itemid, amount FROM shop_stock WHERE shop=$1", name)
+	if err != nil {
+		log.Warn("Couldn't load live shop stock:", err)
+		return nil
+	}
+	defer rows.Close()
+	var items []*world.Item
+	for rows.Next() {
+		item := &world.Item{}
+		if err := rows.Scan(&item.ID, &item.Amount); err != nil {
+			log.Warn("Couldn't load live shop stock:", err)
+			continue
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+//ShopSave Replaces the persisted live stock of the provided shop with its current inventory.
+func (s *sqlService) ShopSave(shop *world.Shop) {
+	if s.database == nil {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	tx, err := s.database.Begin()
+	if err != nil {
+		log.Warn("ShopSave(): Could not begin transaction:", err)
+		return
+	}
+	rollback := func(tx *sql.Tx) {
+		if err := tx.Rollback(); err != nil {
+			log.Warn("ShopSave(): Transaction rollback failed:", err)
+		}
+	}
+	if _, err := tx.Exec("DELETE FROM shop_stock WHERE shop=$1", shop.Name); err != nil {
+		log.Warn("ShopSave(): DELETE failed for shop stock:", err)
+		rollback(tx)
+		return
+	}
+	failed := false
+	shop.Inventory.Range(func(item *world.Item) bool {
+		if failed {
+			return false
+		}
+		if _, err := tx.Exec("INSERT INTO shop_stock(shop, itemid, amount) VALUES($1, $2, $3)", shop.Name, item.ID, item.Amount); err != nil {
+			log.Warn("ShopSave(): INSERT failed for shop stock:", err)
+			failed = true
+		}
+		return false
+	})
+	if failed {
+		rollback(tx)
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		log.Warn("ShopSave(): Error committing transaction:", err)
+	}
+}
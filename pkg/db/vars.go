@@ -0,0 +1,90 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/spkaeros/rscgo/pkg/config"
+	"github.com/spkaeros/rscgo/pkg/game/world"
+	"github.com/spkaeros/rscgo/pkg/log"
+)
+
+//NewVarServiceSql Returns a new world.VarService that stores persistent variables within the default players database.
+func NewVarServiceSql() world.VarService {
+	s := newSqlService(config.PlayerDriver())
+	s.sqlOpen(config.PlayerDB())
+	return dbConn
+}
+
+//VarsLoad Returns every persistent variable stored under the provided scope and owner that has not expired.
+func (s *sqlService) VarsLoad(scope, owner string) []world.VarRecord {
+	db := s.connect(context.Background())
+	if db == nil {
+		log.Warn("VarsLoad(): Could not connect to database")
+		return nil
+	}
+	rows, err := db.QueryContext(context.Background(), "SELECT name, value, expires FROM vars WHERE scope=$1 AND owner=$2 AND (expires=0 OR expires>$3)", scope, owner, time.Now().UnixNano())
+	if err != nil {
+		log.Warn("VarsLoad(): Could not query variables:", err)
+		return nil
+	}
+	defer rows.Close()
+	var records []world.VarRecord
+	for rows.Next() {
+		r := world.VarRecord{Scope: scope, Owner: owner}
+		var expires int64
+		if err := rows.Scan(&r.Name, &r.Value, &expires); err != nil {
+			log.Warn("VarsLoad(): Could not scan variable:", err)
+			continue
+		}
+		if expires != 0 {
+			r.Expires = time.Unix(0, expires)
+		}
+		records = append(records, r)
+	}
+	return records
+}
+
+//VarsSave Replaces the provided persistent variables within a single transaction, deleting those with an empty value.
+func (s *sqlService) VarsSave(records []world.VarRecord) {
+	db := s.connect(context.Background())
+	if db == nil {
+		log.Warn("VarsSave(): Could not connect to database; dropped", len(records), "variables")
+		return
+	}
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		log.Warn("VarsSave(): Could not begin transaction:", err)
+		return
+	}
+	rollback := func() {
+		if err := tx.Rollback(); err != nil {
+			log.Warn("VarsSave(): Transaction rollback failed:", err)
+		}
+	}
+	for _, r := range records {
+		if _, err := tx.Exec("DELETE FROM vars WHERE scope=$1 AND owner=$2 AND name=$3", r.Scope, r.Owner, r.Name); err != nil {
+			log.Warn("VarsSave(): DELETE failed for variable:", err)
+			rollback()
+			return
+		}
+		if len(r.Value) == 0 {
+			continue
+		}
+		var expires int64
+		if !r.Expires.IsZero() {
+			expires = r.Expires.UnixNano()
+		}
+		if _, err := tx.Exec("INSERT INTO vars(scope, owner, name, value, expires) VALUES($1, $2, $3, $4, $5)", r.Scope, r.Owner, r.Name, r.Value, expires); err != nil {
+			log.Warn("VarsSave(): INSERT failed for variable:", err)
+			rollback()
+			return
+		}
+	}
+	if _, err := tx.Exec("DELETE FROM vars WHERE expires<>0 AND expires<=$1", time.Now().UnixNano()); err != nil {
+		log.Warn("VarsSave(): Could not delete expired variables:", err)
+	}
+	if err := tx.Commit(); err != nil {
+		log.Warn("VarsSave(): Error committing transaction:", err)
+	}
+}
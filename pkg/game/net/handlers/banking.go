@@ -33,10 +33,16 @@ func init() {
 			return
 		}
 
-		if player.Inventory.RemoveByID(id, amount) > -1 {
-			player.Bank().Add(id, amount)
-			player.SendPacket(world.BankUpdateItem(player.Bank().GetIndex(id), id, player.Bank().GetByID(id).Amount))
+		deposit := world.NewExchange("bank deposit", player.Inventory, player.Bank())
+		deposit.Offer(player.Inventory, id, amount)
+		if err := deposit.Apply(); err != nil {
+			if err.(*world.ExchangeError).Reason == world.ExchangeNoRoom {
+				player.Message("You don't have room for that in your bank!")
+			}
+			return
 		}
+		world.RecordItem(world.LedgerDeposit, player.Username(), player.Username(), id, amount, player.X(), player.Y())
+		player.UpdateBankItem(player.Bank().GetIndex(id), id, player.Bank().CountID(id))
 	})
 	game.AddHandler("withdrawbank", func(player *world.Player, p *net.Packet) {
 		if !player.HasState(world.StateBanking) {
@@ -51,6 +57,10 @@ func init() {
 			return
 		}
 		item := player.Bank().Get(idx)
+		if item != nil && item.Amount == 0 {
+			// a placeholder; there is nothing to withdraw
+			return
+		}
 		if item == nil || item.Amount < amount {
 			log.Suspicious.Println("Attempted withdraw of items they do not have:", player.String(), id, amount)
 			return
@@ -59,31 +69,20 @@ func init() {
 			player.Message("You don't have room to hold everything!")
 			return
 		}
-		if !item.Stackable() {
-			for i := 0; i < amount; i++ {
-				if !player.Inventory.CanHold(id, 1) || player.Bank().RemoveByID(id, 1) < 0 {
-					break
-				}
-				player.Inventory.Add(id, 1)
-			}
-			player.SendInventory()
-
-			if player.Bank().CountID(id) > 0 {
-				player.SendPacket(world.BankUpdateItem(idx, id, item.Amount))
-			} else {
-				player.SendPacket(world.BankUpdateItem(idx, id, 0))
+		withdrawal := world.NewExchange("bank withdrawal", player.Bank(), player.Inventory)
+		withdrawal.Offer(player.Bank(), id, amount)
+		if err := withdrawal.Apply(); err != nil {
+			if err.(*world.ExchangeError).Reason == world.ExchangeNoRoom {
+				player.Message("You don't have room to hold everything!")
 			}
 			return
 		}
-		if player.Bank().RemoveByID(id, amount) > -1 {
-			player.Inventory.Add(id, amount)
-			player.SendInventory()
-			if player.Bank().CountID(id) > 0 {
-				player.SendPacket(world.BankUpdateItem(idx, id, item.Amount))
-			} else {
-				player.SendPacket(world.BankUpdateItem(idx, id, 0))
-			}
+		world.RecordItem(world.LedgerWithdraw, player.Username(), player.Username(), id, amount, player.X(), player.Y())
+		if player.Bank().CountID(id) == 0 && player.LeaveBankPlaceholder(idx, id) {
+			player.RefreshBank()
+			return
 		}
+		player.UpdateBankItem(idx, id, player.Bank().CountID(id))
 	})
 	game.AddHandler("closebank", func(player *world.Player, p *net.Packet) {
 		if !player.HasState(world.StateBanking) {
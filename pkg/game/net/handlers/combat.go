@@ -57,11 +57,8 @@ func init() {
 				return
 			}
 			npc.ResetPath()
-			for _, trigger := range world.NpcAtkTriggers {
-				if trigger.Check(player, npc) {
-					trigger.Action(player, npc)
-					return
-				}
+			if world.Emit(&world.NpcAttackEvent{Player: player, Npc: npc}) {
+				return
 			}
 			player.StartCombat(npc)
 		})
@@ -96,9 +93,6 @@ func init() {
 				return
 			}
 			player.ResetPath()
-			if time.Since(affectedPlayer.VarTime("lastRetreat")) <= time.Second*3 {
-				return
-			}
 			affectedPlayer.ResetPath()
 			affectedPlayer.Message("You are under attack!")
 			player.StartCombat(affectedPlayer)
@@ -18,12 +18,14 @@ import (
 	"sync"
 	"time"
 
-	"github.com/mattn/anko/vm"
+	"github.com/spkaeros/rscgo/pkg/db"
+	"github.com/spkaeros/rscgo/pkg/definitions"
 	"github.com/spkaeros/rscgo/pkg/game"
 	"github.com/spkaeros/rscgo/pkg/game/net"
 	"github.com/spkaeros/rscgo/pkg/game/world"
 	"github.com/spkaeros/rscgo/pkg/log"
 	"github.com/spkaeros/rscgo/pkg/strutil"
+	"github.com/spkaeros/rscgo/pkg/tasks"
 )
 
 func init() {
@@ -34,14 +36,18 @@ func init() {
 		if len(args) <= 0 {
 			return
 		}
-		handler, ok := world.CommandHandlers[strings.ToLower(args[0])]
+		handler, ok := world.CommandHandler(strings.ToLower(args[0]))
 		if !ok {
 			player.Message("@que@Command not found.  Double check your spelling, and try again.")
 			log.Command("%v sent invalid command: ::%v\n", player.Username(), strings.ToLower(args[0]))
 			return
 		}
 		log.Commandf("%v: ::%v\n", player.Username(), raw)
-		handler(player, args[1:])
+		// Commands can wait on the player, e.g to pick from an option menu, and that reply can only arrive on a later
+		// tick, so they run as a coroutine rather than holding up the engine.
+		player.StartCoroutine(world.StateIdle, func() {
+			handler(player, args[1:])
+		})
 	})
 	world.CommandHandlers["shutdown"] = func(player *world.Player, args []string) {
 		var wg sync.WaitGroup
@@ -108,13 +114,297 @@ func init() {
 			player.Message("Invalid args.  Usage: /pprof <start|stop>")
 		}
 	}
+	world.CommandHandlers["tickstats"] = func(player *world.Player, args []string) {
+		names, stats := tasks.TickPhases.Stats()
+		if len(names) <= 0 {
+			player.Message("The tick pipeline has not been started.")
+			return
+		}
+		player.Message(fmt.Sprintf("%d ticks ran, %d ran over budget.", tasks.TickPhases.Ticks(), tasks.TickPhases.Overruns()))
+		for i, name := range names {
+			player.Message(fmt.Sprintf("%s: last=%v, mean=%v, max=%v", name, stats[i].Last, stats[i].Mean(), stats[i].Max))
+		}
+	}
+	world.CommandHandlers["cron"] = func(player *world.Player, args []string) {
+		if player.Rank() != 2 {
+			player.Message("@que@You do not have permission to use this command.")
+			return
+		}
+		if len(args) < 1 || args[0] == "list" {
+			jobs := tasks.Crontab.Jobs()
+			if len(jobs) <= 0 {
+				player.Message("There are no scheduled jobs.")
+				return
+			}
+			for _, job := range jobs {
+				next, last := "never", "never"
+				if !job.NextRun().IsZero() {
+					next = job.NextRun().Format("Mon Jan 2 15:04")
+				}
+				if !job.LastRun().IsZero() {
+					last = job.LastRun().Format("Mon Jan 2 15:04")
+				}
+				player.Message(fmt.Sprintf("%s (%s): next=%s, last=%s", job.Name, job.Spec, next, last))
+			}
+			return
+		}
+		if len(args) < 2 {
+			player.Message("Usage: ::cron [list|run <name>|cancel <name>|resume <name>]  (use _ for spaces in names)")
+			return
+		}
+		name := strings.ReplaceAll(strings.Join(args[1:], " "), "_", " ")
+		switch args[0] {
+		case "run":
+			if !tasks.Crontab.Trigger(name) {
+				player.Message("There is no scheduled job named '" + name + "'.")
+				return
+			}
+			player.Message("Running '" + name + "' on the next tick.")
+			log.Commands.Println(player.Username() + " triggered scheduled job '" + name + "'")
+		case "cancel":
+			if !tasks.Crontab.Cancel(name) {
+				player.Message("There is no scheduled job named '" + name + "'.")
+				return
+			}
+			player.Message("Cancelled '" + name + "'.")
+			log.Commands.Println(player.Username() + " cancelled scheduled job '" + name + "'")
+		case "resume":
+			if !tasks.Crontab.Resume(name) {
+				player.Message("There is no cancelled scripted job named '" + name + "'.")
+				return
+			}
+			player.Message("Resumed '" + name + "'.")
+			log.Commands.Println(player.Username() + " resumed scheduled job '" + name + "'")
+		default:
+			player.Message("Usage: ::cron [list|run <name>|cancel <name>|resume <name>]  (use _ for spaces in names)")
+		}
+	}
+	world.CommandHandlers["shop"] = func(player *world.Player, args []string) {
+		if player.Rank() != 2 {
+			player.Message("@que@You do not have permission to use this command.")
+			return
+		}
+		if len(args) < 1 {
+			player.Message("Usage: ::shop <name> [set <id> <amount>|restock|save]  (use _ for spaces in names)")
+			player.Message("Shops: " + strings.Join(world.Shops.Names(), ", "))
+			return
+		}
+		shop := findShop(args[0])
+		if shop == nil {
+			player.Message("Could not find a shop named '" + args[0] + "'.")
+			return
+		}
+		if len(args) < 2 {
+			player.Message(fmt.Sprintf("@yel@%s: @whi@buys at %d%%, sells at %d%%, restocks every %d ticks, general=%v", shop.Name, shop.BasePurchasePercent, shop.BaseSalePercent, shop.Model.Interval(), shop.BuysUnstocked))
+			shop.Inventory.Range(func(item *world.Item) bool {
+				player.Message(fmt.Sprintf("%d %s: %d (stock %d, price %+d%%)", item.ID, item.Name(), item.Amount, shop.Stock.Count(item.ID), shop.DeltaPercentMod(item)))
+				return false
+			})
+			return
+		}
+		switch strings.ToLower(args[1]) {
+		case "set":
+			if len(args) < 4 {
+				player.Message("Usage: ::shop <name> set <id> <amount>")
+				return
+			}
+			id, err := strconv.Atoi(args[2])
+			if err != nil || id < 0 || id >= len(definitions.Items) {
+				player.Message("Invalid item ID: " + args[2])
+				return
+			}
+			amount, err := strconv.Atoi(args[3])
+			if err != nil || amount < 0 || amount > 0xFFFF {
+				player.Message("Invalid amount: " + args[3])
+				return
+			}
+			shop.SetAmount(id, amount)
+			log.Commands.Printf("%v set %v's stock of item %d to %d\n", player.Username(), shop.Name, id, amount)
+			player.Message(fmt.Sprintf("%s now has %d %s.", shop.Name, amount, definitions.Items[id].Name))
+		case "restock":
+			shop.ResetStock()
+			log.Commands.Printf("%v restocked %v\n", player.Username(), shop.Name)
+			player.Message(shop.Name + " has been restocked.")
+		case "save":
+			go shop.Save()
+			player.Message(shop.Name + " is being saved.")
+			return
+		default:
+			player.Message("Usage: ::shop <name> [set <id> <amount>|restock|save]")
+			return
+		}
+		shop.Refresh()
+		go shop.Save()
+	}
+	world.CommandHandlers["bank"] = func(player *world.Player, args []string) {
+		usage := func() {
+			player.Message("Usage: ::bank pin | tab [0-" + strconv.Itoa(world.BankTabs) + "] | search [text] | move <slot> <tab> | swap <slot> <slot> | insert <slot> <slot> | placeholders [on|off] | release <slot|all>")
+		}
+		if len(args) < 1 {
+			usage()
+			return
+		}
+		slots := func(args []string) ([]int, bool) {
+			var slots []int
+			for _, arg := range args {
+				slot, err := strconv.Atoi(arg)
+				if err != nil || slot < 1 {
+					player.Message("Invalid bank slot: " + arg)
+					return nil, false
+				}
+				slots = append(slots, slot-1)
+			}
+			return slots, true
+		}
+		switch strings.ToLower(args[0]) {
+		case "pin":
+			player.ManageBankPin()
+			return
+		case "tab":
+			tab := 0
+			if len(args) > 1 {
+				t, err := strconv.Atoi(args[1])
+				if err != nil || t < 0 || t > world.BankTabs {
+					player.Message("Tabs go from 1 to " + strconv.Itoa(world.BankTabs) + ", or 0 to show every tab.")
+					return
+				}
+				tab = t
+			}
+			player.SetBankView(tab, player.VarString("bankSearch", ""))
+			if tab == 0 {
+				player.Message("Your bank is now showing every tab.")
+			} else {
+				player.Message("Your bank is now showing tab " + strconv.Itoa(tab) + ".")
+			}
+		case "search":
+			search := strings.Join(args[1:], " ")
+			player.SetBankView(player.VarInt("bankViewTab", 0), search)
+			if len(search) == 0 {
+				player.Message("Your bank is no longer being searched.")
+			} else {
+				player.Message("Your bank is now only showing items matching '" + search + "'.")
+			}
+		case "placeholders":
+			keep := !player.BankPlaceholders()
+			if len(args) > 1 {
+				keep = strings.ToLower(args[1]) == "on"
+			}
+			player.SetBankPlaceholders(keep)
+			if keep {
+				player.Message("Withdrawing all of an item will now leave a placeholder in your bank.")
+			} else {
+				player.Message("Withdrawing all of an item will no longer leave a placeholder in your bank.")
+			}
+			return
+		case "release":
+			if len(args) < 2 {
+				usage()
+				return
+			}
+			released := 0
+			if strings.ToLower(args[1]) == "all" {
+				released = player.Bank().ReleasePlaceholders(-1)
+			} else {
+				slot, ok := slots(args[1:2])
+				if !ok {
+					return
+				}
+				if view := player.BankView(); slot[0] < len(view) && view[slot[0]].Amount == 0 {
+					released = player.Bank().ReleasePlaceholders(view[slot[0]].ID)
+				}
+			}
+			if released == 0 {
+				player.Message("There are no placeholders there to release.")
+				return
+			}
+			player.Message("Released " + strconv.Itoa(released) + " placeholders from your bank.")
+		case "move", "swap", "insert":
+			if !player.HasState(world.StateBanking) {
+				player.Message("You need to have your bank open to rearrange it.")
+				return
+			}
+			if len(args) < 3 {
+				usage()
+				return
+			}
+			if strings.ToLower(args[0]) == "move" {
+				slot, ok := slots(args[1:2])
+				if !ok {
+					return
+				}
+				view := player.BankView()
+				tab, err := strconv.Atoi(args[2])
+				if slot[0] >= len(view) || err != nil || tab < 0 || tab > world.BankTabs {
+					usage()
+					return
+				}
+				player.SetBankTab(view[slot[0]].ID, tab)
+				player.Message(view[slot[0]].Name() + " has been moved to tab " + strconv.Itoa(tab) + ".")
+				break
+			}
+			pair, ok := slots(args[1:3])
+			if !ok {
+				return
+			}
+			switch strings.ToLower(args[0]) {
+			case "swap":
+				if !player.SwapBankSlots(pair[0], pair[1]) {
+					player.Message("You can only swap slots that have items in them.")
+					return
+				}
+			case "insert":
+				if !player.InsertBankSlot(pair[0], pair[1]) {
+					player.Message("You can only move items between slots that have items in them.")
+					return
+				}
+			}
+		default:
+			usage()
+			return
+		}
+		player.RefreshBank()
+	}
+	world.CommandHandlers["mail"] = func(player *world.Player, args []string) {
+		if len(args) < 2 {
+			player.Message("Usage: ::mail <name> [slot,slot,...] <message>  (use _ for spaces in names)")
+			player.Message("Slots are counted from 1 along the rows of your inventory, up to " + strconv.Itoa(world.MailItems) + " of them.")
+			return
+		}
+		to := strings.TrimSpace(strings.ReplaceAll(args[0], "_", " "))
+		var slots []int
+		message := args[1:]
+		if parts := strings.Split(args[1], ","); len(args) > 2 || len(parts) > 1 {
+			for _, part := range parts {
+				slot, err := strconv.Atoi(part)
+				if err != nil {
+					slots = nil
+					break
+				}
+				slots = append(slots, slot-1)
+			}
+			if slots != nil {
+				message = args[2:]
+			}
+		}
+		if strings.EqualFold(to, player.Username()) {
+			player.Message("You can't send mail to yourself!")
+			return
+		}
+		go func() {
+			if !db.DefaultPlayerService.PlayerNameExists(to) {
+				player.Message("Could not find a player named '" + to + "'.")
+				return
+			}
+			player.SendMail(strutil.Base37.Decode(strutil.Base37.Encode(to)), strings.Join(message, " "), slots)
+		}()
+	}
 	world.CommandHandlers["run"] = func(player *world.Player, args []string) {
 		line := strings.Join(args, " ")
 		env := world.ScriptEnv()
 		env.Define("p", player)
 		env.Define("target", player.TargetMob())
 		env.Define("player", player)
-		ret, err := vm.Execute(env, nil, "bind = import(\"bind\")\nworld = import(\"world\")\nlog = import(\"log\")\nids = import(\"ids\")\n\n"+line)
+		ret, err := world.Sandbox.Execute(env, "::run", line)
 		if err != nil {
 			player.Message("Error: " + err.Error())
 			log.Info.Println("Anko Error: " + err.Error())
@@ -139,12 +429,38 @@ func init() {
 		log.Info.Println(ret)
 	}
 	world.CommandHandlers["reload"] = func(player *world.Player, args []string) {
-		world.Clear()
-		world.RunScripts()
-		player.Message("Reloaded ./scripts/**.ank from working directory.")
-		player.Message(fmt.Sprintf("Bind[%d item, %d obj, %d bound, %d npc, %d invBound, %d invObject, %d npcAtk, %d npcKill]", len(world.ItemTriggers), len(world.ObjectTriggers), len(world.BoundaryTriggers), len(world.NpcTriggers), len(world.InvOnBoundaryTriggers), len(world.InvOnObjectTriggers), len(world.NpcAtkTriggers), len(world.NpcDeathTriggers)))
-		log.Info.Printf("Bind[%d item, %d obj, %d bound, %d npc, %d invBound, %d invObject, %d npcAtk, %d npcKill] loaded\n", len(world.ItemTriggers), len(world.ObjectTriggers), len(world.BoundaryTriggers), len(world.NpcTriggers), len(world.InvOnBoundaryTriggers), len(world.InvOnObjectTriggers), len(world.NpcAtkTriggers), len(world.NpcDeathTriggers))
+		if len(args) > 0 && args[0] == "rollback" {
+			set, err := world.RollbackScripts()
+			if err != nil {
+				player.Message("Could not roll back: " + err.Error())
+				return
+			}
+			player.Message(fmt.Sprintf("Rolling back to scripts version %d on the next tick.", set.Version))
+			log.Commands.Printf("%v rolled scripts back to version %d\n", player.Username(), set.Version)
+			return
+		}
+		go func() {
+			set, err := world.ReloadScripts()
+			if err != nil {
+				player.Message("Reload failed, the live scripts were kept: " + err.Error())
+				return
+			}
+			player.Message(fmt.Sprintf("Reloaded ./scripts/**.ank as version %d, going live on the next tick.", set.Version))
+			player.Message(set.String())
+			log.Commands.Printf("%v reloaded scripts as version %d: %v\n", player.Username(), set.Version, set)
+		}()
+	}
+}
+
+//findShop returns the shop whose name matches name, ignoring case and treating underscores as spaces, or nil.
+func findShop(name string) *world.Shop {
+	name = strings.ReplaceAll(name, "_", " ")
+	for _, candidate := range world.Shops.Names() {
+		if strings.EqualFold(strings.ReplaceAll(candidate, "_", " "), name) {
+			return world.Shops.Get(candidate)
+		}
 	}
+	return nil
 }
 
 func notYetImplemented(player *world.Player) {
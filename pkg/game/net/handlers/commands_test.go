@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spkaeros/rscgo/pkg/game"
+	"github.com/spkaeros/rscgo/pkg/game/net"
+	"github.com/spkaeros/rscgo/pkg/game/world"
+	"github.com/spkaeros/rscgo/pkg/tasks"
+)
+
+//discard A client connection that throws away everything written to it.
+type discard struct{}
+
+func (discard) Write(data []byte) (int, error) {
+	return len(data), nil
+}
+
+func (discard) Flush() error {
+	return nil
+}
+
+//TestCommandAwaitsMenu checks that a command waiting on an option menu hands the engine back straight away, and gets
+// its answer from a reply handled on a later tick.
+func TestCommandAwaitsMenu(t *testing.T) {
+	reply := make(chan int, 1)
+	world.CommandHandlers["testmenu"] = func(player *world.Player, args []string) {
+		reply <- player.OpenOptionMenu("Yes", "No")
+	}
+	defer delete(world.CommandHandlers, "testmenu")
+	p := world.NewPlayer(nil)
+	p.Writer = discard{}
+	p.SetConnected(true)
+
+	handled := make(chan struct{})
+	go func() {
+		defer close(handled)
+		game.Handlers["command"](p, net.NewPacket(38, []byte("testmenu\x00")))
+	}()
+	select {
+	case <-handled:
+	case <-time.After(time.Second):
+		t.Fatal("command handler held up the engine waiting on the menu")
+	}
+	if !p.HasState(world.StateMenu) {
+		t.Fatal("command never opened its menu")
+	}
+	if !p.HandleInput(net.NewPacket(116, []byte{1})) {
+		t.Fatal("menu reply was not taken by the command")
+	}
+	tasks.TickList.Tick()
+	select {
+	case r := <-reply:
+		if r != 1 {
+			t.Errorf("command got menu answer %d, want 1", r)
+		}
+	default:
+		t.Fatal("command was not answered by the tick after the reply")
+	}
+}
@@ -18,10 +18,18 @@ import (
 
 func init() {
 	game.AddHandler("chatmsg", func(player *world.Player, p *net.Packet) {
+		if player.Muted() {
+			player.Message("You are muted, and can not talk right now.")
+			return
+		}
+		chat := &world.ChatEvent{Player: player, Message: string(p.FrameBuffer)}
+		if world.Emit(chat) {
+			return
+		}
 		for _, p1 := range player.NearbyPlayers() {
 			if !p1.ChatBlocked() || p1.FriendsWith(player.UsernameHash()) {
 				//p1.SendPacket(world.PlayerChat(player.Index, string(p.FrameBuffer)))
-				p1.QueuePublicChat(player, string(p.FrameBuffer))
+				p1.QueuePublicChat(player, chat.Message)
 			}
 		}
 	})
@@ -46,6 +54,10 @@ func init() {
 	})
 	game.AddHandler("privmsg", func(player *world.Player, p *net.Packet) {
 		hash := p.ReadUint64()
+		if player.Muted() {
+			player.Message("You are muted, and can not talk right now.")
+			return
+		}
 		if p1, ok := world.Players.FindHash(hash); ok && p1 != nil &&
 			(!p1.FriendBlocked() || p1.FriendList.ContainsHash(hash)) {
 			// c1.SendPacket(world.PrivateMessage(player.UsernameHash(), strutil.ChatFilter.Format(strutil.ChatFilter.Unpack(p.FrameBuffer[8:]))))
@@ -103,13 +115,7 @@ func init() {
 		}
 	})
 	game.AddHandler("chooseoption", func(player *world.Player, p *net.Packet) {
-		choice := p.ReadUint8()
-		if player.VarInt("state", 0)&world.StateChatChoosing&^world.MSItemAction == 0 {
-			return
-		}
-		if choice < 0 {
-			return
-		}
-		player.ReplyMenuC <- int8(choice)
+		// Replies to an open option menu are taken by the coroutine awaiting them before they ever get here, so this
+		// reply is not answering anything.
 	})
 }
@@ -96,8 +96,15 @@ func init() {
 			}
 
 			player.ResetPath()
-			item.Remove()
+			if world.Emit(&world.ItemPickupEvent{Player: player, Item: item}) {
+				return false
+			}
+			if !item.Remove() {
+				// somebody else got to it first
+				return false
+			}
 			player.Inventory.Add(item.ID, item.Amount)
+			world.RecordItem(world.LedgerPickup, "", player.Username(), item.ID, item.Amount, item.X(), item.Y())
 			player.SendInventory()
 			player.PlaySound("takeobject")
 			return false
@@ -122,13 +129,22 @@ func init() {
 			}
 
 			item := player.Inventory.Get(index)
+			if item == nil || world.Emit(&world.ItemDropEvent{Player: player, Item: item}) {
+				return false
+			}
 			if !player.Inventory.Remove(index) {
 				return false
 			}
-			world.AddItem(world.NewGroundItemFor(player.UsernameHash(), item.ID, item.Amount, player.X(), player.Y()))
+			if world.AddItem(world.NewGroundItemFor(player.UsernameHash(), item.ID, item.Amount, player.X(), player.Y())) == nil {
+				player.Inventory.Add(item.ID, item.Amount)
+				player.Message("There is no room to drop that here")
+				player.SendInventory()
+				return false
+			}
+			world.RecordItem(world.LedgerDrop, player.Username(), "", item.ID, item.Amount, player.X(), player.Y())
 			player.PlaySound("dropobject")
 			player.SendInventory()
-			return true
+			return false
 		})
 	})
 	game.AddHandler("invaction1", func(player *world.Player, p *net.Packet) {
@@ -137,18 +153,10 @@ func init() {
 		if item == nil || player.Busy() || player.IsFighting() {
 			return
 		}
-		player.AddState(world.MSItemAction)
-		go func() {
-			defer func() {
-				player.RemoveState(world.MSItemAction)
-			}()
-			for _, triggerDef := range world.ItemTriggers {
-				if triggerDef.Check(item) {
-					triggerDef.Action(player, item)
-					return
-				}
+		player.StartCoroutine(world.MSItemAction, func() {
+			if !world.Emit(&world.ItemActionEvent{Player: player, Item: item}) {
+				player.SendPacket(world.DefaultActionMessage)
 			}
-			player.SendPacket(world.DefaultActionMessage)
-		}()
+		})
 	})
 }
@@ -32,22 +32,12 @@ func init() {
 		player.SetTickAction(func() bool {
 			if player.AtObject(object) {
 				player.ResetPath()
-				player.AddState(world.MSBatching)
-
-				go func() {
-					defer func() {
-						player.RemoveState(world.MSBatching)
-					}()
-
-					for _, trigger := range world.ObjectTriggers {
-						if trigger.Check(object, 0) {
-							trigger.Action(player, object, 0)
-							return
-						}
+				player.StartCoroutine(world.MSBatching, func() {
+					if !world.Emit(&world.ObjectActionEvent{Player: player, Object: object, Click: 0}) {
+						player.SendPacket(world.DefaultActionMessage)
 					}
-					player.SendPacket(world.DefaultActionMessage)
-				}()
-				return true
+				})
+				return false
 			}
 			return false
 
@@ -67,23 +57,13 @@ func init() {
 		player.SetTickAction(func() bool {
 			if player.AtObject(object) {
 				player.ResetPath()
-				player.AddState(world.MSBatching)
-
-				go func() {
-					defer func() {
-						player.RemoveState(world.MSBatching)
-					}()
-
-					for _, trigger := range world.ObjectTriggers {
-						if trigger.Check(object, 1) {
-							trigger.Action(player, object, 1)
-							return
-						}
+				player.StartCoroutine(world.MSBatching, func() {
+					if !world.Emit(&world.ObjectActionEvent{Player: player, Object: object, Click: 1}) {
+						player.SendPacket(world.DefaultActionMessage)
 					}
-					player.SendPacket(world.DefaultActionMessage)
-				}()
+				})
 
-				return true
+				return false
 			}
 			return false
 		})
@@ -107,21 +87,12 @@ func init() {
 					// If somehow we became busy, the object changed before arriving, we do nothing.
 					return true
 				}
-				player.AddState(world.MSBatching)
-				go func() {
-					defer func() {
-						player.RemoveState(world.MSBatching)
-					}()
-
-					for _, trigger := range world.BoundaryTriggers {
-						if trigger.Check(object, 1) {
-							trigger.Action(player, object, 1)
-							return
-						}
+				player.StartCoroutine(world.MSBatching, func() {
+					if !world.Emit(&world.ObjectActionEvent{Player: player, Object: object, Click: 1}) {
+						player.SendPacket(world.DefaultActionMessage)
 					}
-					player.SendPacket(world.DefaultActionMessage)
-				}()
-				return true
+				})
+				return false
 			}
 			return false
 		})
@@ -145,21 +116,12 @@ func init() {
 					// If somehow we became busy, the object changed before arriving, we do nothing.
 					return true
 				}
-				player.AddState(world.MSBatching)
-				go func() {
-					defer func() {
-						player.RemoveState(world.MSBatching)
-					}()
-
-					for _, trigger := range world.BoundaryTriggers {
-						if trigger.Check(object, 0) {
-							trigger.Action(player, object, 0)
-							return
-						}
+				player.StartCoroutine(world.MSBatching, func() {
+					if !world.Emit(&world.ObjectActionEvent{Player: player, Object: object, Click: 0}) {
+						player.SendPacket(world.DefaultActionMessage)
 					}
-					player.SendPacket(world.DefaultActionMessage)
-				}()
-				return true
+				})
+				return false
 			}
 			return false
 		})
@@ -185,38 +147,36 @@ func init() {
 			if player.Busy() {
 				return
 			}
-			for _, triggerDef := range world.NpcTriggers {
-				if triggerDef.Check(npc) {
-					npc.ResetPath()
-					if player.Location.Equals(npc.Location) {
-						for _, direction := range world.OrderedDirections {
-							neighbor := npc.Step(direction)
-							if npc.Reachable(neighbor) {
-								npc.SetLocation(neighbor, true)
-								break
-							}
-						}
-					}
-
-					if !player.Location.Equals(npc.Location) {
-						player.SetDirection(player.DirectionTo(npc.X(), npc.Y()))
-						npc.SetDirection(npc.DirectionTo(player.X(), player.Y()))
+			if !world.Subscribed(&world.TalkToNpcEvent{Player: player, Npc: npc}) {
+				player.Message("The " + npc.Name() + " does not appear interested in talking")
+				return
+			}
+			npc.ResetPath()
+			if player.Location.Equals(npc.Location) {
+				for _, direction := range world.OrderedDirections {
+					neighbor := npc.Step(direction)
+					if npc.Reachable(neighbor) {
+						npc.SetLocation(neighbor, true)
+						break
 					}
-					go func() {
-						player.SetVar("targetMob", npc)
-						defer func() {
-							player.UnsetVar("targetMob")
-							player.RemoveState(world.StateChatting)
-							npc.RemoveState(world.StateChatting)
-						}()
-						player.AddState(world.StateChatting)
-						npc.AddState(world.StateChatting)
-						triggerDef.Action(player, npc)
-					}()
-					return
 				}
 			}
-			player.Message("The " + npc.Name() + " does not appear interested in talking")
+
+			if !player.Location.Equals(npc.Location) {
+				player.SetDirection(player.DirectionTo(npc.X(), npc.Y()))
+				npc.SetDirection(npc.DirectionTo(player.X(), player.Y()))
+			}
+			player.SetVar("targetMob", npc)
+			npc.AddState(world.StateChatting)
+			player.StartCoroutine(world.StateChatting, func() {
+				defer func() {
+					player.UnsetVar("targetMob")
+					npc.RemoveState(world.StateChatting)
+				}()
+				if !world.Emit(&world.TalkToNpcEvent{Player: player, Npc: npc}) {
+					player.Message("The " + npc.Name() + " does not appear interested in talking")
+				}
+			})
 		})
 	})
 	game.AddHandler("invonboundary", func(player *world.Player, p *net.Packet) {
@@ -246,24 +206,59 @@ func init() {
 			}
 			if (player.NextTo(bounds[1]) || player.NextTo(bounds[0])) && player.X() >= bounds[0].X() && player.Y() >= bounds[0].Y() && player.X() <= bounds[1].X() && player.Y() <= bounds[1].Y() {
 				player.ResetPath()
-				player.AddState(world.MSBatching)
-				go func() {
-					defer func() {
-						player.RemoveState(world.MSBatching)
-					}()
-					for _, fn := range world.InvOnBoundaryTriggers {
-						if fn(player, object, invItem) {
-							return
-						}
+				player.StartCoroutine(world.MSBatching, func() {
+					if !world.Emit(&world.InvOnObjectEvent{Player: player, Object: object, Item: invItem}) {
+						player.SendPacket(world.DefaultActionMessage)
 					}
-					player.SendPacket(world.DefaultActionMessage)
-				}()
-				return true
+				})
+				return false
 			}
 			player.WalkTo(object.Location)
 			return false
 		})
 	})
+	game.AddHandler("invongrounditem", func(player *world.Player, p *net.Packet) {
+		if player.Busy() || player.IsFighting() {
+			return
+		}
+		x := p.ReadUint16()
+		y := p.ReadUint16()
+		if x < 0 || x >= world.MaxX || y < 0 || y >= world.MaxY {
+			log.Suspicious.Printf("%v attempted to use an item on a ground item at an invalid location: [%d,%d]\n", player, x, y)
+			return
+		}
+		id := p.ReadUint16()
+		invIndex := p.ReadUint16()
+		if invIndex >= player.Inventory.Size() {
+			log.Suspicious.Printf("%v attempted to use a non-existent item(idx:%v, cap:%v) on a ground item at %d,%d\n", player, invIndex, player.Inventory.Size()-1, x, y)
+			return
+		}
+		invItem := player.Inventory.Get(invIndex)
+		player.SetTickAction(func() bool {
+			if player.Busy() {
+				return false
+			}
+			groundItem := world.GetItem(x, y, id)
+			if groundItem == nil || !groundItem.VisibleTo(player) {
+				log.Suspicious.Printf("%v attempted to use an item on a ground item that doesn't exist: %d@{%d,%d}\n", player, id, x, y)
+				return false
+			}
+			maxDelta := 0
+			if world.IsTileBlocking(x, y, 0x40, false) {
+				maxDelta++
+			}
+			if delta := player.Delta(groundItem.Location); delta > maxDelta || delta == 1 && !player.ReachableCoords(x, y) {
+				return !player.FinishedPath()
+			}
+			player.ResetPath()
+			player.StartCoroutine(world.MSBatching, func() {
+				if !world.Emit(&world.InvOnGroundItemEvent{Player: player, GroundItem: groundItem, Item: invItem}) {
+					player.SendPacket(world.DefaultActionMessage)
+				}
+			})
+			return false
+		})
+	})
 	game.AddHandler("invonplayer", func(player *world.Player, p *net.Packet) {
 		if player.Busy() || player.IsFighting() {
 			return
@@ -292,22 +287,16 @@ func init() {
 			}
 			if player.WithinRange(target.Location, 1) && player.NextTo(target.Location) {
 				player.ResetPath()
-				player.AddState(world.MSBatching)
 				target.AddState(world.MSBatching)
-				go func() {
+				player.StartCoroutine(world.MSBatching, func() {
 					defer func() {
-						player.RemoveState(world.MSBatching)
 						target.RemoveState(world.MSBatching)
 					}()
-					for _, trigger := range world.InvOnPlayerTriggers {
-						if trigger.Check(invItem) {
-							trigger.Action(player, target, invItem)
-							return
-						}
+					if !world.Emit(&world.InvOnPlayerEvent{Player: player, Target: target, Item: invItem}) {
+						player.SendPacket(world.DefaultActionMessage)
 					}
-					player.SendPacket(world.DefaultActionMessage)
-				}()
-				return true
+				})
+				return false
 			}
 			player.WalkTo(target.Location)
 			return false
@@ -341,38 +330,24 @@ func init() {
 			if definitions.Scenary(object.ID).CollisionType == 2 || definitions.Scenary(object.ID).CollisionType == 3 {
 				if (player.NextTo(bounds[1]) || player.NextTo(bounds[0])) && player.X() >= bounds[0].X() && player.Y() >= bounds[0].Y() && player.X() <= bounds[1].X() && player.Y() <= bounds[1].Y() {
 					player.ResetPath()
-					player.AddState(world.MSBatching)
-					go func() {
-						defer func() {
-							player.RemoveState(world.MSBatching)
-						}()
-						for _, fn := range world.InvOnObjectTriggers {
-							if fn(player, object, invItem) {
-								return
-							}
+					player.StartCoroutine(world.MSBatching, func() {
+						if !world.Emit(&world.InvOnObjectEvent{Player: player, Object: object, Item: invItem}) {
+							player.SendPacket(world.DefaultActionMessage)
 						}
-						player.SendPacket(world.DefaultActionMessage)
-					}()
-					return true
+					})
+					return false
 				}
 				player.WalkTo(object.Location)
 				return false
 			}
 			if player.AtObject(object) {
 				player.ResetPath()
-				player.AddState(world.MSBatching)
-				go func() {
-					defer func() {
-						player.RemoveState(world.MSBatching)
-					}()
-					for _, fn := range world.InvOnObjectTriggers {
-						if fn(player, object, invItem) {
-							return
-						}
+				player.StartCoroutine(world.MSBatching, func() {
+					if !world.Emit(&world.InvOnObjectEvent{Player: player, Object: object, Item: invItem}) {
+						player.SendPacket(world.DefaultActionMessage)
 					}
-					player.SendPacket(world.DefaultActionMessage)
-				}()
-				return true
+				})
+				return false
 			}
 			player.WalkTo(object.Location)
 			return false
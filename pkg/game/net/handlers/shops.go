@@ -52,11 +52,10 @@ func init() {
 
 			player.AddItem(id, 1)
 			shop.Remove(id, 1)
+			world.RecordItem(world.LedgerShopBuy, player.Username(), world.ShopAccount(shop), 10, price, player.X(), player.Y())
+			world.RecordItem(world.LedgerShopBuy, world.ShopAccount(shop), player.Username(), id, 1, player.X(), player.Y())
 			player.PlaySound("coins")
-			shop.Players.RangePlayers(func(player *world.Player) bool {
-				player.SendPacket(world.ShopOpen(shop))
-				return false
-			})
+			shop.Refresh()
 		}
 	})
 	game.AddHandler("shopsell", func(player *world.Player, p *net.Packet) {
@@ -83,13 +82,10 @@ func init() {
 			if player.Inventory.RemoveByID(id, 1) > -1 {
 				player.PlaySound("coins")
 				player.AddItem(10, price)
-				shop.Inventory.Add(&world.Item{ID: id, Amount: 1})
-				shop.Players.RangePlayers(func(player *world.Player) bool {
-					if shop == player.CurrentShop() {
-						player.SendPacket(world.ShopOpen(shop))
-					}
-					return false
-				})
+				shop.Add(id, 1)
+				world.RecordItem(world.LedgerShopSell, player.Username(), world.ShopAccount(shop), id, 1, player.X(), player.Y())
+				world.RecordItem(world.LedgerShopSell, world.ShopAccount(shop), player.Username(), 10, price, player.X(), player.Y())
+				shop.Refresh()
 			}
 		}
 	})
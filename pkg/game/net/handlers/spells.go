@@ -70,8 +70,8 @@ func init() {
 }
 
 func dispatchSpellAction(player *world.Player, idx int, target entity.MobileEntity) {
-	s, ok := world.SpellTriggers[idx]
-	if !ok || s == nil {
+	s, ok := world.SpellTrigger(idx)
+	if !ok {
 		log.Info.Printf("Couldn't find spell handler ID: %v, status=`%v`\n", idx, ok)
 		return
 	}
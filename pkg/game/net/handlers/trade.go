@@ -43,11 +43,10 @@ func init() {
 			if player.Busy() || p1.Busy() {
 				return
 			}
-			player.AddState(world.StateTrading)
+			player.OpenTrade(p1)
 			player.ResetPath()
 			player.SendPacket(world.TradeOpen(p1.Index))
 
-			p1.AddState(world.StateTrading)
 			p1.ResetPath()
 			p1.SendPacket(world.TradeOpen(player.Index))
 		} else {
@@ -170,7 +169,8 @@ func init() {
 			player.SendPacket(world.TradeClose)
 			return
 		}
-		if !target.IsTrading() || target.TradeTarget() != player.Index || player.TradeTarget() != target.Index || !target.VarBool("trade1accept", false) {
+		trade := player.Trade()
+		if !target.IsTrading() || target.TradeTarget() != player.Index || player.TradeTarget() != target.Index || !target.VarBool("trade1accept", false) || trade == nil || target.Trade() != trade {
 			log.Suspicious.Printf("Players{ 1:%v; 2:%v } involved in trade with apparently bad trade variables!\n", player.String(), target.String())
 			player.ResetTrade()
 			target.ResetTrade()
@@ -179,29 +179,7 @@ func init() {
 			return
 		}
 		player.SetVar("trade2accept", true)
-		if target.VarBool("trade2accept", false) {
-			neededSlots := target.TradeOffer.Size()
-			availSlots := player.Inventory.Capacity - player.Inventory.Size() + player.TradeOffer.Size()
-			theirNeededSlots := player.TradeOffer.Size()
-			theirAvailSlots := target.Inventory.Capacity - target.Inventory.Size() + target.TradeOffer.Size()
-			if theirNeededSlots > theirAvailSlots {
-				player.Message("The other player does not have room to accept your items.")
-				player.ResetTrade()
-				target.Message("You do not have room in your inventory to hold those items.")
-				target.ResetTrade()
-				player.SendPacket(world.TradeClose)
-				target.SendPacket(world.TradeClose)
-				return
-			}
-			if neededSlots > availSlots {
-				player.Message("You do not have room in your inventory to hold those items.")
-				player.ResetTrade()
-				target.Message("The other player does not have room to accept your items.")
-				target.ResetTrade()
-				player.SendPacket(world.TradeClose)
-				target.SendPacket(world.TradeClose)
-				return
-			}
+		if target.VarBool("trade2accept", false) && trade.Complete() {
 			defer func() {
 				player.SendPacket(world.InventoryItems(player))
 				player.SendPacket(world.TradeClose)
@@ -210,24 +188,39 @@ func init() {
 				target.SendPacket(world.TradeClose)
 				target.ResetTrade()
 			}()
-			if player.Inventory.RemoveAll(player.TradeOffer) != player.TradeOffer.Size() {
-				log.Suspicious.Printf("Players{ %v;2:%v } involved in a trade, player 1 did not have all items to give.", player.String(), target.String())
+			exchange := world.NewExchange("trade", player.Inventory, target.Inventory)
+			exchange.OfferAll(player.Inventory, player.TradeOffer)
+			exchange.OfferAll(target.Inventory, target.TradeOffer)
+			if err := exchange.Apply(); err != nil {
+				failed := err.(*world.ExchangeError)
+				if failed.Reason == world.ExchangeNoRoom {
+					if failed.Inventory == player.Inventory {
+						player.Message("You do not have room in your inventory to hold those items.")
+						target.Message("The other player does not have room to accept your items.")
+					} else {
+						player.Message("The other player does not have room to accept your items.")
+						target.Message("You do not have room in your inventory to hold those items.")
+					}
+					return
+				}
+				log.Suspicious.Printf("Players{ 1:%v; 2:%v } involved in a trade, but %v\n", player.String(), target.String(), err)
 				return
 			}
-			if target.Inventory.RemoveAll(target.TradeOffer) != target.TradeOffer.Size() {
-				log.Suspicious.Printf("Players{ %v;2:%v } involved in a trade, player 2 did not have all items to give.", player.String(), target.String())
-				return
-			}
-			for i := 0; i < target.TradeOffer.Size(); i++ {
-				item := target.TradeOffer.Get(i)
-				player.Inventory.Add(item.ID, item.Amount)
-			}
-			for i := 0; i < player.TradeOffer.Size(); i++ {
-				item := player.TradeOffer.Get(i)
-				target.Inventory.Add(item.ID, item.Amount)
-			}
+			var gave, received []*world.Item
+			target.TradeOffer.Range(func(item *world.Item) bool {
+				world.RecordItem(world.LedgerTrade, target.Username(), player.Username(), item.ID, item.Amount, player.X(), player.Y())
+				received = append(received, item)
+				return true
+			})
+			player.TradeOffer.Range(func(item *world.Item) bool {
+				world.RecordItem(world.LedgerTrade, player.Username(), target.Username(), item.ID, item.Amount, target.X(), target.Y())
+				gave = append(gave, item)
+				return true
+			})
 			player.Message("Trade completed.")
 			target.Message("Trade completed.")
+			world.Emit(&world.TradeCompleteEvent{Player: player, Target: target, Gave: gave, Received: received})
+			world.Emit(&world.TradeCompleteEvent{Player: target, Target: player, Gave: received, Received: gave})
 		}
 	})
 }
@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package handlers
+
+import (
+	"math/rand"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/spkaeros/rscgo/pkg/definitions"
+	"github.com/spkaeros/rscgo/pkg/game"
+	"github.com/spkaeros/rscgo/pkg/game/net"
+	"github.com/spkaeros/rscgo/pkg/game/world"
+	"github.com/spkaeros/rscgo/pkg/strutil"
+)
+
+const (
+	stressBots   = 16
+	stressRounds = 250
+	stressItems  = 32
+)
+
+//newTradeBot returns a player registered with the world that holds a random assortment of items.
+func newTradeBot(rng *rand.Rand, n int) *world.Player {
+	p := world.NewPlayer(nil)
+	p.SetVar("username", strutil.Base37.Encode("bot"+strconv.Itoa(n)))
+	world.Players.Put(p)
+	for i := 0; i < 10; i++ {
+		id := rng.Intn(stressItems)
+		if definitions.Items[id].Stackable {
+			p.Inventory.Add(id, 1+rng.Intn(1000))
+		} else {
+			p.Inventory.Add(id, 1)
+		}
+	}
+	return p
+}
+
+//offer fills the bots trade offer with a random selection of the items it holds.
+func offer(rng *rand.Rand, p *world.Player) {
+	p.Inventory.Range(func(item *world.Item) bool {
+		if p.TradeOffer.Size() < 5 && rng.Intn(3) == 0 {
+			amount := item.Amount
+			if item.Stackable() {
+				amount = 1 + rng.Intn(amount)
+			}
+			p.TradeOffer.Add(item.ID, amount)
+		}
+		return true
+	})
+}
+
+//totals returns the total amount of each item held by all of the provided players.
+func totals(players []*world.Player) map[int]int {
+	counts := make(map[int]int)
+	for _, p := range players {
+		p.Inventory.Range(func(item *world.Item) bool {
+			counts[item.ID] += item.Amount
+			return true
+		})
+	}
+	return counts
+}
+
+//TestTradeStress drives many concurrent trades between bot players, with both sides confirming at the same time,
+// and checks that no items were created or destroyed along the way.
+func TestTradeStress(t *testing.T) {
+	items := definitions.Items
+	t.Cleanup(func() {
+		definitions.Items = items
+	})
+	definitions.Items = make([]definitions.ItemDefinition, stressItems)
+	for i := range definitions.Items {
+		definitions.Items[i] = definitions.ItemDefinition{ID: i, Name: "item" + strconv.Itoa(i), Stackable: i%4 == 0}
+	}
+	world.DebugInvariants = true
+	defer func() {
+		world.DebugInvariants = false
+	}()
+
+	rng := rand.New(rand.NewSource(1994))
+	var bots []*world.Player
+	for i := 0; i < stressBots; i++ {
+		bots = append(bots, newTradeBot(rng, i))
+	}
+	defer func() {
+		for _, p := range bots {
+			world.Players.Remove(p)
+		}
+	}()
+	initial := totals(bots)
+
+	confirm := game.Handlers["tradeconfirmaccept"]
+	for round := 0; round < stressRounds; round++ {
+		rng.Shuffle(len(bots), func(i, j int) {
+			bots[i], bots[j] = bots[j], bots[i]
+		})
+		var wg sync.WaitGroup
+		for i := 0; i+1 < len(bots); i += 2 {
+			p1, p2 := bots[i], bots[i+1]
+			p1.OpenTrade(p2)
+			for _, pair := range [][2]*world.Player{{p1, p2}, {p2, p1}} {
+				pair[0].SetTradeTarget(pair[1].Index)
+				offer(rng, pair[0])
+				pair[0].SetVar("trade1accept", true)
+			}
+			wg.Add(2)
+			for _, p := range []*world.Player{p1, p2} {
+				go func(p *world.Player) {
+					defer wg.Done()
+					confirm(p, net.NewEmptyPacket(0))
+				}(p)
+			}
+		}
+		wg.Wait()
+		for _, p := range bots {
+			p.ResetTrade()
+		}
+	}
+
+	after := totals(bots)
+	for id, amount := range initial {
+		if after[id] != amount {
+			t.Errorf("item %d: started with %d in circulation, ended with %d", id, amount, after[id])
+		}
+	}
+	for id, amount := range after {
+		if _, ok := initial[id]; !ok {
+			t.Errorf("item %d: appeared from nowhere, %d in circulation", id, amount)
+		}
+	}
+	if failures := world.InvariantFailures.Load(); failures > 0 {
+		t.Errorf("%d audited transfers were rolled back", failures)
+	}
+}
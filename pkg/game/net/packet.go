@@ -342,6 +342,27 @@ func (p *Packet) AddBitmask(value int, numBits int) *Packet {
 
 }
 
+//Frame Returns the bytes to put on the wire for this packet.  Bare packets are returned as-is, and all others are
+// prefixed by their length header.
+func (p *Packet) Frame() []byte {
+	if p.Opcode == 0 {
+		return p.FrameBuffer
+	}
+	header := []byte{0, 0}
+	frameLength := len(p.FrameBuffer)
+	if frameLength >= 160 {
+		header[0] = byte(frameLength>>8 + 160)
+		header[1] = byte(frameLength)
+	} else {
+		header[0] = byte(frameLength)
+		if frameLength > 0 {
+			frameLength--
+			header[1] = p.FrameBuffer[frameLength]
+		}
+	}
+	return append(header, p.FrameBuffer[:frameLength]...)
+}
+
 //Length returns length of byte buffer.
 func (p *Packet) Length() int {
 	return len(p.FrameBuffer)
@@ -0,0 +1,282 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spkaeros/rscgo/pkg/crypto"
+	"github.com/spkaeros/rscgo/pkg/log"
+)
+
+// Bank PINs and bank organisation.
+//
+// The 204 client has no keypad, bank tabs or search box, so PINs are entered through option menus that show the
+// digits in a different, random order every time, and tabs, searching and rearranging are driven by the ::bank
+// command.  Whatever tab and search filter the player has selected decides which items the bank screen shows them.
+// Players may also keep placeholders: withdrawing all of an item leaves an empty slot behind, holding its place, its
+// tab and its position until more is deposited or the placeholder is released.
+
+const (
+	//BankPinLength How many digits a bank PIN has.
+	BankPinLength = 4
+	//BankPinAttempts How many wrong PINs may be entered in a row before the bank is locked.
+	BankPinAttempts = 3
+	//BankPinLockout How long the bank stays locked after too many wrong PINs.
+	BankPinLockout = 5 * time.Minute
+	//BankTabs How many tabs items may be sorted into.  Tab 0 always shows every item.
+	BankTabs = 9
+)
+
+//HasBankPin returns true if this player has protected their bank with a PIN.
+func (p *Player) HasBankPin() bool {
+	return len(p.Attributes.VarString("bankPin", "")) > 0
+}
+
+//SetBankPin protects this players bank with pin, or removes their PIN if pin is empty.
+func (p *Player) SetBankPin(pin string) {
+	if len(pin) == 0 {
+		p.Attributes.UnsetVar("bankPin")
+		return
+	}
+	p.Attributes.SetVar("bankPin", crypto.Hash(pin))
+}
+
+//EnterBankPin asks the player to enter a PIN through a series of option menus, and returns it.  Each digit is picked
+// in two steps, first from five pairs of digits in a random order, and then from the chosen pair.
+// Returns an empty string if the player closes any of the menus.
+func (p *Player) EnterBankPin(prompt string) string {
+	p.Message(prompt)
+	pin := ""
+	for len(pin) < BankPinLength {
+		digits := rand.Perm(10)
+		var pairs []string
+		for i := 0; i < len(digits); i += 2 {
+			pairs = append(pairs, strconv.Itoa(digits[i])+" or "+strconv.Itoa(digits[i+1]))
+		}
+		pair := p.openOptionMenu(false, pairs...)
+		if pair < 0 {
+			return ""
+		}
+		digit := p.openOptionMenu(false, strconv.Itoa(digits[pair*2]), strconv.Itoa(digits[pair*2+1]))
+		if digit < 0 {
+			return ""
+		}
+		pin += strconv.Itoa(digits[pair*2+digit])
+		p.Message("PIN: " + strings.Repeat("*", len(pin)) + strings.Repeat("-", BankPinLength-len(pin)))
+	}
+	return pin
+}
+
+//verifyBankPin asks the player for their current PIN, keeping count of wrong attempts and locking the bank when
+// there have been too many.  Returns true if the right PIN was entered.
+func (p *Player) verifyBankPin() bool {
+	if until := p.Attributes.VarTime("bankPinLockTimer"); time.Now().Before(until) {
+		p.Message(fmt.Sprintf("You have entered too many wrong PINs.  Please try again in %d minutes.", int(math.Ceil(time.Until(until).Minutes()))))
+		return false
+	}
+	pin := p.EnterBankPin("Please enter your bank PIN.")
+	if len(pin) == 0 {
+		return false
+	}
+	if crypto.Hash(pin) != p.Attributes.VarString("bankPin", "") {
+		failures := p.Attributes.VarInt("bankPinFailures", 0) + 1
+		if failures >= BankPinAttempts {
+			p.Attributes.UnsetVar("bankPinFailures")
+			p.Attributes.SetVar("bankPinLockTimer", time.Now().Add(BankPinLockout))
+			log.Suspicious.Println(p, "entered", failures, "wrong bank PINs in a row; locking their bank")
+			p.Message(fmt.Sprintf("That PIN was wrong.  Your bank has been locked for %d minutes.", int(BankPinLockout.Minutes())))
+			return false
+		}
+		p.Attributes.SetVar("bankPinFailures", failures)
+		p.Message(fmt.Sprintf("That PIN was wrong.  You have %d attempts left.", BankPinAttempts-failures))
+		return false
+	}
+	p.Attributes.UnsetVar("bankPinFailures")
+	return true
+}
+
+//UnlockBank makes sure the player has entered their bank PIN this session, asking for it if not.
+// Returns true if the bank may be opened.
+func (p *Player) UnlockBank() bool {
+	if !p.HasBankPin() || p.VarBool("bankUnlocked", false) {
+		return true
+	}
+	if !p.verifyBankPin() {
+		return false
+	}
+	p.SetVar("bankUnlocked", true)
+	return true
+}
+
+//ManageBankPin walks the player through setting, changing or removing their bank PIN.
+func (p *Player) ManageBankPin() {
+	if p.HasBankPin() {
+		choice := p.openOptionMenu(false, "Change my PIN", "Remove my PIN", "Never mind")
+		if choice < 0 || choice > 1 || !p.verifyBankPin() {
+			return
+		}
+		if choice == 1 {
+			p.SetBankPin("")
+			p.UnsetVar("bankUnlocked")
+			p.Message("Your bank PIN has been removed.")
+			return
+		}
+	} else if p.openOptionMenu(false, "Set a bank PIN", "Never mind") != 0 {
+		return
+	}
+	pin := p.EnterBankPin("Please choose a new " + strconv.Itoa(BankPinLength) + " digit PIN.")
+	if len(pin) == 0 {
+		return
+	}
+	if p.EnterBankPin("Please enter your new PIN again to confirm it.") != pin {
+		p.Message("Those PINs did not match.  Your PIN has not been changed.")
+		return
+	}
+	p.SetBankPin(pin)
+	p.SetVar("bankUnlocked", true)
+	p.Message("Your bank PIN has been set.  Don't tell it to anybody!")
+}
+
+//bankTabs returns the tab that each of this players tabbed bank items has been sorted into, by item ID.
+func (p *Player) bankTabs() map[int]int {
+	tabs := make(map[int]int)
+	for _, entry := range strings.Split(p.Attributes.VarString("bankTabs", ""), ",") {
+		parts := strings.Split(entry, ":")
+		if len(parts) != 2 {
+			continue
+		}
+		id, err := strconv.Atoi(parts[0])
+		tab, err2 := strconv.Atoi(parts[1])
+		if err != nil || err2 != nil {
+			continue
+		}
+		tabs[id] = tab
+	}
+	return tabs
+}
+
+//BankTab returns the tab that the item with the provided ID is sorted into, or 0 if it has not been sorted.
+func (p *Player) BankTab(id int) int {
+	return p.bankTabs()[id]
+}
+
+//SetBankTab sorts the item with the provided ID into tab.  Tab 0 unsorts it.  Items no longer in the bank are
+// forgotten about at the same time.
+func (p *Player) SetBankTab(id, tab int) {
+	tabs := p.bankTabs()
+	tabs[id] = tab
+	var ids []int
+	for id, tab := range tabs {
+		if tab > 0 && tab <= BankTabs && p.bank.GetIndex(id) >= 0 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	var entries []string
+	for _, id := range ids {
+		entries = append(entries, strconv.Itoa(id)+":"+strconv.Itoa(tabs[id]))
+	}
+	if len(entries) == 0 {
+		p.Attributes.UnsetVar("bankTabs")
+		return
+	}
+	p.Attributes.SetVar("bankTabs", strings.Join(entries, ","))
+}
+
+//SetBankView selects which tab of the bank to show, and filters it down to items whose names contain search.
+// Tab 0 shows every tab, and an empty search shows every item.
+func (p *Player) SetBankView(tab int, search string) {
+	p.SetVar("bankViewTab", tab)
+	p.SetVar("bankSearch", strings.ToLower(strings.TrimSpace(search)))
+}
+
+//BankFiltered returns true if the bank screen is showing anything other than every item in the bank, in order.
+func (p *Player) BankFiltered() bool {
+	return p.VarInt("bankViewTab", 0) != 0 || len(p.VarString("bankSearch", "")) > 0
+}
+
+//BankView returns the bank items that the player has chosen to show on their bank screen, in order.
+func (p *Player) BankView() []*Item {
+	tab := p.VarInt("bankViewTab", 0)
+	search := p.VarString("bankSearch", "")
+	tabs := p.bankTabs()
+	var view []*Item
+	p.bank.Range(func(item *Item) bool {
+		if (tab == 0 || tabs[item.ID] == tab) && (len(search) == 0 || strings.Contains(strings.ToLower(item.Name()), search)) {
+			view = append(view, item)
+		}
+		return true
+	})
+	return view
+}
+
+//bankIndex returns the index within the whole bank of the item shown at slot on the bank screen, or -1.
+func (p *Player) bankIndex(slot int) int {
+	view := p.BankView()
+	if slot < 0 || slot >= len(view) {
+		return -1
+	}
+	return p.bank.GetIndex(view[slot].ID)
+}
+
+//SwapBankSlots swaps the items shown at slots a and b on the bank screen.
+func (p *Player) SwapBankSlots(a, b int) bool {
+	return p.bank.Swap(p.bankIndex(a), p.bankIndex(b))
+}
+
+//InsertBankSlot moves the item shown at slot from on the bank screen to slot to, shifting everything between over.
+func (p *Player) InsertBankSlot(from, to int) bool {
+	return p.bank.Insert(p.bankIndex(from), p.bankIndex(to))
+}
+
+//BankPlaceholders returns true if this player keeps a placeholder in their bank for items they withdraw all of.
+func (p *Player) BankPlaceholders() bool {
+	return p.Attributes.VarBool("bankPlaceholders", false)
+}
+
+//SetBankPlaceholders decides whether this player keeps placeholders for items they withdraw all of.
+func (p *Player) SetBankPlaceholders(keep bool) {
+	if !keep {
+		p.Attributes.UnsetVar("bankPlaceholders")
+		return
+	}
+	p.Attributes.SetVar("bankPlaceholders", true)
+}
+
+//LeaveBankPlaceholder holds index idx of the bank for the item with the provided ID, if this player keeps
+// placeholders and has just withdrawn the last of it.  Returns true if a placeholder was left.
+func (p *Player) LeaveBankPlaceholder(idx, id int) bool {
+	return p.BankPlaceholders() && p.bank.Placeholder(idx, id)
+}
+
+//RefreshBank resends the whole bank screen, if it is open.
+func (p *Player) RefreshBank() {
+	if p.HasState(StateBanking) {
+		p.SendPacket(BankOpen(p))
+	}
+}
+
+//UpdateBankItem tells the client that the item at index idx in the bank is now amount of id.  If the bank screen is
+// only showing some of the bank, indexes do not line up with the screen, so the whole screen is resent instead.
+func (p *Player) UpdateBankItem(idx, id, amount int) {
+	if p.BankFiltered() {
+		p.RefreshBank()
+		return
+	}
+	p.SendPacket(BankUpdateItem(idx, id, amount))
+}
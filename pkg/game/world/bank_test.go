@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+
+package world
+
+import (
+	"testing"
+)
+
+//moveItems moves amount of id from one inventory to another through an exchange, failing the test if it can not.
+func moveItems(t *testing.T, from, to *Inventory, id, amount int) {
+	t.Helper()
+	e := NewExchange("test", from, to)
+	e.Offer(from, id, amount)
+	if err := e.Apply(); err != nil {
+		t.Fatalf("moving %d of item %d: %v", amount, id, err)
+	}
+}
+
+func TestBankPlaceholders(t *testing.T) {
+	groundTestItems(t)
+	p := NewPlayer(nil)
+	p.Bank().List = []*Item{{ID: 1, Amount: 1}, {ID: 0, Amount: 50}, {ID: 2, Amount: 1}}
+
+	moveItems(t, p.Bank(), p.Inventory, 0, 50)
+	if p.LeaveBankPlaceholder(1, 0) {
+		t.Fatal("left a placeholder without the player keeping them")
+	}
+	moveItems(t, p.Inventory, p.Bank(), 0, 50)
+
+	p.SetBankPlaceholders(true)
+	moveItems(t, p.Bank(), p.Inventory, 0, 50)
+	if !p.LeaveBankPlaceholder(1, 0) {
+		t.Fatal("withdrawing all of an item left no placeholder")
+	}
+	if item := p.Bank().Get(1); item == nil || item.ID != 0 || item.Amount != 0 || p.Bank().Size() != 3 {
+		t.Fatalf("bank holds %v, want a placeholder for item 0 in the middle", p.Bank().List)
+	}
+	if p.LeaveBankPlaceholder(1, 0) {
+		t.Error("left a second placeholder for the same item")
+	}
+
+	moveItems(t, p.Inventory, p.Bank(), 0, 20)
+	if item := p.Bank().Get(1); item.ID != 0 || item.Amount != 20 || p.Bank().Size() != 3 {
+		t.Fatalf("bank holds %v, want the deposit back in its placeholder", p.Bank().List)
+	}
+
+	moveItems(t, p.Bank(), p.Inventory, 2, 1)
+	p.LeaveBankPlaceholder(2, 2)
+	if released := p.Bank().ReleasePlaceholders(-1); released != 1 || p.Bank().Size() != 2 {
+		t.Errorf("released %d placeholders, bank holds %v, want just the one released", released, p.Bank().List)
+	}
+}
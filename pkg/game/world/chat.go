@@ -17,3 +17,8 @@ func NewTargetedMessage(owner entity.MobileEntity, target entity.MobileEntity, c
 func NewChatMessage(owner entity.MobileEntity, content string) ChatMessage {
 	return ChatMessage{owner, nil, content}
 }
+
+//Message Returns the text of this chat message.
+func (m ChatMessage) Message() string {
+	return m.string
+}
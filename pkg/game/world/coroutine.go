@@ -0,0 +1,264 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"context"
+	"sync"
+
+	"github.com/spkaeros/rscgo/pkg/game/net"
+	"github.com/spkaeros/rscgo/pkg/tasks"
+)
+
+//Coroutine A script action being carried out for a player, that may span many ticks.  A coroutine runs on a goroutine
+// of its own, but only ever one piece at a time: it runs until it parks to wait on ticks passing or on its player's
+// input, and whatever set it running, whether that is StartCoroutine or the engine's tick waking it back up, waits for
+// it to park or finish before carrying on.  It is cancelled as soon as its player walks away, logs out or enters
+// combat, which wakes it from whatever it is waiting on and interrupts any script it is running.
+type Coroutine struct {
+	player *Player
+	state  MobState
+	ctx    context.Context
+	cancel context.CancelFunc
+	// reason says why the coroutine was cancelled, if it was.
+	reason string
+	// wake is closed to resume the coroutine from where it is parked, and is nil while it is running.
+	wake chan struct{}
+	// sleep is how many more ticks until a coroutine parked in SleepTicks is woken.
+	sleep int
+	// awaiting lists the opcodes that a coroutine parked in AwaitInput is waiting on a packet of, and input holds the
+	// packet its player sent until it is woken to take it.
+	awaiting []byte
+	input    *net.Packet
+	// parked receives each time the coroutine parks, and done is closed once it has finished, so that whatever set
+	// it running can wait for it to give control back.
+	parked chan struct{}
+	done   chan struct{}
+	sync.Mutex
+}
+
+//StartCoroutine Runs fn as a coroutine for this player, with the player in state until it returns, and waits for it to
+// park or finish.  Any coroutine that the player was already running is cancelled first.  A state of StateIdle leaves
+// the players state alone.
+func (p *Player) StartCoroutine(state MobState, fn func()) *Coroutine {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &Coroutine{player: p, state: state, ctx: ctx, cancel: cancel, parked: make(chan struct{}, 1), done: make(chan struct{})}
+	p.coroutineLock.Lock()
+	if old := p.coroutine; old != nil {
+		old.Cancel("interrupted")
+		// the old coroutine no longer owns the player, so it leaves its state to us
+		if old.state != StateIdle {
+			p.RemoveState(old.state)
+		}
+	}
+	p.coroutine = c
+	if state != StateIdle {
+		p.AddState(state)
+	}
+	p.coroutineLock.Unlock()
+	tasks.TickList.Add(c.tick)
+	go func() {
+		defer func() {
+			c.cancel()
+			p.coroutineLock.Lock()
+			if p.coroutine == c {
+				p.coroutine = nil
+				if state != StateIdle {
+					p.RemoveState(state)
+				}
+			}
+			p.coroutineLock.Unlock()
+			close(c.done)
+		}()
+		fn()
+	}()
+	c.yielded()
+	return c
+}
+
+//Coroutine Returns the coroutine this player is running, or nil if they are not running one.
+func (p *Player) Coroutine() *Coroutine {
+	p.coroutineLock.Lock()
+	defer p.coroutineLock.Unlock()
+	return p.coroutine
+}
+
+//StopCoroutine Cancels the coroutine this player is running, if there is one, for the given reason.
+func (p *Player) StopCoroutine(reason string) {
+	if c := p.Coroutine(); c != nil {
+		c.Cancel(reason)
+	}
+}
+
+//coroutineContext Returns the context of the coroutine this player is running, which is done once it is cancelled.
+// Players that are not running a coroutine get a context that is never done.
+func (p *Player) coroutineContext() context.Context {
+	if c := p.Coroutine(); c != nil {
+		return c.ctx
+	}
+	return context.Background()
+}
+
+//SleepTicks Waits for n engine ticks to pass.  Returns false without waiting them all out if the coroutine this player
+// is running gets cancelled, in which case the caller should give up on what it was doing.  Outside of a coroutine this
+// sleeps for as long as n ticks would take.
+func (p *Player) SleepTicks(n int) bool {
+	c := p.Coroutine()
+	if c == nil {
+		Sleep(ticksDuration(n))
+		return true
+	}
+	return c.SleepTicks(n)
+}
+
+//SleepTicks Parks this coroutine until n engine ticks have passed.  Returns false early if it was cancelled.
+func (c *Coroutine) SleepTicks(n int) bool {
+	return c.park(n, nil)
+}
+
+//park Gives control back to whatever set this coroutine running, until its tick wakes it: after sleep ticks, or once
+// its player sends a packet with one of the awaiting opcodes if there are any.  Returns false, straight away if need
+// be, once the coroutine is cancelled.
+func (c *Coroutine) park(sleep int, awaiting []byte) bool {
+	if c.ctx.Err() != nil {
+		return false
+	}
+	c.Lock()
+	c.sleep, c.awaiting, c.input = sleep, awaiting, nil
+	wake := make(chan struct{})
+	c.wake = wake
+	c.Unlock()
+	select {
+	case c.parked <- struct{}{}:
+	default:
+	}
+	select {
+	case <-wake:
+		return c.ctx.Err() == nil
+	case <-c.ctx.Done():
+		return false
+	}
+}
+
+//yielded Waits for this coroutine to park or finish, after setting it running.
+func (c *Coroutine) yielded() {
+	select {
+	case <-c.parked:
+	case <-c.done:
+	}
+}
+
+//AwaitInput Waits for this player to send a packet with one of the given opcodes, and returns it.  The packet goes
+// to the waiting coroutine instead of its usual handler.  Returns false if the coroutine this player is running gets
+// cancelled first, or if they are not running one at all, since nothing else may wait on the engine's packets.
+func (p *Player) AwaitInput(opcodes ...byte) (*net.Packet, bool) {
+	c := p.Coroutine()
+	if c == nil {
+		return nil, false
+	}
+	return c.AwaitInput(opcodes...)
+}
+
+//AwaitInput Parks this coroutine until its player sends a packet with one of the given opcodes.  Returns false early
+// if it was cancelled.
+func (c *Coroutine) AwaitInput(opcodes ...byte) (*net.Packet, bool) {
+	if !c.park(0, opcodes) {
+		return nil, false
+	}
+	c.Lock()
+	defer c.Unlock()
+	packet := c.input
+	c.awaiting, c.input = nil, nil
+	return packet, true
+}
+
+//HandleInput Hands packet to the coroutine this player is running, if it is parked waiting on a packet like it.
+// Returns true if it was taken, in which case the packet must not be handled anywhere else.  The coroutine is woken to
+// take it by its next tick.  Called by the engine for every packet before its handler.
+func (p *Player) HandleInput(packet *net.Packet) bool {
+	c := p.Coroutine()
+	if c == nil {
+		return false
+	}
+	c.Lock()
+	defer c.Unlock()
+	if c.wake == nil || c.input != nil || c.ctx.Err() != nil {
+		return false
+	}
+	for _, opcode := range c.awaiting {
+		if opcode == packet.Opcode {
+			c.input = packet
+			return true
+		}
+	}
+	return false
+}
+
+//Cancel Stops this coroutine for the given reason, waking it from anything it is waiting on.
+func (c *Coroutine) Cancel(reason string) {
+	c.Lock()
+	defer c.Unlock()
+	if c.ctx.Err() == nil {
+		c.reason = reason
+	}
+	c.cancel()
+}
+
+//Reason Returns why this coroutine was cancelled, or an empty string if it has not been.
+func (c *Coroutine) Reason() string {
+	c.Lock()
+	defer c.Unlock()
+	return c.reason
+}
+
+//Done Returns a channel that is closed once this coroutine has finished or been cancelled.
+func (c *Coroutine) Done() <-chan struct{} {
+	return c.ctx.Done()
+}
+
+//tick Drives this coroutine from the engine tick: it is cancelled if its player has logged out or entered combat, and
+// woken up if its time is up or the input it awaits has arrived, in which case this waits for it to park again or
+// finish.  Returns true once the coroutine is over, to stop being ticked.
+func (c *Coroutine) tick() bool {
+	if c.ctx.Err() == nil {
+		if !c.player.Connected() {
+			c.Cancel("logged out")
+		} else if c.player.IsFighting() {
+			c.Cancel("entered combat")
+		}
+	}
+	if c.ctx.Err() != nil {
+		// let a cancelled coroutine finish unwinding before the engine carries on
+		<-c.done
+		return true
+	}
+	c.Lock()
+	if c.wake == nil || c.awaiting != nil && c.input == nil {
+		c.Unlock()
+		return false
+	}
+	if c.awaiting == nil {
+		c.sleep--
+		if c.sleep > 0 {
+			c.Unlock()
+			return false
+		}
+	}
+	close(c.wake)
+	c.wake = nil
+	c.Unlock()
+	c.yielded()
+	if c.ctx.Err() != nil {
+		<-c.done
+		return true
+	}
+	return false
+}
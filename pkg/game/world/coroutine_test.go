@@ -0,0 +1,263 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spkaeros/rscgo/pkg/game/net"
+	"github.com/spkaeros/rscgo/pkg/tasks"
+)
+
+//discard A client connection that throws away everything written to it.
+type discard struct{}
+
+func (discard) Write(data []byte) (int, error) {
+	return len(data), nil
+}
+
+func (discard) Flush() error {
+	return nil
+}
+
+//sleeper Starts a coroutine for p that sleeps for ticks, and returns it along with a channel that receives what
+// SleepTicks returned.
+func sleeper(p *Player, ticks int) (*Coroutine, chan bool) {
+	woke := make(chan bool, 1)
+	c := p.StartCoroutine(MSBatching, func() {
+		woke <- p.SleepTicks(ticks)
+	})
+	return c, woke
+}
+
+//finished Waits for the coroutine to send what SleepTicks returned on woke, and checks that it left the player.
+func finished(t *testing.T, p *Player, woke chan bool) bool {
+	select {
+	case ok := <-woke:
+		deadline := time.Now().Add(time.Second)
+		for p.Coroutine() != nil && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond)
+		}
+		if p.Coroutine() != nil || p.HasState(MSBatching) {
+			t.Error("finished coroutine was left on the player")
+		}
+		return ok
+	case <-time.After(time.Second):
+		t.Fatal("coroutine never woke up")
+	}
+	return false
+}
+
+func TestCoroutineSleepsTicks(t *testing.T) {
+	p := NewPlayer(nil)
+	p.SetConnected(true)
+	c, woke := sleeper(p, 2)
+	if !p.HasState(MSBatching) || !p.CanWalk() {
+		t.Error("player running a coroutine should be batching, and free to walk away")
+	}
+	tasks.TickList.Tick()
+	select {
+	case <-woke:
+		t.Fatal("coroutine woke up a tick early")
+	default:
+	}
+	tasks.TickList.Tick()
+	select {
+	case ok := <-woke:
+		if !ok {
+			t.Error("SleepTicks reported a cancel after sleeping undisturbed")
+		}
+	default:
+		t.Fatal("tick returned before the coroutine it woke up had finished")
+	}
+	if p.Coroutine() != nil || p.HasState(MSBatching) {
+		t.Error("finished coroutine was left on the player")
+	}
+	if c.Reason() != "" {
+		t.Errorf("coroutine that ran to the end was cancelled: %q", c.Reason())
+	}
+}
+
+func TestCoroutineTakesTurns(t *testing.T) {
+	p := NewPlayer(nil)
+	p.SetConnected(true)
+	steps := 0
+	p.StartCoroutine(MSBatching, func() {
+		for steps < 3 && p.SleepTicks(1) {
+			steps++
+		}
+	})
+	if steps != 0 {
+		t.Fatalf("coroutine took %d steps before its first tick", steps)
+	}
+	for tick := 1; tick <= 3; tick++ {
+		tasks.TickList.Tick()
+		// the tick only returns once the coroutine has parked again, so there is no racing it
+		if steps != tick {
+			t.Fatalf("coroutine took %d steps in %d ticks", steps, tick)
+		}
+	}
+	if p.Coroutine() != nil {
+		t.Error("finished coroutine was left on the player")
+	}
+}
+
+func TestCoroutineCancels(t *testing.T) {
+	for _, test := range []struct {
+		reason string
+		cancel func(p *Player)
+	}{
+		{"walked away", func(p *Player) { p.ResetAll() }},
+		{"logged out", func(p *Player) { p.SetConnected(false) }},
+		{"entered combat", func(p *Player) { p.AddState(StateFighting) }},
+	} {
+		p := NewPlayer(nil)
+		p.SetConnected(true)
+		c, woke := sleeper(p, 100)
+		test.cancel(p)
+		tasks.TickList.Tick()
+		if finished(t, p, woke) {
+			t.Errorf("%s: SleepTicks did not report the cancel", test.reason)
+		}
+		if c.Reason() != test.reason {
+			t.Errorf("%s: got cancel reason %q", test.reason, c.Reason())
+		}
+	}
+}
+
+func TestCoroutineMenu(t *testing.T) {
+	p := NewPlayer(nil)
+	p.Writer = discard{}
+	p.SetConnected(true)
+	if p.OpenOptionMenu("Yes", "No") != -1 || p.HasState(StateMenu) {
+		t.Fatal("option menu was opened outside of a coroutine, with nothing to wait on the reply")
+	}
+	reply := make(chan int, 1)
+	p.StartCoroutine(StateChatting, func() {
+		reply <- p.OpenOptionMenu("Yes", "No")
+	})
+	if !p.HasState(StateMenu) {
+		t.Fatal("coroutine never opened the menu")
+	}
+	if !p.HandleInput(net.NewPacket(optionMenuReply, []byte{1})) {
+		t.Fatal("menu did not take the reply")
+	}
+	tasks.TickList.Tick()
+	select {
+	case r := <-reply:
+		if r != 1 || p.HasState(StateMenu) {
+			t.Errorf("menu returned %d, menu still open: %v", r, p.HasState(StateMenu))
+		}
+	default:
+		t.Fatal("menu was not answered by the tick after the reply")
+	}
+}
+
+func TestCoroutineCancelsMenu(t *testing.T) {
+	p := NewPlayer(nil)
+	reply := make(chan int, 1)
+	p.StartCoroutine(StateChatting, func() {
+		reply <- p.OpenOptionMenu("Yes", "No")
+	})
+	if !p.HasState(StateMenu) {
+		t.Fatal("coroutine never opened the menu")
+	}
+	p.StopCoroutine("walked away")
+	select {
+	case r := <-reply:
+		if r != -1 || p.HasState(StateMenu) {
+			t.Errorf("cancelled menu returned %d, menu still open: %v", r, p.HasState(StateMenu))
+		}
+	case <-time.After(time.Second):
+		t.Fatal("menu kept waiting on a reply after its coroutine was cancelled")
+	}
+}
+
+func TestCoroutineReplaced(t *testing.T) {
+	p := NewPlayer(nil)
+	p.SetConnected(true)
+	old, oldWoke := sleeper(p, 5)
+	c, woke := sleeper(p, 1)
+	select {
+	case ok := <-oldWoke:
+		if ok || old.Reason() != "interrupted" {
+			t.Errorf("replaced coroutine woke with %v, cancel reason %q", ok, old.Reason())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("replaced coroutine never woke up")
+	}
+	// the tick lets the old coroutine finish its cleanup, which must leave the new one alone
+	tasks.TickList.Tick()
+	if !finished(t, p, woke) {
+		t.Error("SleepTicks reported a cancel after sleeping undisturbed")
+	}
+	if c.Reason() != "" {
+		t.Errorf("replacing coroutine was cancelled: %q", c.Reason())
+	}
+}
+
+func TestCoroutineAwaitsInput(t *testing.T) {
+	p := NewPlayer(nil)
+	p.SetConnected(true)
+	if p.HandleInput(net.NewEmptyPacket(45)) {
+		t.Error("packet was taken with no coroutine waiting on it")
+	}
+	got := make(chan *net.Packet, 1)
+	p.StartCoroutine(StateChatting, func() {
+		packet, _ := p.AwaitInput(45, 46)
+		got <- packet
+	})
+	if p.HandleInput(net.NewEmptyPacket(12)) {
+		t.Error("coroutine took a packet it was not waiting on")
+	}
+	reply := net.NewEmptyPacket(46)
+	if !p.HandleInput(reply) {
+		t.Fatal("coroutine did not take the packet it was waiting on")
+	}
+	if p.HandleInput(net.NewEmptyPacket(45)) {
+		t.Error("coroutine took a second packet before it was woken for the first")
+	}
+	select {
+	case <-got:
+		t.Fatal("coroutine ran on before its tick")
+	default:
+	}
+	tasks.TickList.Tick()
+	select {
+	case packet := <-got:
+		if packet != reply {
+			t.Errorf("AwaitInput returned %v, want the reply", packet)
+		}
+	default:
+		t.Fatal("coroutine never received its input")
+	}
+	if p.HandleInput(net.NewEmptyPacket(46)) {
+		t.Error("packet was taken after the coroutine stopped waiting")
+	}
+
+	c := p.StartCoroutine(StateChatting, func() {
+		_, ok := p.AwaitInput(45)
+		if ok {
+			got <- nil
+		}
+		close(got)
+	})
+	c.Cancel("walked away")
+	select {
+	case _, ok := <-got:
+		if ok {
+			t.Error("cancelled AwaitInput reported input")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("AwaitInput kept waiting after its coroutine was cancelled")
+	}
+}
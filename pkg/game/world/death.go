@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"strings"
+
+	"github.com/spkaeros/rscgo/pkg/game/entity"
+	"github.com/spkaeros/rscgo/pkg/log"
+)
+
+// Death rules.
+//
+// What a player keeps when they die, whether they leave bones, and where they respawn are all decided by a DeathPolicy.
+// The policy fills in a PlayerDeath describing the players death, and then every script registered with bind.onDeath gets a
+// chance to change it before it is carried out.  onDeath scripts run on the game engine, so they must not block, e.g:
+//
+//	bind.onDeath(func(death) {
+//		if death.Player.Wilderness() == 0 && death.Player.Rank() == 2 {
+//			death.Keep = KEEP_ALL
+//		}
+//	})
+
+const (
+	//ProtectItemPrayer The index of the prayer that lets a player keep one extra item when they die.
+	ProtectItemPrayer = 8
+	//KeepAll A PlayerDeath.Keep value meaning the player keeps every item.
+	KeepAll = -1
+	//WildernessKeepStep How many levels deep into the wilderness the wilderness policy goes before keeping one item fewer.
+	WildernessKeepStep = 15
+)
+
+//PlayerDeath Describes what happens to a player who has just died.
+type PlayerDeath struct {
+	Player *Player
+	Killer entity.MobileEntity
+	//Keep How many of their most valuable items the player keeps, or KeepAll.
+	Keep int
+	//Bones Whether the player leaves bones behind.
+	Bones bool
+	//Unskull Whether the players skull is removed.
+	Unskull bool
+	//Respawn Where the player respawns.
+	Respawn Location
+	//Gravestone How many ticks the players drops are held for them alone before anyone else may take them.
+	// 0 means the drops go to whoever killed them, or straight to everybody.
+	Gravestone int
+}
+
+//DeathPolicy Decides the rules for a players death.
+type DeathPolicy interface {
+	Apply(death *PlayerDeath)
+}
+
+//ClassicDeathPolicy Players keep their 3 most valuable items unless skulled, plus one with Protect Item, leave bones,
+// lose their skull and respawn at SpawnPoint.
+type ClassicDeathPolicy struct{}
+
+//Apply fills in the classic rules.
+func (ClassicDeathPolicy) Apply(death *PlayerDeath) {
+	death.Keep = 0
+	if !death.Player.Skulled() {
+		death.Keep += 3
+	}
+	if death.Player.PrayerActivated(ProtectItemPrayer) {
+		death.Keep++
+	}
+	death.Bones = true
+	death.Unskull = true
+	death.Respawn = SpawnPoint
+}
+
+//HardcoreDeathPolicy The classic rules, except players never keep anything.
+type HardcoreDeathPolicy struct{}
+
+//Apply fills in the hardcore rules.
+func (HardcoreDeathPolicy) Apply(death *PlayerDeath) {
+	ClassicDeathPolicy{}.Apply(death)
+	death.Keep = 0
+}
+
+//SafeZoneDeathPolicy The classic rules, except players keep everything when they die within one of Zones.
+type SafeZoneDeathPolicy struct {
+	Zones [][2]Location
+}
+
+//Apply fills in the safe zone rules.
+func (s SafeZoneDeathPolicy) Apply(death *PlayerDeath) {
+	ClassicDeathPolicy{}.Apply(death)
+	for _, zone := range s.Zones {
+		if death.Player.WithinArea(zone) {
+			death.Keep = KeepAll
+			return
+		}
+	}
+}
+
+//WildernessDeathPolicy Players keep everything outside of the wilderness.  Inside it the classic rules apply, but
+// players keep one item fewer for every WildernessKeepStep levels deep they die.
+type WildernessDeathPolicy struct{}
+
+//Apply fills in the wilderness rules.
+func (WildernessDeathPolicy) Apply(death *PlayerDeath) {
+	ClassicDeathPolicy{}.Apply(death)
+	level := death.Player.Wilderness()
+	if level <= 0 {
+		death.Keep = KeepAll
+		return
+	}
+	if death.Keep -= (level - 1) / WildernessKeepStep; death.Keep < 0 {
+		death.Keep = 0
+	}
+}
+
+//DeathPolicies The death policies that can be chosen in the server config, by name.
+var DeathPolicies = map[string]DeathPolicy{
+	"classic":    ClassicDeathPolicy{},
+	"hardcore":   HardcoreDeathPolicy{},
+	"safezone":   SafeZoneDeathPolicy{},
+	"wilderness": WildernessDeathPolicy{},
+}
+
+//DefaultDeathPolicy The death policy used for every player death.
+var DefaultDeathPolicy DeathPolicy = ClassicDeathPolicy{}
+
+//GravestoneTicks How many ticks a gravestone holds a dead players drops for them.  0 disables gravestones.
+var GravestoneTicks = 0
+
+//SetDeathPolicy selects the death policy with the provided name.  Safe zones are only used by the safezone policy.
+// Returns false, leaving the current policy alone, if there is no policy by that name.
+func SetDeathPolicy(name string, safeZones [][2]Location) bool {
+	policy, ok := DeathPolicies[strings.ToLower(name)]
+	if !ok {
+		log.Warn("Unknown death policy:", name)
+		return false
+	}
+	if _, ok := policy.(SafeZoneDeathPolicy); ok {
+		policy = SafeZoneDeathPolicy{Zones: safeZones}
+	}
+	DefaultDeathPolicy = policy
+	return true
+}
+
+//NewDeath returns the rules for p being killed by killer, as decided by DefaultDeathPolicy and any handlers of the death event.
+func NewDeath(p *Player, killer entity.MobileEntity) *PlayerDeath {
+	death := &PlayerDeath{Player: p, Killer: killer}
+	DefaultDeathPolicy.Apply(death)
+	if killer == nil || !killer.IsPlayer() {
+		// Nobody earned the drops, so hold them for their owner to come back for
+		death.Gravestone = GravestoneTicks
+	}
+	Emit(&DeathEvent{PlayerDeath: death})
+	return death
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+
+package world
+
+import (
+	"testing"
+)
+
+func deathAt(x, y int, policy DeathPolicy) *PlayerDeath {
+	p := NewPlayer(nil)
+	p.SetLocation(NewLocation(x, y), true)
+	death := &PlayerDeath{Player: p}
+	policy.Apply(death)
+	return death
+}
+
+func TestClassicDeathPolicy(t *testing.T) {
+	p := NewPlayer(nil)
+	death := &PlayerDeath{Player: p}
+	ClassicDeathPolicy{}.Apply(death)
+	if death.Keep != 3 || !death.Bones || !death.Unskull || death.Respawn != SpawnPoint {
+		t.Fatalf("unexpected classic death: %+v", death)
+	}
+	p.Prayers[ProtectItemPrayer] = true
+	p.Attributes.SetVar("skullTicks", TicksTwentyMin)
+	ClassicDeathPolicy{}.Apply(death)
+	if death.Keep != 1 {
+		t.Fatalf("skulled player praying Protect Item keeps %d items, want 1", death.Keep)
+	}
+}
+
+func TestHardcoreDeathPolicy(t *testing.T) {
+	if death := deathAt(122, 647, HardcoreDeathPolicy{}); death.Keep != 0 {
+		t.Fatalf("hardcore player keeps %d items, want 0", death.Keep)
+	}
+}
+
+func TestSafeZoneDeathPolicy(t *testing.T) {
+	policy := SafeZoneDeathPolicy{Zones: [][2]Location{{NewLocation(100, 600), NewLocation(150, 700)}}}
+	if death := deathAt(122, 647, policy); death.Keep != KeepAll {
+		t.Fatalf("player inside a safe zone keeps %d items, want all", death.Keep)
+	}
+	if death := deathAt(220, 445, policy); death.Keep != 3 {
+		t.Fatalf("player outside a safe zone keeps %d items, want 3", death.Keep)
+	}
+}
+
+func TestWildernessDeathPolicy(t *testing.T) {
+	tests := []struct {
+		y, keep int
+	}{
+		{445, KeepAll},
+		{426, 3},
+		{336, 2},
+		{246, 1},
+		{156, 0},
+		{100, 0},
+	}
+	for _, test := range tests {
+		death := deathAt(220, test.y, WildernessDeathPolicy{})
+		if death.Keep != test.keep {
+			t.Errorf("dying at wilderness level %d keeps %d items, want %d", death.Player.Wilderness(), death.Keep, test.keep)
+		}
+	}
+}
+
+func TestOnDeathScript(t *testing.T) {
+	defer installScripts(NewScriptSet())
+	_, err := reloadScripts([]scriptFile{{"death.ank", `bind.onDeath(func(death) {
+	death.Keep = KEEP_ALL
+	death.Bones = false
+})`}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	SwapScripts()
+	death := NewDeath(NewPlayer(nil), nil)
+	if death.Keep != KeepAll || death.Bones {
+		t.Fatalf("onDeath script did not change the rules: %+v", death)
+	}
+}
@@ -0,0 +1,576 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/spkaeros/rscgo/pkg/game/entity"
+	"github.com/spkaeros/rscgo/pkg/rand"
+)
+
+// NPC dialogue trees.
+//
+// Rather than hand-writing every conversation as nested option menus, scripts can describe one as a map of named
+// nodes, and leave the talking to the dialogue interpreter.  Every conversation starts at the node named "start".  A node
+// may hold any of these keys, which happen in this order:
+//
+//	"player":  lines the player says
+//	"npc":     lines the NPC says back
+//	"message": lines sent to the player as game messages
+//	"stage":   [quest, stage] moves the player to a quest stage
+//	"take":    [item, amount] takes items from the player
+//	"give":    [item, amount] gives the player items, so long as they had everything the node takes
+//	"action":  func(player, npc) to call
+//
+// Lines are strings, or funcs(player, npc) that return a string.  After that, the conversation moves on to at most one of:
+//
+//	"options": [{"text": ..., "next": ..., "if": ...}, ...] opens an option menu, and moves to the chosen options node.
+//	           Options whose condition fails are left out of the menu, and an option with no next node ends the conversation.
+//	"next":    the name of the node to move to, or a list of {"next": ..., "if": ...} branches, the first of whose
+//	           conditions holds is followed.  A branch with no condition always holds.
+//	"random":  a list of node names to pick one of at random.
+//
+// A node with none of them ends the conversation.  A condition is a map holding any of "quest" along with "stage",
+// "minStage" or "maxStage", "item" along with an "amount" the player must carry (1 by default), "stat" along with the
+// "level" the player must have reached, "check": func(player, npc) returning a bool, and "not": another condition.  A
+// condition holds when every part of it does.
+//
+// Dialogues are checked when they are built, so that a script with a node pointing at a node that doesn't exist, a node
+// that can never be reached from the start, or a loop that can never end, fails to load.
+
+//dialogueSteps The most nodes a conversation may pass through without waiting on the player, in case a branch loops.
+const dialogueSteps = 100
+
+//Dialogue A conversation with an NPC, made from named nodes.
+type Dialogue struct {
+	Nodes map[string]*DialogueNode
+}
+
+//DialogueNode A single step of a dialogue.
+type DialogueNode struct {
+	Name                  string
+	Player, Npc, Messages []dialogueLine
+	Stage                 []int
+	Give, Take            []int
+	Action                func(*Player, *NPC)
+	Options               []DialogueBranch
+	Next                  []DialogueBranch
+	Random                []string
+}
+
+//DialogueBranch A way out of a dialogue node; an option in its menu, or one of its next branches.
+type DialogueBranch struct {
+	Text string
+	Next string
+	If   DialogueCondition
+}
+
+//DialogueCondition Returns true if a dialogue branch may be taken.  A nil condition always holds.
+type DialogueCondition func(*Player, *NPC) bool
+
+//holds returns true if this condition holds for player talking to npc.
+func (c DialogueCondition) holds(player *Player, npc *NPC) bool {
+	return c == nil || c(player, npc)
+}
+
+//dialogueLine A line of dialogue, worked out when it is said.
+type dialogueLine func(*Player, *NPC) string
+
+//lines returns what each of the provided lines says to player talking to npc.
+func lines(player *Player, npc *NPC, list []dialogueLine) []string {
+	out := make([]string, 0, len(list))
+	for _, line := range list {
+		out = append(out, line(player, npc))
+	}
+	return out
+}
+
+//NewDialogue Returns the dialogue described by tree, a map of node names to nodes as described above, or an error if it
+// is malformed or fails to validate.
+func NewDialogue(tree map[interface{}]interface{}) (*Dialogue, error) {
+	d := &Dialogue{Nodes: make(map[string]*DialogueNode, len(tree))}
+	for k, v := range tree {
+		name, ok := k.(string)
+		if !ok {
+			return nil, fmt.Errorf("dialogue node names must be strings, not %T", k)
+		}
+		m, ok := v.(map[interface{}]interface{})
+		if !ok {
+			return nil, fmt.Errorf("dialogue node '%s' must be a map, not %T", name, v)
+		}
+		node, err := newDialogueNode(name, m)
+		if err != nil {
+			return nil, fmt.Errorf("dialogue node '%s': %v", name, err)
+		}
+		d.Nodes[name] = node
+	}
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func newDialogueNode(name string, m map[interface{}]interface{}) (node *DialogueNode, err error) {
+	node = &DialogueNode{Name: name}
+	exits := 0
+	for k, v := range m {
+		key, _ := k.(string)
+		switch key {
+		case "player":
+			node.Player, err = dialogueLines(v)
+		case "npc":
+			node.Npc, err = dialogueLines(v)
+		case "message":
+			node.Messages, err = dialogueLines(v)
+		case "stage":
+			node.Stage, err = dialogueInts(v, 2, 0)
+		case "give":
+			node.Give, err = dialogueInts(v, 2, 1)
+		case "take":
+			node.Take, err = dialogueInts(v, 2, 1)
+		case "action":
+			sandboxed(v, &node.Action)
+			if node.Action == nil {
+				err = fmt.Errorf("action must be a func(player, npc), not %T", v)
+			}
+		case "options":
+			exits++
+			node.Options, err = dialogueBranches(v, true)
+		case "next":
+			exits++
+			if next, ok := v.(string); ok {
+				node.Next = []DialogueBranch{{Next: next}}
+				break
+			}
+			node.Next, err = dialogueBranches(v, false)
+		case "random":
+			exits++
+			for _, next := range dialogueList(v) {
+				name, ok := next.(string)
+				if !ok {
+					return nil, fmt.Errorf("random must be a list of node names")
+				}
+				node.Random = append(node.Random, name)
+			}
+			if len(node.Random) == 0 {
+				err = errors.New("random must list at least one node")
+			}
+		default:
+			err = fmt.Errorf("unknown key %v", k)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if exits > 1 {
+		return nil, errors.New("can only have one of options, next or random")
+	}
+	return node, nil
+}
+
+//dialogueList returns v as a list, wrapping it in one if it is a single value.
+func dialogueList(v interface{}) []interface{} {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []interface{}{v}
+	}
+	list := make([]interface{}, rv.Len())
+	for i := range list {
+		list[i] = rv.Index(i).Interface()
+	}
+	return list
+}
+
+func dialogueLines(v interface{}) (out []dialogueLine, err error) {
+	for _, line := range dialogueList(v) {
+		if s, ok := line.(string); ok {
+			out = append(out, func(*Player, *NPC) string {
+				return s
+			})
+			continue
+		}
+		var fn dialogueLine
+		sandboxed(line, &fn)
+		if fn == nil {
+			return nil, fmt.Errorf("lines must be strings or funcs(player, npc), not %T", line)
+		}
+		out = append(out, fn)
+	}
+	return
+}
+
+//dialogueInts returns v as a list of n ints.  If def is not zero, the last int may be left out, and defaults to def.
+func dialogueInts(v interface{}, n, def int) ([]int, error) {
+	list := dialogueList(v)
+	if def != 0 && len(list) == n-1 {
+		list = append(list, def)
+	}
+	if len(list) != n {
+		return nil, fmt.Errorf("want %d numbers, got %v", n, v)
+	}
+	ints := make([]int, n)
+	for i, v := range list {
+		var ok bool
+		if ints[i], ok = dialogueInt(v); !ok {
+			return nil, fmt.Errorf("want %d numbers, got %v", n, list)
+		}
+	}
+	return ints, nil
+}
+
+func dialogueInt(v interface{}) (int, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(rv.Int()), true
+	case reflect.Float32, reflect.Float64:
+		return int(rv.Float()), true
+	}
+	return 0, false
+}
+
+func dialogueBranches(v interface{}, options bool) (branches []DialogueBranch, err error) {
+	for _, b := range dialogueList(v) {
+		m, ok := b.(map[interface{}]interface{})
+		if !ok {
+			return nil, fmt.Errorf("branches must be maps, not %T", b)
+		}
+		var branch DialogueBranch
+		for k, v := range m {
+			switch k {
+			case "text":
+				branch.Text, ok = v.(string)
+			case "next":
+				branch.Next, ok = v.(string)
+			case "if":
+				branch.If, err = dialogueCondition(v)
+				if err != nil {
+					return nil, err
+				}
+			default:
+				return nil, fmt.Errorf("unknown branch key %v", k)
+			}
+			if !ok {
+				return nil, fmt.Errorf("branch %v must be a string, not %T", k, v)
+			}
+		}
+		if options && len(branch.Text) == 0 {
+			return nil, errors.New("options must have some text")
+		}
+		if !options && len(branch.Next) == 0 {
+			return nil, errors.New("next branches must name a node")
+		}
+		branches = append(branches, branch)
+	}
+	if len(branches) == 0 {
+		return nil, errors.New("must have at least one branch")
+	}
+	return
+}
+
+func dialogueCondition(v interface{}) (DialogueCondition, error) {
+	if m, ok := v.(map[interface{}]interface{}); ok {
+		return dialogueConditionMap(m)
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		var conds []DialogueCondition
+		for _, c := range dialogueList(v) {
+			cond, err := dialogueCondition(c)
+			if err != nil {
+				return nil, err
+			}
+			conds = append(conds, cond)
+		}
+		return func(player *Player, npc *NPC) bool {
+			for _, cond := range conds {
+				if !cond.holds(player, npc) {
+					return false
+				}
+			}
+			return true
+		}, nil
+	}
+	var check func(*Player, *NPC) bool
+	sandboxed(v, &check)
+	if check == nil {
+		return nil, fmt.Errorf("conditions must be maps, lists or funcs(player, npc), not %T", v)
+	}
+	return check, nil
+}
+
+func dialogueConditionMap(m map[interface{}]interface{}) (DialogueCondition, error) {
+	var conds []DialogueCondition
+	number := func(key string) (int, bool, error) {
+		v, ok := m[key]
+		if !ok {
+			return 0, false, nil
+		}
+		n, ok := dialogueInt(v)
+		if !ok {
+			return 0, false, fmt.Errorf("condition %s must be a number, not %T", key, v)
+		}
+		return n, true, nil
+	}
+	for k, v := range m {
+		switch k {
+		case "quest":
+			quest, _, err := number("quest")
+			if err != nil {
+				return nil, err
+			}
+			stage, exact, err := number("stage")
+			if err != nil {
+				return nil, err
+			}
+			min, hasMin, err := number("minStage")
+			if err != nil {
+				return nil, err
+			}
+			max, hasMax, err := number("maxStage")
+			if err != nil {
+				return nil, err
+			}
+			if !exact && !hasMin && !hasMax {
+				return nil, errors.New("quest conditions need a stage, minStage or maxStage")
+			}
+			conds = append(conds, func(player *Player, _ *NPC) bool {
+				s := player.QuestStage(quest)
+				return (!exact || s == stage) && (!hasMin || s >= min) && (!hasMax || s <= max)
+			})
+		case "item":
+			id, _, err := number("item")
+			if err != nil {
+				return nil, err
+			}
+			amount, ok, err := number("amount")
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				amount = 1
+			}
+			conds = append(conds, func(player *Player, _ *NPC) bool {
+				return player.Inventory.CountID(id) >= amount
+			})
+		case "stat":
+			skill, ok := dialogueInt(v)
+			if name, isName := v.(string); isName {
+				skill, ok = entity.SkillIndex(name), entity.SkillIndex(name) >= 0
+			}
+			if !ok {
+				return nil, fmt.Errorf("condition stat must be a skill name or number, not %v", v)
+			}
+			level, _, err := number("level")
+			if err != nil {
+				return nil, err
+			}
+			conds = append(conds, func(player *Player, _ *NPC) bool {
+				return player.Skills().Maximum(skill) >= level
+			})
+		case "check":
+			cond, err := dialogueCondition(v)
+			if err != nil {
+				return nil, err
+			}
+			conds = append(conds, cond)
+		case "not":
+			cond, err := dialogueCondition(v)
+			if err != nil {
+				return nil, err
+			}
+			conds = append(conds, func(player *Player, npc *NPC) bool {
+				return !cond.holds(player, npc)
+			})
+		case "stage", "minStage", "maxStage":
+			if _, ok := m["quest"]; !ok {
+				return nil, fmt.Errorf("condition %v needs a quest", k)
+			}
+		case "amount":
+			if _, ok := m["item"]; !ok {
+				return nil, errors.New("condition amount needs an item")
+			}
+		case "level":
+			if _, ok := m["stat"]; !ok {
+				return nil, errors.New("condition level needs a stat")
+			}
+		default:
+			return nil, fmt.Errorf("unknown condition %v", k)
+		}
+	}
+	return func(player *Player, npc *NPC) bool {
+		for _, cond := range conds {
+			if !cond(player, npc) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+//exits returns the names of every node that this node may move on to.
+func (n *DialogueNode) exits() (names []string) {
+	for _, b := range append(n.Options, n.Next...) {
+		if len(b.Next) > 0 {
+			names = append(names, b.Next)
+		}
+	}
+	return append(names, n.Random...)
+}
+
+//ends returns true if the conversation may end at this node, without moving on to another one.  Option menus can
+// always be closed, and next branches that all have conditions may all fail.
+func (n *DialogueNode) ends() bool {
+	if len(n.Options) > 0 {
+		return true
+	}
+	for _, b := range n.Next {
+		if b.If == nil {
+			return false
+		}
+	}
+	return len(n.Random) == 0
+}
+
+//Validate Returns an error describing every node that points at a node that doesn't exist, can't be reached from the
+// start node, or only leads into a loop that never ends.
+func (d *Dialogue) Validate() error {
+	if _, ok := d.Nodes["start"]; !ok {
+		return errors.New("dialogue has no start node")
+	}
+	var problems []string
+	names := make([]string, 0, len(d.Nodes))
+	for name := range d.Nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, next := range d.Nodes[name].exits() {
+			if _, ok := d.Nodes[next]; !ok {
+				problems = append(problems, fmt.Sprintf("node '%s' leads to missing node '%s'", name, next))
+			}
+		}
+	}
+
+	reached := map[string]bool{"start": true}
+	for queue := []string{"start"}; len(queue) > 0; queue = queue[1:] {
+		for _, next := range d.Nodes[queue[0]].exits() {
+			if _, ok := d.Nodes[next]; ok && !reached[next] {
+				reached[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	ends := make(map[string]bool)
+	for changed := true; changed; {
+		changed = false
+		for name, node := range d.Nodes {
+			if ends[name] {
+				continue
+			}
+			if node.ends() {
+				ends[name], changed = true, true
+				continue
+			}
+			for _, next := range node.exits() {
+				if ends[next] {
+					ends[name], changed = true, true
+					break
+				}
+			}
+		}
+	}
+	for _, name := range names {
+		if !reached[name] {
+			problems = append(problems, fmt.Sprintf("node '%s' can not be reached from the start", name))
+		} else if !ends[name] {
+			problems = append(problems, fmt.Sprintf("node '%s' never reaches the end of the conversation", name))
+		}
+	}
+	if len(problems) > 0 {
+		return errors.New("dialogue " + strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+//Run Has player hold this conversation with npc, starting from the start node.  This waits on the player, so it should
+// only be called from a coroutine, as NPC triggers are.
+func (d *Dialogue) Run(player *Player, npc *NPC) {
+	node := d.Nodes["start"]
+	steps := 0
+	for node != nil && steps < dialogueSteps {
+		if player.coroutineContext().Err() != nil {
+			return
+		}
+		player.Chat(lines(player, npc, node.Player)...)
+		npc.Chat(player, lines(player, npc, node.Npc)...)
+		for _, msg := range lines(player, npc, node.Messages) {
+			player.Message(msg)
+		}
+		if node.Stage != nil {
+			player.SetQuestStage(node.Stage[0], node.Stage[1])
+		}
+		// whatever a node gives is only handed over once whatever it takes has been paid
+		if node.Take == nil || player.Inventory.RemoveByID(node.Take[0], node.Take[1]) >= 0 {
+			if node.Give != nil {
+				player.AddItem(node.Give[0], node.Give[1])
+			}
+		}
+		if node.Action != nil {
+			node.Action(player, npc)
+		}
+		next, answered := d.next(node, player, npc)
+		if answered {
+			// only the steps taken since the player last answered count towards the limit
+			steps = 0
+		} else {
+			steps++
+		}
+		node = next
+	}
+}
+
+//next returns the node that the conversation moves on to after node, or nil if it ends there, and whether the player
+// answered an option menu to get there.
+func (d *Dialogue) next(node *DialogueNode, player *Player, npc *NPC) (*DialogueNode, bool) {
+	if len(node.Options) > 0 {
+		var options []DialogueBranch
+		var texts []string
+		for _, option := range node.Options {
+			if option.If.holds(player, npc) {
+				options = append(options, option)
+				texts = append(texts, option.Text)
+			}
+		}
+		if len(options) == 0 {
+			return nil, false
+		}
+		choice := player.OpenOptionMenu(texts...)
+		if choice < 0 || choice >= len(options) {
+			return nil, false
+		}
+		return d.Nodes[options[choice].Next], true
+	}
+	for _, branch := range node.Next {
+		if branch.If.holds(player, npc) {
+			return d.Nodes[branch.Next], false
+		}
+	}
+	if len(node.Random) > 0 {
+		return d.Nodes[node.Random[rand.Rng.Intn(len(node.Random))]], false
+	}
+	return nil, false
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spkaeros/rscgo/pkg/game/net"
+	"github.com/spkaeros/rscgo/pkg/tasks"
+)
+
+type tree = map[interface{}]interface{}
+
+func TestDialogueValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		tree tree
+		want []string
+	}{
+		{"valid", tree{
+			"start": tree{"npc": "Hello", "options": []interface{}{
+				tree{"text": "Again", "next": "start"},
+				tree{"text": "Goodbye", "next": "bye"},
+				tree{"text": "Nevermind"},
+			}},
+			"bye": tree{"npc": "Goodbye", "next": []interface{}{
+				tree{"if": tree{"quest": 0, "minStage": 1}, "next": "start"},
+			}},
+		}, nil},
+		{"no start", tree{"hello": tree{"npc": "Hello"}}, []string{"no start node"}},
+		{"missing node", tree{"start": tree{"next": "nowhere"}}, []string{"node 'start' leads to missing node 'nowhere'"}},
+		{"unreachable", tree{"start": tree{"npc": "Hello"}, "orphan": tree{"npc": "Hi"}},
+			[]string{"node 'orphan' can not be reached from the start"}},
+		{"endless loop", tree{
+			"start": tree{"next": "ping"},
+			"ping":  tree{"npc": "Ping", "next": "pong"},
+			"pong":  tree{"npc": "Pong", "random": []interface{}{"ping", "start"}},
+		}, []string{"node 'ping' never reaches the end", "node 'pong' never reaches the end", "node 'start' never reaches the end"}},
+		{"unknown key", tree{"start": tree{"npcc": "Hello"}}, []string{"dialogue node 'start': unknown key npcc"}},
+		{"two exits", tree{"start": tree{"next": "start", "random": []interface{}{"start"}}}, []string{"only have one of"}},
+		{"bad condition", tree{"start": tree{"next": []interface{}{tree{"if": tree{"stage": 1}, "next": "start"}}}},
+			[]string{"condition stage needs a quest"}},
+	}
+	for _, test := range tests {
+		_, err := NewDialogue(test.tree)
+		if test.want == nil {
+			if err != nil {
+				t.Errorf("%s: %v", test.name, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("%s: did not fail", test.name)
+			continue
+		}
+		for _, want := range test.want {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("%s: error %q does not mention %q", test.name, err, want)
+			}
+		}
+	}
+}
+
+func TestDialogueConditions(t *testing.T) {
+	groundTestItems(t)
+	d, err := NewDialogue(tree{
+		"start": tree{"next": []interface{}{
+			tree{"if": tree{"quest": 3, "stage": 2, "item": 0, "amount": 5}, "next": "rich"},
+			tree{"if": tree{"stat": "cooking", "level": 20}, "next": "cook"},
+			tree{"if": tree{"not": tree{"item": 0}}, "next": "poor"},
+		}},
+		"rich": tree{"npc": "Welcome back"},
+		"cook": tree{"npc": "Hello chef"},
+		"poor": tree{"npc": "Go away"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewPlayer(nil)
+	next := func() string {
+		if node, _ := d.next(d.Nodes["start"], p, nil); node != nil {
+			return node.Name
+		}
+		return ""
+	}
+	if n := next(); n != "poor" {
+		t.Errorf("went to %q, want poor", n)
+	}
+	p.Inventory.Add(0, 5)
+	if n := next(); n != "" {
+		t.Errorf("went to %q, want the conversation to end", n)
+	}
+	p.Skills().SetMax(7, 20)
+	if n := next(); n != "cook" {
+		t.Errorf("went to %q, want cook", n)
+	}
+	p.SetQuestStage(3, 2)
+	if n := next(); n != "rich" {
+		t.Errorf("went to %q, want rich", n)
+	}
+}
+
+func TestDialogueTakesBeforeGiving(t *testing.T) {
+	groundTestItems(t)
+	d, err := NewDialogue(tree{"start": tree{"take": []interface{}{1, 1}, "give": []interface{}{2, 1}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewPlayer(nil)
+	d.Run(p, nil)
+	if p.Inventory.CountID(2) > 0 {
+		t.Fatal("dialogue gave its reward to a player who could not pay for it")
+	}
+	p.Inventory.Add(1, 1)
+	d.Run(p, nil)
+	if p.Inventory.CountID(1) > 0 || p.Inventory.CountID(2) != 1 {
+		t.Fatal("dialogue did not swap the item it takes for the one it gives")
+	}
+}
+
+func TestDialogueLongConversation(t *testing.T) {
+	d, err := NewDialogue(tree{"start": tree{"options": []interface{}{
+		tree{"text": "Again", "next": "start"},
+		tree{"text": "Stop"},
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewPlayer(nil)
+	p.Writer = discard{}
+	p.SetConnected(true)
+	c := p.StartCoroutine(StateChatting, func() {
+		d.Run(p, nil)
+	})
+	// far more answers than the step limit, which only counts the steps between them
+	for i := 0; i < dialogueSteps*2; i++ {
+		if !p.HandleInput(net.NewPacket(optionMenuReply, []byte{0})) {
+			t.Fatalf("conversation ended after %d answers", i)
+		}
+		tasks.TickList.Tick()
+	}
+	p.HandleInput(net.NewPacket(optionMenuReply, []byte{1}))
+	tasks.TickList.Tick()
+	if p.Coroutine() == c {
+		t.Fatal("conversation did not end when the player chose to stop")
+	}
+}
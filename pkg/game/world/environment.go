@@ -21,6 +21,7 @@ import (
 	"github.com/mattn/anko/parser"
 	"github.com/spkaeros/rscgo/pkg/definitions"
 	"github.com/spkaeros/rscgo/pkg/game/entity"
+	"github.com/spkaeros/rscgo/pkg/ids"
 	"github.com/spkaeros/rscgo/pkg/log"
 	"github.com/spkaeros/rscgo/pkg/tasks"
 	"github.com/spkaeros/rscgo/pkg/rand"
@@ -30,7 +31,9 @@ import (
 	_ "github.com/mattn/anko/packages"
 )
 
-//CommandHandlers A map to assign in-game commands to the functions they should execute.
+//CommandHandlers A map to assign in-game commands to the functions they should execute.  These are the built-in
+// commands, registered from Go before any scripts are installed; they survive every reload, while commands bound by
+// scripts only live as long as the set that bound them.  Look commands up through CommandHandler.
 var CommandHandlers = make(map[string]func(*Player, []string))
 
 func init() {
@@ -72,6 +75,8 @@ func init() {
 					player.Destroy()
 				})
 				time.Sleep(2 * time.Second)
+				FlushVars()
+				FlushLedger()
 				os.Exit(200)
 			}()
 			tasks.Schedule(10, func() bool {
@@ -104,6 +109,8 @@ func init() {
 		"newGeneralShop": reflect.ValueOf(NewGeneralShop),
 		"getShop":        reflect.ValueOf(Shops.Get),
 		"hasShop":        reflect.ValueOf(Shops.Contains),
+		"getQuest":       reflect.ValueOf(Quests.Get),
+		"dialogue":       reflect.ValueOf(mustDialogue),
 	}
 	env.PackageTypes["world"] = map[string]reflect.Type{
 		"players":    reflect.TypeOf(Players),
@@ -111,168 +118,216 @@ func init() {
 		"object":     reflect.TypeOf(&Object{}),
 		"item":       reflect.TypeOf(&Item{}),
 		"groundItem": reflect.TypeOf(&GroundItem{}),
+		"death":      reflect.TypeOf(&PlayerDeath{}),
 		"npc":        reflect.TypeOf(&NPC{}),
 		"location":   reflect.TypeOf(Location{}),
 	}
-	env.Packages["ids"] = map[string]reflect.Value{
-		"COOKEDMEAT":               reflect.ValueOf(132),
-		"BURNTMEAT":                reflect.ValueOf(134),
-		"FLIER":                    reflect.ValueOf(201),
-		"LEATHER_GLOVES":           reflect.ValueOf(16),
-		"BOOTS":                    reflect.ValueOf(17),
-		"SEAWEED":                  reflect.ValueOf(622),
-		"OYSTER":                   reflect.ValueOf(793),
-		"CASKET":                   reflect.ValueOf(549),
-		"RAW_RAT_MEAT":             reflect.ValueOf(503),
-		"RAW_SHRIMP":               reflect.ValueOf(349),
-		"RAW_ANCHOVIES":            reflect.ValueOf(351),
-		"RAW_TROUT":                reflect.ValueOf(358),
-		"RAW_SALMON":               reflect.ValueOf(356),
-		"RAW_PIKE":                 reflect.ValueOf(363),
-		"RAW_SARDINE":              reflect.ValueOf(354),
-		"RAW_HERRING":              reflect.ValueOf(361),
-		"RAW_BASS":                 reflect.ValueOf(550),
-		"RAW_MACKEREL":             reflect.ValueOf(552),
-		"RAW_COD":                  reflect.ValueOf(554),
-		"RAW_LOBSTER":              reflect.ValueOf(372),
-		"RAW_SWORDFISH":            reflect.ValueOf(369),
-		"RAW_TUNA":                 reflect.ValueOf(366),
-		"HOLY_SYMBOL_OF_SARADOMIN": reflect.ValueOf(385),
-		"RAW_SHARK":                reflect.ValueOf(545),
-		"WOODEN_SHIELD":            reflect.ValueOf(4),
-		"BRONZE_LSWORD":            reflect.ValueOf(70),
-		"NET":                      reflect.ValueOf(376),
-		"BIG_NET":                  reflect.ValueOf(548),
-		"LOBSTER_POT":              reflect.ValueOf(375),
-		"FISHING_ROD":              reflect.ValueOf(377),
-		"FLYFISHING_ROD":           reflect.ValueOf(378),
-		"OILY_FISHING_ROD":         reflect.ValueOf(589),
-		"RAW_LAVA_EEL":             reflect.ValueOf(591),
-		"HARPOON":                  reflect.ValueOf(379),
-		"FISHING_BAIT":             reflect.ValueOf(380),
-		"FEATHER":                  reflect.ValueOf(381),
-		"BRONZE_PICKAXE":           reflect.ValueOf(156),
-		"IRON_PICKAXE":             reflect.ValueOf(1258),
-		"STEEL_PICKAXE":            reflect.ValueOf(1259),
-		"MITHRIL_PICKAXE":          reflect.ValueOf(1260),
-		"ADAM_PICKAXE":             reflect.ValueOf(1261),
-		"RUNE_PICKAXE":             reflect.ValueOf(1262),
-		"TIN_ORE":                  reflect.ValueOf(202),
-		"SLEEPING_BAG":             reflect.ValueOf(1263),
-		"NEEDLE":                   reflect.ValueOf(39),
-		"THREAD":                   reflect.ValueOf(43),
-		"FIRE_RUNE":                reflect.ValueOf(31),
-		"WATER_RUNE":               reflect.ValueOf(32),
-		"AIR_RUNE":                 reflect.ValueOf(33),
-		"EARTH_RUNE":               reflect.ValueOf(34),
-		"MIND_RUNE":                reflect.ValueOf(35),
-		"BODY_RUNE":                reflect.ValueOf(36),
-		"LIFE_RUNE":                reflect.ValueOf(37),
-		"DEATH_RUNE":               reflect.ValueOf(38),
-		"NATURE_RUNE":              reflect.ValueOf(40),
-		"CHAOS_RUNE":               reflect.ValueOf(41),
-		"LAW_RUNE":                 reflect.ValueOf(42),
-		"COSMIC_RUNE":              reflect.ValueOf(46),
-		"BLOOD_RUNE":               reflect.ValueOf(619),
-		"AIR_STAFF":                reflect.ValueOf(101),
-		"WATER_STAFF":              reflect.ValueOf(102),
-		"EARTH_STAFF":              reflect.ValueOf(103),
-		"FIRE_STAFF":               reflect.ValueOf(197),
-		"FIRE_BATTLESTAFF":         reflect.ValueOf(615),
-		"WATER_BATTLESTAFF":        reflect.ValueOf(616),
-		"AIR_BATTLESTAFF":          reflect.ValueOf(617),
-		"EARTH_BATTLESTAFF":        reflect.ValueOf(618),
-		"E_FIRE_BATTLESTAFF":       reflect.ValueOf(682),
-		"E_WATER_BATTLESTAFF":      reflect.ValueOf(683),
-		"E_AIR_BATTLESTAFF":        reflect.ValueOf(684),
-		"E_EARTH_BATTLESTAFF":      reflect.ValueOf(685),
-		"BONES":                    reflect.ValueOf(20),
-		"BANANA":                   reflect.ValueOf(249),
-		"BAT_BONES":                reflect.ValueOf(604),
-		"DRAGON_BONES":             reflect.ValueOf(614),
-		"RUNE_2H":                  reflect.ValueOf(81),
-		"RUNE_CHAIN":               reflect.ValueOf(400),
-		"RUNE_PLATEBODY":           reflect.ValueOf(401),
-		"RUNE_PLATETOP":            reflect.ValueOf(407),
-		"BLACK_DAGGER":             reflect.ValueOf(423),
-		"GOLD_RING":                reflect.ValueOf(283),
-		"SILK":                     reflect.ValueOf(200),
-		"IRON_ORE_CERTIFICATE":     reflect.ValueOf(517),
-		"CHOCOLATE_SLICE":          reflect.ValueOf(336),
-		"CHOCOLATE_BAR":            reflect.ValueOf(337),
-		"SPINACH_ROLL":             reflect.ValueOf(179),
-		"DRAGON_SWORD":             reflect.ValueOf(593),
-		"DRAGON_AXE":               reflect.ValueOf(594),
-		"DSTONE_AMULET_C":          reflect.ValueOf(597),
-		"DSTONE_AMULET":            reflect.ValueOf(522),
-		"DSTONE_AMULET_U":          reflect.ValueOf(522),
-		"DRAGON_HELMET":            reflect.ValueOf(795),
-		"DRAGON_SHIELD":            reflect.ValueOf(1278),
-		"EASTER_EGG":               reflect.ValueOf(677),
-		"CHRISTMAS_CRACKER":        reflect.ValueOf(575),
-		"PARTYHAT_RED":             reflect.ValueOf(576),
-		"PARTYHAT_YELLOW":          reflect.ValueOf(577),
-		"PARTYHAT_BLUE":            reflect.ValueOf(578),
-		"PARTYHAT_GREEN":           reflect.ValueOf(579),
-		"PARTYHAT_PINK":            reflect.ValueOf(580),
-		"PARTYHAT_WHITE":           reflect.ValueOf(581),
-		"GREEN_MASK":               reflect.ValueOf(828),
-		"RED_MASK":                 reflect.ValueOf(831),
-		"BLUE_MASK":                reflect.ValueOf(832),
-		"SANTA_HAT":                reflect.ValueOf(971),
-		"PRESENT":                  reflect.ValueOf(980),
-		"GNOME_BALL":               reflect.ValueOf(981),
-		"BLURITE_ORE":              reflect.ValueOf(266),
-		"CLAY":                     reflect.ValueOf(149),
-		"COPPER_ORE":               reflect.ValueOf(150),
-		"IRON_ORE":                 reflect.ValueOf(151),
-		"GOLD":                     reflect.ValueOf(152),
-		"SILVER":                   reflect.ValueOf(383),
-		"GOLD2":                    reflect.ValueOf(690),
-		"MITHRIL_ORE":              reflect.ValueOf(153),
-		"ADAM_ORE":                 reflect.ValueOf(154),
-		"RUNITE_ORE":               reflect.ValueOf(409),
-		"COAL":                     reflect.ValueOf(155),
+	env.Packages["ids"] = idsPackage()
+	env.Packages["vars"] = map[string]reflect.Value{
+		"player":  reflect.ValueOf(PlayerVars),
+		"account": reflect.ValueOf(AccountVars),
+		"world":   reflect.ValueOf(WorldVars),
+	}
+	env.PackageTypes["vars"] = map[string]reflect.Type{
+		"store": reflect.TypeOf(&VarStore{}),
 	}
 	env.Packages["bind"] = map[string]reflect.Value{
-		"onLogin": reflect.ValueOf(func(fn func(player *Player)) {
-			LoginTriggers = append(LoginTriggers, fn)
+		"on": reflect.ValueOf(func(name string, args ...interface{}) {
+			t, ok := eventTypes[name]
+			if !ok {
+				panic("no such event: " + name)
+			}
+			if len(args) == 0 || len(args) > 2 {
+				panic("usage: bind.on(event, [priority,] handler)")
+			}
+			priority := 0
+			if len(args) == 2 {
+				priority = int(reflect.ValueOf(args[0]).Convert(reflect.TypeOf(0)).Int())
+			}
+			origin := scriptOrigin()
+			handler := Sandbox.Wrap(args[len(args)-1], reflect.FuncOf([]reflect.Type{t}, nil, false), origin)
+			binding(func(s *ScriptSet) {
+				s.Events.add(t, eventHandler{priority: priority, fn: handler, origin: origin})
+			})
 		}),
-		"invOnBoundary": reflect.ValueOf(func(fn func(player *Player, boundary *Object, item *Item) bool) {
-			InvOnBoundaryTriggers = append(InvOnBoundaryTriggers, fn)
+		"onLogin": reflect.ValueOf(func(fn interface{}) {
+			var action func(*Player)
+			sandboxed(fn, &action)
+			bindEvent(func(ev *LoginEvent) {
+				action(ev.Player)
+			}, action)
 		}),
-		"invOnPlayer": reflect.ValueOf(func(pred func(*Item) bool, fn func(player *Player, target *Player, item *Item)) {
-			InvOnPlayerTriggers = append(InvOnPlayerTriggers, ItemOnPlayerTrigger{pred, fn})
+		"invOnBoundary": reflect.ValueOf(func(fn interface{}) {
+			var action func(*Player, *Object, *Item) bool
+			sandboxed(fn, &action)
+			bindEvent(func(ev *InvOnObjectEvent) {
+				if ev.Object.Boundary && action(ev.Player, ev.Object, ev.Item) {
+					ev.Cancel()
+				}
+			}, action)
 		}),
-		"invOnObject": reflect.ValueOf(func(fn func(player *Player, boundary *Object, item *Item) bool) {
-			InvOnObjectTriggers = append(InvOnObjectTriggers, fn)
+		"invOnPlayer": reflect.ValueOf(func(pred, fn interface{}) {
+			var t ItemOnPlayerTrigger
+			keys := predicate(pred, &t.Check)
+			sandboxed(fn, &t.Action)
+			bindTrigger(keyedBy("", keys, true), func(ev *InvOnPlayerEvent) {
+				if t.Check(ev.Item) {
+					t.Action(ev.Player, ev.Target, ev.Item)
+					ev.Cancel()
+				}
+			}, t.Check, t.Action)
 		}),
-		"object": reflect.ValueOf(func(pred func(*Object, int) bool, fn func(player *Player, object *Object, click int)) {
-			ObjectTriggers = append(ObjectTriggers, ObjectTrigger{pred, fn})
+		"invOnObject": reflect.ValueOf(func(fn interface{}) {
+			var action func(*Player, *Object, *Item) bool
+			sandboxed(fn, &action)
+			bindEvent(func(ev *InvOnObjectEvent) {
+				if !ev.Object.Boundary && action(ev.Player, ev.Object, ev.Item) {
+					ev.Cancel()
+				}
+			}, action)
 		}),
-		"item": reflect.ValueOf(func(check func(item *Item) bool, fn func(player *Player, item *Item)) {
-			ItemTriggers = append(ItemTriggers, ItemTrigger{check, fn})
+		"invOnGroundItem": reflect.ValueOf(func(fn interface{}) {
+			var action func(*Player, *GroundItem, *Item) bool
+			sandboxed(fn, &action)
+			bindEvent(func(ev *InvOnGroundItemEvent) {
+				if action(ev.Player, ev.GroundItem, ev.Item) {
+					ev.Cancel()
+				}
+			}, action)
 		}),
-		"boundary": reflect.ValueOf(func(pred func(*Object, int) bool, fn func(player *Player, object *Object, click int)) {
-			BoundaryTriggers = append(BoundaryTriggers, ObjectTrigger{pred, fn})
+		"object": reflect.ValueOf(func(pred, fn interface{}) {
+			var t ObjectTrigger
+			keys := predicate(pred, &t.Check)
+			sandboxed(fn, &t.Action)
+			bindTrigger(keyedBy("object", keys, true), func(ev *ObjectActionEvent) {
+				if !ev.Object.Boundary && t.Check(ev.Object, ev.Click) {
+					t.Action(ev.Player, ev.Object, ev.Click)
+					ev.Cancel()
+				}
+			}, t.Check, t.Action)
 		}),
-		"npc": reflect.ValueOf(func(predicate func(npc *NPC) bool, fn func(player *Player, npc *NPC)) {
-			NpcTriggers = append(NpcTriggers, NpcTrigger{predicate, fn})
+		"item": reflect.ValueOf(func(pred, fn interface{}) {
+			var t ItemTrigger
+			keys := predicate(pred, &t.Check)
+			sandboxed(fn, &t.Action)
+			bindTrigger(keyedBy("", keys, true), func(ev *ItemActionEvent) {
+				if t.Check(ev.Item) {
+					t.Action(ev.Player, ev.Item)
+					ev.Cancel()
+				}
+			}, t.Check, t.Action)
+		}),
+		"boundary": reflect.ValueOf(func(pred, fn interface{}) {
+			var t ObjectTrigger
+			keys := predicate(pred, &t.Check)
+			sandboxed(fn, &t.Action)
+			bindTrigger(keyedBy("boundary", keys, true), func(ev *ObjectActionEvent) {
+				if ev.Object.Boundary && t.Check(ev.Object, ev.Click) {
+					t.Action(ev.Player, ev.Object, ev.Click)
+					ev.Cancel()
+				}
+			}, t.Check, t.Action)
+		}),
+		"npc": reflect.ValueOf(func(pred, fn interface{}) {
+			var t NpcTrigger
+			keys := predicate(pred, &t.Check)
+			sandboxed(fn, &t.Action)
+			bindTrigger(keyedBy("", keys, true), func(ev *TalkToNpcEvent) {
+				if t.Check(ev.Npc) {
+					t.Action(ev.Player, ev.Npc)
+					ev.Cancel()
+				}
+			}, t.Check, t.Action)
 		}),
-		"spell": reflect.ValueOf(func(ident interface{}, fn func(player *Player, spell interface{})) {
+		"dialogue": reflect.ValueOf(func(pred interface{}, tree map[interface{}]interface{}) *Dialogue {
+			d := mustDialogue(tree)
+			var t NpcTrigger
+			keys := predicate(pred, &t.Check)
+			bindTrigger(keyedBy("", keys, true), func(ev *TalkToNpcEvent) {
+				if t.Check(ev.Npc) {
+					d.Run(ev.Player, ev.Npc)
+					ev.Cancel()
+				}
+			}, t.Check)
+			return d
+		}),
+		"spell": reflect.ValueOf(func(ident interface{}, fn interface{}) {
 			switch ident.(type) {
 			case int64:
-				SpellTriggers[int(ident.(int64))] = fn
+				var action Trigger
+				sandboxed(fn, &action)
+				origin := scriptOrigin()
+				binding(func(s *ScriptSet) {
+					id := int(ident.(int64))
+					if _, ok := s.Spells[id]; ok {
+						s.conflict("spell %d is bound more than once, so the one in %s replaces the rest", id, origin)
+					}
+					s.Spells[id] = action
+				})
 			}
 		}),
-		"npcAttack": reflect.ValueOf(func(pred NpcActionPredicate, fn func(player *Player, npc *NPC)) {
-			NpcAtkTriggers = append(NpcAtkTriggers, NpcBlockingTrigger{pred, fn})
+		"npcAttack": reflect.ValueOf(func(pred, fn interface{}) {
+			var t NpcBlockingTrigger
+			keys := predicate(pred, &t.Check)
+			sandboxed(fn, &t.Action)
+			bindTrigger(keyedBy("", keys, true), func(ev *NpcAttackEvent) {
+				if t.Check(ev.Player, ev.Npc) {
+					t.Action(ev.Player, ev.Npc)
+					ev.Cancel()
+				}
+			}, t.Check, t.Action)
+		}),
+		"npcKilled": reflect.ValueOf(func(pred, fn interface{}) {
+			var t NpcBlockingTrigger
+			keys := predicate(pred, &t.Check)
+			sandboxed(fn, &t.Action)
+			bindTrigger(keyedBy("", keys, false), func(ev *NpcKilledEvent) {
+				if t.Check(ev.Player, ev.Npc) {
+					t.Action(ev.Player, ev.Npc)
+				}
+			}, t.Check, t.Action)
 		}),
-		"npcKilled": reflect.ValueOf(func(pred NpcActionPredicate, fn func(player *Player, npc *NPC)) {
-			NpcDeathTriggers = append(NpcDeathTriggers, NpcBlockingTrigger{pred, fn})
+		"onDeath": reflect.ValueOf(func(fn interface{}) {
+			var action func(*PlayerDeath)
+			sandboxed(fn, &action)
+			bindEvent(func(ev *DeathEvent) {
+				action(ev.PlayerDeath)
+			}, action)
+		}),
+		"command": reflect.ValueOf(func(name string, fn interface{}) {
+			var action func(*Player, []string)
+			sandboxed(fn, &action)
+			origin := scriptOrigin()
+			binding(func(s *ScriptSet) {
+				if _, ok := s.Commands[name]; ok {
+					s.conflict("command '%s' is bound more than once, so the one in %s replaces the rest", name, origin)
+				} else if _, ok := CommandHandlers[name]; ok {
+					s.conflict("command '%s' in %s replaces a built-in command", name, origin)
+				}
+				s.Commands[name] = action
+			})
 		}),
-		"command": reflect.ValueOf(func(name string, fn func(p *Player, args []string)) {
-			CommandHandlers[name] = fn
+		"schedule": reflect.ValueOf(func(name, spec string, fn interface{}) {
+			var action func()
+			sandboxed(fn, &action)
+			job, err := tasks.NewCronJob(name, spec, action)
+			if err != nil {
+				panic(err)
+			}
+			origin := scriptOrigin()
+			binding(func(s *ScriptSet) {
+				if _, ok := s.Schedules[name]; ok {
+					s.conflict("schedule '%s' is bound more than once, so the one in %s replaces the rest", name, origin)
+				}
+				s.Schedules[name] = job
+			})
+		}),
+		"quest": reflect.ValueOf(func(id int, name string, points int, fn interface{}, rewards ...string) {
+			var reward func(*Player)
+			sandboxed(fn, &reward)
+			binding(func(s *ScriptSet) {
+				s.Quests = append(s.Quests, &Quest{ID: id, Name: name, Points: points, Rewards: rewards, Reward: reward})
+			})
 		}),
 	}
 	env.Packages["log"] = map[string]reflect.Value{
@@ -289,11 +344,58 @@ func init() {
 	}
 }
 
+//bindEvent Subscribes handler to its event on whichever script set is being bound into.  handler calls on the script
+// callbacks that follow it, and if any of those are nil, handler is subscribed as nil for Validate to report.
+func bindEvent(handler interface{}, callbacks ...interface{}) {
+	bindTrigger(nil, handler, callbacks...)
+}
+
+//bindTrigger Subscribes handler to its event like bindEvent does, indexed by keys if they are not nil.
+func bindTrigger(keys *handlerKeys, handler interface{}, callbacks ...interface{}) {
+	fn := reflect.ValueOf(handler)
+	for _, cb := range callbacks {
+		if v := reflect.ValueOf(cb); !v.IsValid() || v.IsNil() {
+			fn = reflect.Zero(fn.Type())
+		}
+	}
+	origin := scriptOrigin()
+	binding(func(s *ScriptSet) {
+		s.Events.add(fn.Type().In(0), eventHandler{fn: fn, origin: origin, keys: keys})
+	})
+}
+
+//mustDialogue Returns the dialogue described by tree, panicking if it is malformed so that the script binding it fails
+// to load.
+func mustDialogue(tree map[interface{}]interface{}) *Dialogue {
+	d, err := NewDialogue(tree)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+//keyedBy Returns the keys for indexing a trigger of the given kind under, or nil if the trigger has no static keys.
+func keyedBy(kind string, keys *TriggerKeys, exclusive bool) *handlerKeys {
+	if keys == nil {
+		return nil
+	}
+	return &handlerKeys{kind: kind, TriggerKeys: keys, exclusive: exclusive}
+}
+
 func ScriptEnv() *env.Env {
 	e := env.NewEnv()
 	parser.EnableErrorVerbose()
-	e.Define("sleep", time.Sleep)
-	e.Define("runAfter", time.AfterFunc)
+	e.Define("sleep", func(d time.Duration) {
+		Sleep(d)
+	})
+	e.Define("runAfter", func(d time.Duration, fn interface{}) *time.Timer {
+		var action func()
+		sandboxed(fn, &action)
+		if action == nil {
+			return nil
+		}
+		return time.AfterFunc(d, action)
+	})
 	e.Define("after", time.After)
 	e.Define("newProjectile", NewProjectile)
 	e.Define("Minute", time.Second*60)
@@ -335,6 +437,7 @@ func ScriptEnv() *env.Env {
 	e.Define("PRAYER_PARALYZE_MONSTER", 12)
 	e.Define("PRAYER_PROTECT_FROM_MISSILES", 13)
 	e.Define("ZeroTime", time.Time{})
+	e.Define("QUEST_COMPLETE", QuestComplete)
 	e.Define("itemDefs", definitions.Items)
 	e.Define("objectDefs", definitions.ScenaryObjects)
 	e.Define("boundaryDefs", definitions.BoundaryObjects)
@@ -346,6 +449,8 @@ func ScriptEnv() *env.Env {
 	e.Define("skillName", entity.SkillName)
 	e.Define("newNpc", NewNpc)
 	e.Define("newObject", NewObject)
+	e.Define("newGroundItem", NewGroundItem)
+	e.Define("KEEP_ALL", KeepAll)
 	e.Define("base37", strutil.Base37.Encode)
 	e.Define("rand", func(low, high int) int {
 		return int(rand.Rng.Float64()*float64(high+1)) - low
@@ -379,37 +484,11 @@ func ScriptEnv() *env.Env {
 	e.Define("weightedChance", WeightedChoice)
 	e.Define("statRoll", Statistical)
 	e.Define("CurTick", CurrentTick)
-	e.Define("npcPredicate", func(ids ...interface{}) func(*NPC) bool {
-		return func(npc *NPC) bool {
-			for _, id := range ids {
-				if cmd, ok := id.(string); ok {
-					if npc.Name() == cmd {
-						return true
-					}
-				} else if id, ok := id.(int64); ok {
-					if npc.ID == int(id) {
-						return true
-					}
-				}
-			}
-			return false
-		}
+	e.Define("npcPredicate", func(ids ...interface{}) *NpcPredicate {
+		return &NpcPredicate{newTriggerKeys(ids)}
 	})
-	e.Define("npcBlockingPredicate", func(ids ...interface{}) func(*Player, *NPC) bool {
-		return func(player *Player, npc *NPC) bool {
-			for _, id := range ids {
-				if cmd, ok := id.(string); ok {
-					if npc.Name() == cmd {
-						return true
-					}
-				} else if id, ok := id.(int64); ok {
-					if npc.ID == int(id) {
-						return true
-					}
-				}
-			}
-			return false
-		}
+	e.Define("npcBlockingPredicate", func(ids ...interface{}) *NpcBlockingPredicate {
+		return &NpcBlockingPredicate{newTriggerKeys(ids)}
 	})
 
 	e.Define("fuzzyItem", func(input string) (itemList []map[string]interface{}) {
@@ -430,40 +509,34 @@ func ScriptEnv() *env.Env {
 		return itemList
 	})
 
-	e.Define("itemPredicate", func(ids ...interface{}) func(*Item) bool {
-		return func(item *Item) bool {
-			for _, id := range ids {
-				if cmd, ok := id.(string); ok {
-					if item.Command() == cmd {
-						return true
-					}
-				} else if id, ok := id.(int64); ok {
-					if item.ID == int(id) {
-						return true
-					}
-				}
-			}
-			return false
-		}
+	e.Define("itemPredicate", func(ids ...interface{}) *ItemPredicate {
+		return &ItemPredicate{newTriggerKeys(ids)}
 	})
-	e.Define("objectPredicate", func(ids ...interface{}) func(*Object, int) bool {
-		return func(object *Object, click int) bool {
-			for _, id := range ids {
-				if cmd, ok := id.(string); ok {
-					if definitions.ScenaryObjects[object.ID].Commands[click] == cmd {
-						return true
-					}
-				} else if id, ok := id.(int64); ok {
-					if object.ID == int(id) {
-						return true
-					}
-				}
-			}
-			return false
-		}
+	e.Define("objectPredicate", func(ids ...interface{}) *ObjectPredicate {
+		return &ObjectPredicate{newTriggerKeys(ids)}
 	})
 	e.Define("asPlayer", AsPlayer)
 	e.Define("asNpc", AsNpc)
 	e = core.Import(e)
+	e.Define("load", func(path string) interface{} {
+		return loadScript(e, path)
+	})
 	return e
 }
+
+//idsPackage Returns the scripts ids package.  Item ids and their aliases are at the top level, e.g ids.AIR_RUNE, and
+// NPCs, scenery objects and boundaries each get their own namespace, e.g ids.npcs.MAN or ids.objects.TREE.
+func idsPackage() map[string]reflect.Value {
+	pkg := make(map[string]reflect.Value, len(ids.Items)+len(ids.ItemAliases)+4)
+	for name, id := range ids.Items {
+		pkg[name] = reflect.ValueOf(id)
+	}
+	for name, id := range ids.ItemAliases {
+		pkg[name] = reflect.ValueOf(id)
+	}
+	pkg["items"] = reflect.ValueOf(ids.Items)
+	pkg["npcs"] = reflect.ValueOf(ids.Npcs)
+	pkg["objects"] = reflect.ValueOf(ids.Objects)
+	pkg["boundaries"] = reflect.ValueOf(ids.Boundaries)
+	return pkg
+}
@@ -0,0 +1,444 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//Event Something that happened in the game world, that handlers subscribed on an EventBus get to react to.  Events are
+// handed to their handlers by pointer, so that handlers may change them to change the outcome.
+type Event interface {
+	//Cancel Stops the event from reaching any handlers after this one.  Where the engine would go on to act on the
+	// event, e.g dropping an item, or telling the player nothing interesting happens, a cancelled event stops it.
+	Cancel()
+	//Cancelled Returns true if a handler has cancelled this event.
+	Cancelled() bool
+}
+
+//Cancellable Embedded by every event type, to make it an Event.
+type Cancellable struct {
+	cancelled bool
+}
+
+//Cancel Stops the event from reaching any more handlers, and tells the engine not to act on it.
+func (c *Cancellable) Cancel() {
+	c.cancelled = true
+}
+
+//Cancelled Returns true if a handler has cancelled this event.
+func (c *Cancellable) Cancelled() bool {
+	return c.cancelled
+}
+
+//LoginEvent A player has finished logging in.
+type LoginEvent struct {
+	Cancellable
+	Player *Player
+}
+
+//LogoutEvent A player is logging out.
+type LogoutEvent struct {
+	Cancellable
+	Player *Player
+}
+
+//LevelUpEvent A player gained one or more levels in a skill.
+type LevelUpEvent struct {
+	Cancellable
+	Player *Player
+	Skill  int
+	// Level is the skill's new maximum level.
+	Level int
+}
+
+//ItemPickupEvent A player is picking up an item from the ground.  Cancelling it leaves the item where it is.
+type ItemPickupEvent struct {
+	Cancellable
+	Player *Player
+	Item   *GroundItem
+}
+
+//ItemDropEvent A player is dropping an item from their inventory.  Cancelling it keeps the item in their inventory.
+type ItemDropEvent struct {
+	Cancellable
+	Player *Player
+	Item   *Item
+}
+
+//ChatEvent A player is talking in public chat.  Handlers may change the message, and cancelling it means nobody hears it.
+type ChatEvent struct {
+	Cancellable
+	Player  *Player
+	Message string
+}
+
+//RegionChangeEvent A player moved from one region of the world into another.  Players move in parallel, so this is
+// queued, and emitted during the logic phase of the next tick.
+type RegionChangeEvent struct {
+	Cancellable
+	Player   *Player
+	From, To Location
+}
+
+//TradeCompleteEvent A player finished trading with Target.  Both sides of a trade get an event of their own.
+type TradeCompleteEvent struct {
+	Cancellable
+	Player, Target *Player
+	// Gave and Received are the items that Player traded away and got back.
+	Gave, Received []*Item
+}
+
+//ItemActionEvent A player clicked on an item in their inventory.  Cancelling it marks the action as handled.
+type ItemActionEvent struct {
+	Cancellable
+	Player *Player
+	Item   *Item
+}
+
+//ObjectActionEvent A player clicked on an object or boundary.  Click is 0 for the first option and 1 for the second.
+// Cancelling it marks the action as handled.
+type ObjectActionEvent struct {
+	Cancellable
+	Player *Player
+	Object *Object
+	Click  int
+}
+
+//InvOnObjectEvent A player used an inventory item on an object or boundary.  Cancelling it marks the action as handled.
+type InvOnObjectEvent struct {
+	Cancellable
+	Player *Player
+	Object *Object
+	Item   *Item
+}
+
+//InvOnGroundItemEvent A player used an inventory item on a ground item.  Cancelling it marks the action as handled.
+type InvOnGroundItemEvent struct {
+	Cancellable
+	Player     *Player
+	GroundItem *GroundItem
+	Item       *Item
+}
+
+//InvOnPlayerEvent A player used an inventory item on another player.  Cancelling it marks the action as handled.
+type InvOnPlayerEvent struct {
+	Cancellable
+	Player, Target *Player
+	Item           *Item
+}
+
+//TalkToNpcEvent A player started talking to an NPC.  Cancelling it marks the NPC as having had something to say.
+type TalkToNpcEvent struct {
+	Cancellable
+	Player *Player
+	Npc    *NPC
+}
+
+//NpcAttackEvent A player is about to attack an NPC.  Cancelling it stops the fight from starting.
+type NpcAttackEvent struct {
+	Cancellable
+	Player *Player
+	Npc    *NPC
+}
+
+//NpcKilledEvent A player has killed an NPC.
+type NpcKilledEvent struct {
+	Cancellable
+	Player *Player
+	Npc    *NPC
+}
+
+//DeathEvent A player has died.  Handlers may change the rules of the death before it is carried out.
+type DeathEvent struct {
+	Cancellable
+	*PlayerDeath
+}
+
+//eventTypes Maps the name that scripts know each event by to its type, and eventNames maps them back.
+var eventTypes = make(map[string]reflect.Type)
+var eventNames = make(map[reflect.Type]string)
+
+//registerEvent Makes the event type of ev known to event buses, and to scripts by name.
+func registerEvent(name string, ev Event) {
+	t := reflect.TypeOf(ev)
+	eventTypes[name] = t
+	eventNames[t] = name
+}
+
+func init() {
+	registerEvent("login", (*LoginEvent)(nil))
+	registerEvent("logout", (*LogoutEvent)(nil))
+	registerEvent("levelUp", (*LevelUpEvent)(nil))
+	registerEvent("itemPickup", (*ItemPickupEvent)(nil))
+	registerEvent("itemDrop", (*ItemDropEvent)(nil))
+	registerEvent("chat", (*ChatEvent)(nil))
+	registerEvent("regionChange", (*RegionChangeEvent)(nil))
+	registerEvent("tradeComplete", (*TradeCompleteEvent)(nil))
+	registerEvent("itemAction", (*ItemActionEvent)(nil))
+	registerEvent("objectAction", (*ObjectActionEvent)(nil))
+	registerEvent("invOnObject", (*InvOnObjectEvent)(nil))
+	registerEvent("invOnGroundItem", (*InvOnGroundItemEvent)(nil))
+	registerEvent("invOnPlayer", (*InvOnPlayerEvent)(nil))
+	registerEvent("talkToNpc", (*TalkToNpcEvent)(nil))
+	registerEvent("npcAttack", (*NpcAttackEvent)(nil))
+	registerEvent("npcKilled", (*NpcKilledEvent)(nil))
+	registerEvent("death", (*DeathEvent)(nil))
+}
+
+//eventHandler A function subscribed to an event, that takes a pointer to the event type as its only argument.
+type eventHandler struct {
+	priority int
+	// order counts up with every handler added to a bus, to keep handlers of the same priority in the order they came.
+	order int
+	fn    reflect.Value
+	// origin names where the handler came from, for reporting conflicts.
+	origin string
+	// keys is what the handler is indexed by, or nil if it needs to see every event of its type.
+	keys *handlerKeys
+}
+
+//before Returns true if h runs before other.
+func (h eventHandler) before(other eventHandler) bool {
+	if h.priority != other.priority {
+		return h.priority > other.priority
+	}
+	return h.order < other.order
+}
+
+//EventBus A set of handlers subscribed to events, ordered by priority.
+type EventBus struct {
+	// handlers are replaced rather than changed whenever a handler is added, so that emitting never needs to hold
+	// the lock while the handlers run.
+	handlers map[reflect.Type][]eventHandler
+	// index holds an index of the handlers for each event type that has been emitted since its handlers last changed.
+	index map[reflect.Type]*triggerIndex
+	added int
+	sync.RWMutex
+}
+
+//NewEventBus Returns a new event bus with nothing subscribed to it.
+func NewEventBus() *EventBus {
+	return &EventBus{handlers: make(map[reflect.Type][]eventHandler), index: make(map[reflect.Type]*triggerIndex)}
+}
+
+//Events The event bus that Go code subscribes its hooks to.  These last for the life of the server, while handlers
+// bound by scripts live on the event bus of their ScriptSet, and get replaced along with it.
+var Events = NewEventBus()
+
+//On Subscribes fn to an event.  fn must be a function that takes a pointer to one of the event types, e.g
+// func(*LevelUpEvent), and it is called with every event of that type.  Handlers with a higher priority run first, and
+// handlers sharing a priority run in the order that they were subscribed.
+func (b *EventBus) On(priority int, fn interface{}) error {
+	t := reflect.TypeOf(fn)
+	if t == nil || t.Kind() != reflect.Func || t.NumIn() != 1 || t.NumOut() != 0 {
+		return fmt.Errorf("event handlers must be a function taking an event, not %v", t)
+	}
+	if _, ok := eventNames[t.In(0)]; !ok {
+		return fmt.Errorf("%v is not an event", t.In(0))
+	}
+	if reflect.ValueOf(fn).IsNil() {
+		return fmt.Errorf("nil handler for the %s event", eventNames[t.In(0)])
+	}
+	b.add(t.In(0), eventHandler{priority: priority, fn: reflect.ValueOf(fn), origin: "Go"})
+	return nil
+}
+
+//add Subscribes h to the event of type t, after every handler with the same or a higher priority.  h may have a nil
+// function, for Validate to report later.
+func (b *EventBus) add(t reflect.Type, h eventHandler) {
+	b.Lock()
+	defer b.Unlock()
+	b.added++
+	h.order = b.added
+	old := b.handlers[t]
+	i := sort.Search(len(old), func(i int) bool {
+		return old[i].priority < h.priority
+	})
+	handlers := make([]eventHandler, 0, len(old)+1)
+	handlers = append(handlers, old[:i]...)
+	handlers = append(handlers, h)
+	b.handlers[t] = append(handlers, old[i:]...)
+	delete(b.index, t)
+}
+
+//subscribed Returns the handlers subscribed to the event of type t, in the order they are to run.
+func (b *EventBus) subscribed(t reflect.Type) []eventHandler {
+	b.RLock()
+	defer b.RUnlock()
+	return b.handlers[t]
+}
+
+//indexed Returns the index of the handlers subscribed to the event of type t, building it if need be.
+func (b *EventBus) indexed(t reflect.Type) *triggerIndex {
+	b.RLock()
+	idx := b.index[t]
+	b.RUnlock()
+	if idx != nil {
+		return idx
+	}
+	b.Lock()
+	defer b.Unlock()
+	if idx = b.index[t]; idx == nil {
+		idx = newTriggerIndex(b.handlers[t])
+		b.index[t] = idx
+	}
+	return idx
+}
+
+//candidates Returns the handlers on this bus that may want ev, in the order they are to run in.  For events that
+// triggers can be indexed by, this is only the triggers keyed for what the event is about, along with any that could
+// not be indexed.
+func (b *EventBus) candidates(ev Event) []eventHandler {
+	t := reflect.TypeOf(ev)
+	if keyed, ok := ev.(keyedEvent); ok {
+		return b.indexed(t).candidates(keyed)
+	}
+	return b.subscribed(t)
+}
+
+//Conflicts Describes every id or command that more than one trigger on this bus is keyed by, where only the first of
+// those triggers would ever get to handle it.
+func (b *EventBus) Conflicts() (found []string) {
+	b.RLock()
+	types := make([]reflect.Type, 0, len(b.handlers))
+	for t := range b.handlers {
+		types = append(types, t)
+	}
+	b.RUnlock()
+	for _, t := range types {
+		found = append(found, b.indexed(t).conflicts(eventNames[t])...)
+	}
+	sort.Strings(found)
+	return
+}
+
+//Len Returns how many handlers are subscribed to the event with the given name.
+func (b *EventBus) Len(name string) int {
+	return len(b.subscribed(eventTypes[name]))
+}
+
+//String Returns how many handlers are subscribed to each event that has any.
+func (b *EventBus) String() string {
+	b.RLock()
+	defer b.RUnlock()
+	names := make([]string, 0, len(b.handlers))
+	for t := range b.handlers {
+		names = append(names, eventNames[t])
+	}
+	sort.Strings(names)
+	counts := make([]string, 0, len(names))
+	for _, name := range names {
+		counts = append(counts, fmt.Sprintf("%d %s", len(b.handlers[eventTypes[name]]), name))
+	}
+	return strings.Join(counts, ", ")
+}
+
+//Validate Returns an error if any handler on this bus is nil.
+func (b *EventBus) Validate() error {
+	b.RLock()
+	defer b.RUnlock()
+	for t, handlers := range b.handlers {
+		for i, h := range handlers {
+			if !h.fn.IsValid() || h.fn.IsNil() {
+				return fmt.Errorf("%s handler %d is nil", eventNames[t], i)
+			}
+		}
+	}
+	return nil
+}
+
+//Emit Hands ev to every handler on this bus that is subscribed to it, and returns true if one of them cancelled it.
+func (b *EventBus) Emit(ev Event) bool {
+	return dispatch(ev, b.candidates(ev))
+}
+
+//dispatch Hands ev to each of handlers in turn, until one of them cancels it.  Returns true if it was cancelled.
+func dispatch(ev Event, handlers []eventHandler) bool {
+	arg := []reflect.Value{reflect.ValueOf(ev)}
+	for _, h := range handlers {
+		if ev.Cancelled() {
+			break
+		}
+		h.fn.Call(arg)
+	}
+	return ev.Cancelled()
+}
+
+//liveEvents The event bus of the live script set.
+var liveEvents = struct {
+	bus *EventBus
+	sync.RWMutex
+}{bus: NewEventBus()}
+
+//queuedEvents Events raised while the engine runs players in parallel, held back to be emitted one at a time.
+var queuedEvents = struct {
+	events []Event
+	sync.Mutex
+}{}
+
+//Queue Holds ev back to be emitted during the logic phase of the next tick, along with everything else queued before
+// it.  Events raised from the parallel phases of a tick are queued, so that their handlers run one at a time like
+// everything else that touches more than one player.
+func Queue(ev Event) {
+	queuedEvents.Lock()
+	defer queuedEvents.Unlock()
+	queuedEvents.events = append(queuedEvents.events, ev)
+}
+
+//EmitQueued Emits every queued event, in the order they were queued.  The game engine calls this during its logic
+// phase.
+func EmitQueued() {
+	queuedEvents.Lock()
+	events := queuedEvents.events
+	queuedEvents.events = nil
+	queuedEvents.Unlock()
+	for _, ev := range events {
+		Emit(ev)
+	}
+}
+
+//Subscribed Returns true if any handler, from Go or from the live scripts, may want ev.  Handlers that could not be
+// indexed by what they want are always counted, as there is no telling without running them.
+func Subscribed(ev Event) bool {
+	liveEvents.RLock()
+	bus := liveEvents.bus
+	liveEvents.RUnlock()
+	return len(bus.candidates(ev)) > 0 || len(Events.candidates(ev)) > 0
+}
+
+//Emit Hands ev to every handler subscribed to it, from Go and from the live scripts, in order of priority.  Returns
+// true if one of them cancelled it.
+func Emit(ev Event) bool {
+	liveEvents.RLock()
+	bus := liveEvents.bus
+	liveEvents.RUnlock()
+	scripted := bus.candidates(ev)
+	native := Events.candidates(ev)
+	if len(scripted) == 0 {
+		return dispatch(ev, native)
+	}
+	if len(native) == 0 {
+		return dispatch(ev, scripted)
+	}
+	handlers := make([]eventHandler, 0, len(native)+len(scripted))
+	handlers = append(handlers, native...)
+	handlers = append(handlers, scripted...)
+	// Both lists are already in order; a stable sort keeps Go handlers ahead of script handlers of the same priority.
+	sort.SliceStable(handlers, func(i, j int) bool {
+		return handlers[i].priority > handlers[j].priority
+	})
+	return dispatch(ev, handlers)
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestEventPriorityAndCancel(t *testing.T) {
+	bus := NewEventBus()
+	var order []string
+	for _, h := range []struct {
+		priority int
+		name     string
+	}{{0, "first"}, {5, "urgent"}, {0, "second"}, {-1, "late"}} {
+		name := h.name
+		if err := bus.On(h.priority, func(ev *ChatEvent) {
+			order = append(order, name)
+			if name == "second" && ev.Message == "stop" {
+				ev.Cancel()
+			}
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if bus.Emit(&ChatEvent{Message: "hi"}) {
+		t.Error("event was cancelled without any handler cancelling it")
+	}
+	if got := fmt.Sprint(order); got != "[urgent first second late]" {
+		t.Errorf("handlers ran in the wrong order: %v", got)
+	}
+	order = nil
+	if !bus.Emit(&ChatEvent{Message: "stop"}) {
+		t.Error("cancelled event was not reported as cancelled")
+	}
+	if got := fmt.Sprint(order); got != "[urgent first second]" {
+		t.Errorf("handlers after the cancel still ran: %v", got)
+	}
+
+	if bus.On(0, func(*Player) {}) == nil || bus.On(0, (func(*ChatEvent))(nil)) == nil || bus.On(0, "chat") == nil {
+		t.Error("bus accepted a handler that can not take an event")
+	}
+}
+
+func TestSubscribed(t *testing.T) {
+	defer installScripts(NewScriptSet())
+	if _, err := reloadScripts([]scriptFile{{"npc.ank", `bind.npc(npcPredicate(6), func(player, npc) {})`}}); err != nil {
+		t.Fatal(err)
+	}
+	SwapScripts()
+	if !Subscribed(&TalkToNpcEvent{Npc: &NPC{ID: 6}}) {
+		t.Error("talking to an NPC with a trigger had no subscribers")
+	}
+	if Subscribed(&TalkToNpcEvent{Npc: &NPC{ID: 7}}) {
+		t.Error("talking to an NPC without a trigger had subscribers")
+	}
+}
+
+func TestQueuedEvents(t *testing.T) {
+	native := Events
+	defer func() {
+		Events = native
+	}()
+	Events = NewEventBus()
+	var got []string
+	if err := Events.On(0, func(ev *ChatEvent) {
+		got = append(got, ev.Message)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	Queue(&ChatEvent{Message: "first"})
+	Queue(&ChatEvent{Message: "second"})
+	if len(got) != 0 {
+		t.Fatal("queued events were emitted straight away")
+	}
+	EmitQueued()
+	if fmt.Sprint(got) != "[first second]" {
+		t.Fatalf("emitted queued events %v, want [first second]", got)
+	}
+	EmitQueued()
+	if len(got) != 2 {
+		t.Fatal("queued events were emitted more than once")
+	}
+}
+
+func TestScriptEvents(t *testing.T) {
+	defer installScripts(NewScriptSet())
+	var heard []string
+	defer func(old *EventBus) {
+		Events = old
+	}(Events)
+	Events = NewEventBus()
+	Events.On(0, func(ev *LevelUpEvent) {
+		heard = append(heard, "go")
+	})
+	_, err := reloadScripts([]scriptFile{{"levels.ank", `
+bind.on("levelUp", func(ev) {
+	ev.Level = ev.Level + 1
+})
+bind.on("levelUp", 10, func(ev) {
+	if ev.Skill == 3 {
+		ev.Cancel()
+	}
+})
+bind.item(func(item) { return item.ID == 1 }, func(player, item) {})
+`}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	SwapScripts()
+
+	ev := &LevelUpEvent{Skill: 0, Level: 2}
+	if Emit(ev) || ev.Level != 3 || len(heard) != 1 {
+		t.Errorf("event did not reach both Go and script handlers: %+v, %v", ev, heard)
+	}
+	if !Emit(&LevelUpEvent{Skill: 3}) || len(heard) != 1 {
+		t.Error("high priority script handler did not cancel the event before the Go handler")
+	}
+	if !Emit(&ItemActionEvent{Item: &Item{ID: 1}}) || Emit(&ItemActionEvent{Item: &Item{ID: 2}}) {
+		t.Error("bind.item did not handle exactly the items its predicate accepts")
+	}
+
+	if _, err := reloadScripts([]scriptFile{{"bad.ank", `bind.on("noSuchEvent", func(ev) {})`}}); err == nil {
+		t.Error("binding an unknown event succeeded")
+	}
+}
@@ -0,0 +1,241 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"math"
+
+	"github.com/spkaeros/rscgo/pkg/definitions"
+)
+
+// Two-party item exchanges.
+//
+// An Exchange moves items between two inventories as a single all-or-nothing operation.  Both inventories are locked
+// for the duration, always in the same order no matter which side started the exchange, so two exchanges touching
+// the same inventories can not deadlock each other.  Every offered item and every free slot is validated against the
+// locked inventories up front, and the new contents of both sides are only committed once the whole swap is known to fit.
+// An exchange may also release items to the world, e.g onto the ground, or have a single party that only releases.
+
+const (
+	//ExchangeMissingItems The inventory does not hold everything it offered.
+	ExchangeMissingItems = "does not hold all of the offered items"
+	//ExchangeNoRoom The inventory does not have room for everything it is to receive.
+	ExchangeNoRoom = "does not have room to hold the items"
+	//ExchangeImbalanced The exchange would have created or destroyed items, and was abandoned by the item audit.
+	ExchangeImbalanced = "would not balance its items"
+)
+
+//ExchangeError Describes why an Exchange was rejected, and which of its inventories was at fault.
+type ExchangeError struct {
+	Inventory *Inventory
+	Reason    string
+}
+
+func (e *ExchangeError) Error() string {
+	return inventoryAccount(e.Inventory) + " " + e.Reason
+}
+
+//Exchange An atomic swap of items between two inventories.
+type Exchange struct {
+	name     string
+	parties  [2]*Inventory
+	offers   [2][]*Item
+	releases [2][]*Item
+}
+
+//NewExchange returns a new, empty exchange between inventories a and b, described by name in audit logs.  b may be
+// nil, for an exchange that only releases items out of a.
+func NewExchange(name string, a, b *Inventory) *Exchange {
+	return &Exchange{name: name, parties: [2]*Inventory{a, b}}
+}
+
+//party returns the index of inv within this exchange, or -1 if it is not a party to it.
+func (e *Exchange) party(inv *Inventory) int {
+	for i, p := range e.parties {
+		if p != nil && p == inv {
+			return i
+		}
+	}
+	return -1
+}
+
+//Offer adds amount of the item with the provided ID to what from gives the other party.
+func (e *Exchange) Offer(from *Inventory, id, amount int) {
+	if idx := e.party(from); idx >= 0 && e.parties[1-idx] != nil && amount > 0 {
+		e.offers[idx] = append(e.offers[idx], &Item{ID: id, Amount: amount})
+	}
+}
+
+//OfferAll adds every item in offer to what from gives the other party, e.g a trade or duel offer window.
+func (e *Exchange) OfferAll(from *Inventory, offer *Inventory) {
+	offer.Range(func(item *Item) bool {
+		e.Offer(from, item.ID, item.Amount)
+		return true
+	})
+}
+
+//Release adds amount of the item with the provided ID to what from gives up to the world rather than the other
+// party, e.g items dropped to the ground.  The caller is responsible for putting them wherever they go once the
+// exchange has been applied.
+func (e *Exchange) Release(from *Inventory, id, amount int) {
+	if idx := e.party(from); idx >= 0 && amount > 0 {
+		e.releases[idx] = append(e.releases[idx], &Item{ID: id, Amount: amount})
+	}
+}
+
+//Apply validates and performs the exchange.  Either every offered and released item leaves its inventory, or nothing
+// does and an *ExchangeError describing the offending inventory is returned.
+func (e *Exchange) Apply() error {
+	first, second := e.parties[0], e.parties[1]
+	if second != nil && first.Serial() > second.Serial() {
+		first, second = second, first
+	}
+	first.Lock.Lock()
+	if second != nil && second != first {
+		second.Lock.Lock()
+	}
+
+	var invs []*Inventory
+	var lists [2][]*Item
+	var removed [2][]*Item
+	var err error
+	released := make(map[int]int)
+	for i, inv := range e.parties {
+		if inv == nil {
+			continue
+		}
+		invs = append(invs, inv)
+		lists[i], removed[i] = inv.take(inv.List, append(append([]*Item{}, e.offers[i]...), e.releases[i]...))
+		if lists[i] == nil {
+			err = &ExchangeError{inv, ExchangeMissingItems}
+			break
+		}
+		for _, item := range e.releases[i] {
+			released[item.ID] += item.Amount
+		}
+	}
+	if err == nil {
+		for i, inv := range e.parties {
+			if inv == nil {
+				continue
+			}
+			if lists[i] = inv.put(lists[i], e.offers[1-i]); lists[i] == nil {
+				err = &ExchangeError{inv, ExchangeNoRoom}
+				break
+			}
+		}
+	}
+	if err == nil && !auditItems(e.name, invs...).verify(lists[:len(invs)], released) {
+		err = &ExchangeError{first, ExchangeImbalanced}
+	}
+	if err == nil {
+		for i, inv := range e.parties {
+			if inv != nil {
+				inv.List = lists[i]
+			}
+		}
+	}
+
+	if second != nil && second != first {
+		second.Lock.Unlock()
+	}
+	first.Lock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	for i, inv := range e.parties {
+		if inv == nil || inv.Owner == nil {
+			continue
+		}
+		for _, item := range removed[i] {
+			inv.Owner.DequipItem(item)
+		}
+		inv.Owner.SendInventory()
+	}
+	return nil
+}
+
+//stacks returns true if items with the provided ID occupy a single slot in this inventory.
+func (i *Inventory) stacks(id int) bool {
+	return i.stackEverything || (id >= 0 && id < len(definitions.Items) && definitions.Items[id].Stackable)
+}
+
+//take returns a copy of list with every item in offer removed from it, along with the removed items that were being
+// worn.  The caller must hold the inventory lock.  If list does not contain all of offer, returns nil.
+func (i *Inventory) take(list []*Item, offer []*Item) ([]*Item, []*Item) {
+	list = append([]*Item{}, list...)
+	var worn []*Item
+	for _, want := range offer {
+		amount := want.Amount
+		for idx := len(list) - 1; idx >= 0 && amount > 0; idx-- {
+			item := list[idx]
+			if item.ID != want.ID {
+				continue
+			}
+			if i.stacks(item.ID) && item.Amount > amount {
+				list[idx] = &Item{ID: item.ID, Amount: item.Amount - amount, Worn: item.Worn}
+				amount = 0
+				break
+			}
+			if !i.stacks(item.ID) && item.Amount > 1 {
+				// Inventory.Add will put several non-stackable items into one slot if asked to; only take what was offered.
+				taken := int(math.Min(float64(item.Amount), float64(amount)))
+				if taken < item.Amount {
+					list[idx] = &Item{ID: item.ID, Amount: item.Amount - taken, Worn: item.Worn}
+					amount -= taken
+					continue
+				}
+			}
+			amount -= item.Amount
+			if item.Worn {
+				worn = append(worn, item)
+			}
+			list = append(list[:idx], list[idx+1:]...)
+		}
+		if amount > 0 {
+			return nil, nil
+		}
+	}
+	return list, worn
+}
+
+//put returns a copy of list with every item in offer added to it.  The caller must hold the inventory lock.
+// If the items would not fit within this inventories capacity, returns nil.
+func (i *Inventory) put(list []*Item, offer []*Item) []*Item {
+	list = append([]*Item{}, list...)
+	for _, item := range offer {
+		if i.stacks(item.ID) {
+			added := false
+			for idx, held := range list {
+				if held.ID == item.ID {
+					if held.Amount+item.Amount > math.MaxInt32 {
+						return nil
+					}
+					list[idx] = &Item{ID: held.ID, Amount: held.Amount + item.Amount, Worn: held.Worn}
+					added = true
+					break
+				}
+			}
+			if !added {
+				list = append(list, &Item{ID: item.ID, Amount: item.Amount})
+			}
+			continue
+		}
+		for n := 0; n < item.Amount; n++ {
+			list = append(list, &Item{ID: item.ID, Amount: 1})
+		}
+	}
+	if len(list) > i.Capacity {
+		return nil
+	}
+	return list
+}
@@ -0,0 +1,197 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+
+package world
+
+import (
+	"sync"
+	"testing"
+)
+
+//exchangeInventory returns an inventory with room for capacity slots, holding the provided items.
+func exchangeInventory(capacity int, items ...*Item) *Inventory {
+	return &Inventory{List: items, Capacity: capacity}
+}
+
+//itemTotals returns the total amount of each item ID held in inv.
+func itemTotals(inv *Inventory) map[int]int {
+	inv.Lock.RLock()
+	defer inv.Lock.RUnlock()
+	return listTotals(inv.List)
+}
+
+func sameAmounts(a, b map[int]int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for id, amount := range a {
+		if b[id] != amount {
+			return false
+		}
+	}
+	return true
+}
+
+func TestExchangeMissingItems(t *testing.T) {
+	groundTestItems(t)
+	a := exchangeInventory(30, &Item{ID: 1, Amount: 1}, &Item{ID: 0, Amount: 100})
+	b := exchangeInventory(30, &Item{ID: 2, Amount: 1})
+	beforeA, beforeB := itemTotals(a), itemTotals(b)
+
+	e := NewExchange("test", a, b)
+	e.Offer(a, 1, 1)
+	e.Offer(a, 0, 50)
+	// b offers more than it holds, so a must keep everything it offered as well
+	e.Offer(b, 2, 2)
+	err := e.Apply()
+	if failed, ok := err.(*ExchangeError); !ok || failed.Inventory != b || failed.Reason != ExchangeMissingItems {
+		t.Fatalf("Apply() = %v, want b to be missing items", err)
+	}
+	if !sameAmounts(itemTotals(a), beforeA) || !sameAmounts(itemTotals(b), beforeB) {
+		t.Errorf("rejected exchange changed the inventories: a=%v b=%v", itemTotals(a), itemTotals(b))
+	}
+}
+
+func TestExchangeNoRoom(t *testing.T) {
+	groundTestItems(t)
+	// a swaps one item for two, but only has the one slot it frees up
+	a := exchangeInventory(1, &Item{ID: 1, Amount: 1})
+	b := exchangeInventory(30, &Item{ID: 2, Amount: 1}, &Item{ID: 3, Amount: 1})
+	e := NewExchange("test", a, b)
+	e.Offer(a, 1, 1)
+	e.Offer(b, 2, 1)
+	e.Offer(b, 3, 1)
+	checkNoRoom(t, e, a, a, b)
+
+	// b receives an item but gives nothing, with every slot already in use
+	a = exchangeInventory(30, &Item{ID: 1, Amount: 1}, &Item{ID: 0, Amount: 10})
+	b = exchangeInventory(2, &Item{ID: 2, Amount: 1}, &Item{ID: 3, Amount: 1})
+	e = NewExchange("test", a, b)
+	e.Offer(a, 0, 10)
+	e.Offer(a, 1, 1)
+	checkNoRoom(t, e, b, a, b)
+}
+
+//checkNoRoom applies e, and fails the test unless it was rejected for want lacking room, with a and b untouched.
+func checkNoRoom(t *testing.T, e *Exchange, want, a, b *Inventory) {
+	t.Helper()
+	beforeA, beforeB := itemTotals(a), itemTotals(b)
+	err := e.Apply()
+	if failed, ok := err.(*ExchangeError); !ok || failed.Inventory != want || failed.Reason != ExchangeNoRoom {
+		t.Fatalf("Apply() = %v, want %v to have no room", err, want.List)
+	}
+	if !sameAmounts(itemTotals(a), beforeA) || !sameAmounts(itemTotals(b), beforeB) {
+		t.Errorf("rejected exchange changed the inventories: a=%v b=%v", itemTotals(a), itemTotals(b))
+	}
+}
+
+func TestExchangeStacks(t *testing.T) {
+	groundTestItems(t)
+	a := exchangeInventory(30, &Item{ID: 0, Amount: 100})
+	b := exchangeInventory(1, &Item{ID: 0, Amount: 5})
+
+	e := NewExchange("test", a, b)
+	e.Offer(a, 0, 40)
+	if err := e.Apply(); err != nil {
+		t.Fatalf("Apply() = %v, want nil", err)
+	}
+	if a.Size() != 1 || a.CountID(0) != 60 {
+		t.Errorf("giver holds %v, want one stack of 60", a.List)
+	}
+	// a full inventory still has room for more of a stack it already holds
+	if b.Size() != 1 || b.CountID(0) != 45 {
+		t.Errorf("receiver holds %v, want one stack of 45", b.List)
+	}
+
+	e = NewExchange("test", a, b)
+	e.Offer(b, 0, 45)
+	if err := e.Apply(); err != nil {
+		t.Fatalf("Apply() = %v, want nil", err)
+	}
+	if a.Size() != 1 || a.CountID(0) != 105 || b.Size() != 0 {
+		t.Errorf("after giving the whole stack back a=%v b=%v, want a=105 and b empty", a.List, b.List)
+	}
+}
+
+func TestExchangeRelease(t *testing.T) {
+	groundTestItems(t)
+	DebugInvariants = true
+	defer func() {
+		DebugInvariants = false
+	}()
+	failures := InvariantFailures.Load()
+	a := exchangeInventory(30, &Item{ID: 0, Amount: 100}, &Item{ID: 1, Amount: 1}, &Item{ID: 2, Amount: 1})
+
+	e := NewExchange("test", a, nil)
+	e.Release(a, 0, 30)
+	e.Release(a, 1, 1)
+	if err := e.Apply(); err != nil {
+		t.Fatalf("Apply() = %v, want nil", err)
+	}
+	if want := map[int]int{0: 70, 2: 1}; !sameAmounts(itemTotals(a), want) {
+		t.Errorf("after releasing, holds %v, want %v", itemTotals(a), want)
+	}
+
+	e = NewExchange("test", a, nil)
+	e.Release(a, 2, 1)
+	e.Release(a, 1, 1)
+	if err := e.Apply(); err == nil || err.(*ExchangeError).Reason != ExchangeMissingItems {
+		t.Errorf("releasing an item no longer held: Apply() = %v, want missing items", err)
+	}
+	if a.CountID(2) != 1 {
+		t.Errorf("rejected release took item 2 anyway")
+	}
+	if InvariantFailures.Load() != failures {
+		t.Errorf("released items were reported as lost by the audit")
+	}
+}
+
+func TestExchangeConcurrent(t *testing.T) {
+	groundTestItems(t)
+	invs := []*Inventory{
+		exchangeInventory(30, &Item{ID: 0, Amount: 1000}, &Item{ID: 1, Amount: 1}),
+		exchangeInventory(30, &Item{ID: 0, Amount: 1000}, &Item{ID: 2, Amount: 1}),
+		exchangeInventory(30, &Item{ID: 0, Amount: 1000}, &Item{ID: 3, Amount: 1}),
+	}
+	total := func() map[int]int {
+		sum := make(map[int]int)
+		for _, inv := range invs {
+			for id, amount := range itemTotals(inv) {
+				sum[id] += amount
+			}
+		}
+		return sum
+	}
+	before := total()
+
+	var wg sync.WaitGroup
+	for n := 0; n < 300; n++ {
+		a, b := invs[n%3], invs[(n+1)%3]
+		if n%2 == 0 {
+			// the same pair of inventories, started from the other side
+			a, b = b, a
+		}
+		wg.Add(1)
+		go func(n int, a, b *Inventory) {
+			defer wg.Done()
+			e := NewExchange("test", a, b)
+			e.Offer(a, 0, 1+n%7)
+			e.Offer(b, 1+n%3, 1)
+			// most of these are missing the non-stackable item; they must be rejected without any effect
+			e.Apply()
+		}(n, a, b)
+	}
+	wg.Wait()
+
+	if after := total(); !sameAmounts(after, before) {
+		t.Errorf("concurrent exchanges changed the items in circulation: before %v, after %v", before, after)
+	}
+}
@@ -0,0 +1,237 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"math"
+	"sync"
+
+	"github.com/spkaeros/rscgo/pkg/tasks"
+)
+
+// Ground item lifecycle.
+//
+// Every ground item enters and leaves the world through GroundItems, which keeps the timers for all of them on a single
+// engine tick task.  An owned item is private to its owner for PrivateTicks, then public for PublicTicks, and then it
+// despawns.  Items may ask to stay private for longer with a privateTicks attribute.  Unowned items skip straight to public.  Persistent item spawns never despawn on their own; once taken they
+// are scheduled to respawn at the same spot.
+//
+// Stackable items dropped onto a tile that already holds a matching stack are merged into it, and each tile holds at
+// most TileCap items so that nobody can lag the players around them by dropping thousands of items in one place.  A
+// drop onto a full tile pushes out the oldest item there, unless every item there is private to somebody else, in
+// which case the drop is refused.  Items the server drops for a player, e.g on death, are placed over the cap instead.
+
+const (
+	//GroundItemPrivateTicks How long an owned ground item is only visible to its owner.
+	GroundItemPrivateTicks = TicksMinute
+	//GroundItemPublicTicks How long a ground item is visible to everyone before it despawns.
+	GroundItemPublicTicks = TicksMinute * 2
+	//GroundItemTileCap The most ground items that may sit on a single tile.
+	GroundItemTileCap = 32
+)
+
+//groundTimer The ticks at which a transient ground item becomes public, and then despawns.
+type groundTimer struct {
+	reveal, despawn uint64
+}
+
+//GroundItemManager Owns the lifecycle of every ground item in the world.
+type GroundItemManager struct {
+	PrivateTicks, PublicTicks uint64
+	TileCap                   int
+
+	tick     uint64
+	timers   map[*GroundItem]*groundTimer
+	respawns map[*GroundItem]uint64
+	start    sync.Once
+	sync.Mutex
+}
+
+//GroundItems The ground item manager for the game world.
+var GroundItems = NewGroundItemManager()
+
+//NewGroundItemManager returns a new ground item manager using the default timings.  Its timers start running the
+// first time an item is spawned.
+func NewGroundItemManager() *GroundItemManager {
+	return &GroundItemManager{
+		PrivateTicks: GroundItemPrivateTicks,
+		PublicTicks:  GroundItemPublicTicks,
+		TileCap:      GroundItemTileCap,
+		timers:       make(map[*GroundItem]*groundTimer),
+		respawns:     make(map[*GroundItem]uint64),
+	}
+}
+
+//Spawn puts item into the world and starts its timers.  If item is merged into a stack already on its tile, the stack
+// it was merged into is returned, otherwise item itself is.  If its tile is full, and nothing on it may be despawned to
+// make room, item is not spawned and nil is returned.
+func (m *GroundItemManager) Spawn(item *GroundItem) *GroundItem {
+	return m.spawn(item, false)
+}
+
+//Place puts item into the world like Spawn does, except that when nothing can be despawned to make room on a full
+// tile, item goes over the cap rather than being refused.  This is for items that the server drops on a players
+// behalf, e.g on death, which must never be lost.
+func (m *GroundItemManager) Place(item *GroundItem) *GroundItem {
+	return m.spawn(item, true)
+}
+
+//spawn puts item into the world, as Spawn or, if force is set, Place does.
+func (m *GroundItemManager) spawn(item *GroundItem, force bool) *GroundItem {
+	m.start.Do(func() {
+		tasks.TickList.Add(func() bool {
+			m.Tick()
+			return false
+		})
+	})
+	m.Lock()
+	defer m.Unlock()
+	if item.VarBool("persistent", false) {
+		delete(m.respawns, item)
+		item.SetVar("visibility", 2)
+		m.add(item)
+		return item
+	}
+	if len(item.Owner) == 0 {
+		item.SetVar("visibility", 2)
+	} else {
+		item.SetVar("visibility", 1)
+	}
+	tile := m.tileItems(item.X(), item.Y())
+	if item.Stackable() {
+		for _, stack := range tile {
+			if _, ok := m.timers[stack]; ok && stack.ID == item.ID && stack.Owner == item.Owner &&
+				stack.Visibility() == item.Visibility() && stack.Amount <= math.MaxInt32-item.Amount {
+				// the stack keeps its own timers, so that topping it up never keeps it around for longer
+				stack.Amount += item.Amount
+				return stack
+			}
+		}
+	}
+	for len(tile) >= m.TileCap {
+		// Make room by despawning whatever has been lying here the longest, so long as it is not private to somebody else
+		var oldest *GroundItem
+		for _, other := range tile {
+			if other.Visibility() == 1 && other.Owner != item.Owner {
+				continue
+			}
+			if timer, ok := m.timers[other]; ok && (oldest == nil || timer.despawn < m.timers[oldest].despawn) {
+				oldest = other
+			}
+		}
+		if oldest == nil {
+			if force {
+				break
+			}
+			item.UnsetVar("visibility")
+			return nil
+		}
+		m.despawn(oldest)
+		tile = m.tileItems(item.X(), item.Y())
+	}
+	m.timers[item] = m.timer(item)
+	m.add(item)
+	return item
+}
+
+//Despawn takes item out of the world, and schedules it to respawn if it is a persistent spawn.  Returns true only if
+// item was in the world to be taken, so that two players can never both pick up the same item.
+func (m *GroundItemManager) Despawn(item *GroundItem) bool {
+	m.Lock()
+	defer m.Unlock()
+	return m.despawn(item)
+}
+
+//Withdraw takes item out of the world for good.  Unlike Despawn, a persistent spawn is not scheduled to come back, and
+// one that is already waiting to respawn stays gone.
+func (m *GroundItemManager) Withdraw(item *GroundItem) {
+	m.Lock()
+	defer m.Unlock()
+	m.despawn(item)
+	delete(m.respawns, item)
+}
+
+//Tick advances every ground item timer by one engine tick.
+func (m *GroundItemManager) Tick() {
+	m.Lock()
+	defer m.Unlock()
+	m.tick++
+	for item, timer := range m.timers {
+		if timer.reveal != 0 && m.tick >= timer.reveal {
+			timer.reveal = 0
+			item.SetVisibility(2)
+		}
+		if m.tick >= timer.despawn {
+			m.despawn(item)
+		}
+	}
+	for item, at := range m.respawns {
+		if m.tick >= at {
+			delete(m.respawns, item)
+			item.SetVar("visibility", 2)
+			m.add(item)
+		}
+	}
+}
+
+//timer returns fresh timers for item, starting from the current tick.  Items with a privateTicks attribute, such as
+// gravestone drops, stay private for that long instead of PrivateTicks.
+func (m *GroundItemManager) timer(item *GroundItem) *groundTimer {
+	private := m.PrivateTicks
+	if ticks := item.VarInt("privateTicks", 0); ticks > 0 {
+		private = uint64(ticks)
+	}
+	if item.Visibility() != 1 {
+		private = 0
+	}
+	timer := &groundTimer{despawn: m.tick + private + m.PublicTicks}
+	if private > 0 {
+		timer.reveal = m.tick + private
+	}
+	return timer
+}
+
+//despawn removes item from the world.  The caller must hold the manager lock.
+func (m *GroundItemManager) despawn(item *GroundItem) bool {
+	region := Region(item.X(), item.Y())
+	if !region.Items.Contains(item) {
+		return false
+	}
+	delete(m.timers, item)
+	item.UnsetVar("visibility")
+	region.Items.Remove(item)
+	region.touch(viewItems)
+	if item.VarBool("persistent", false) {
+		// respawn times are stored in seconds
+		m.respawns[item] = m.tick + uint64(math.Ceil(float64(item.VarInt("respawnTime", 10)*TicksMinute)/60))
+	}
+	return true
+}
+
+//add puts item into its region.  The caller must hold the manager lock.
+func (m *GroundItemManager) add(item *GroundItem) {
+	region := Region(item.X(), item.Y())
+	region.Items.Add(item)
+	region.touch(viewItems)
+}
+
+//tileItems returns every ground item on the tile at x,y.
+func (m *GroundItemManager) tileItems(x, y int) (items []*GroundItem) {
+	region := Region(x, y)
+	region.Items.lock.RLock()
+	defer region.Items.lock.RUnlock()
+	for _, e := range region.Items.set {
+		if item, ok := e.(*GroundItem); ok && item.X() == x && item.Y() == y {
+			items = append(items, item)
+		}
+	}
+	return
+}
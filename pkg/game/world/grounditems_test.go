@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+
+package world
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/spkaeros/rscgo/pkg/definitions"
+	"github.com/spkaeros/rscgo/pkg/strutil"
+)
+
+//groundTestItems Defines four plain items for the rest of the test, of which only the first stacks.
+func groundTestItems(t *testing.T) {
+	items := definitions.Items
+	t.Cleanup(func() {
+		definitions.Items = items
+	})
+	definitions.Items = make([]definitions.ItemDefinition, 4)
+	for i := range definitions.Items {
+		definitions.Items[i] = definitions.ItemDefinition{ID: i, Name: "item" + strconv.Itoa(i), Stackable: i == 0}
+	}
+}
+
+func TestGroundItemTimers(t *testing.T) {
+	groundTestItems(t)
+	m := NewGroundItemManager()
+	m.PrivateTicks, m.PublicTicks = 2, 3
+	owned := m.Spawn(NewGroundItemFor(strutil.Base37.Encode("owner"), 1, 1, 400, 400))
+	unowned := m.Spawn(NewGroundItem(1, 1, 401, 400))
+	if owned.Visibility() != 1 || unowned.Visibility() != 2 {
+		t.Fatalf("spawned with visibility %d and %d, want 1 and 2", owned.Visibility(), unowned.Visibility())
+	}
+	m.Tick()
+	m.Tick()
+	if owned.Visibility() != 2 {
+		t.Fatal("owned item was not revealed after PrivateTicks")
+	}
+	m.Tick()
+	if unowned.Visibility() != 0 || GetItem(401, 400, 1) != nil {
+		t.Fatal("unowned item did not despawn after PublicTicks")
+	}
+	m.Tick()
+	m.Tick()
+	if owned.Visibility() != 0 || GetItem(400, 400, 1) != nil {
+		t.Fatal("owned item did not despawn after PrivateTicks+PublicTicks")
+	}
+}
+
+func TestGroundItemMerge(t *testing.T) {
+	groundTestItems(t)
+	m := NewGroundItemManager()
+	owner := strutil.Base37.Encode("owner")
+	stack := m.Spawn(NewGroundItemFor(owner, 0, 10, 410, 400))
+	if merged := m.Spawn(NewGroundItemFor(owner, 0, 5, 410, 400)); merged != stack || stack.Amount != 15 {
+		t.Fatalf("stackable drop was not merged: got amount %d", stack.Amount)
+	}
+	if other := m.Spawn(NewGroundItemFor(strutil.Base37.Encode("other"), 0, 5, 410, 400)); other == stack {
+		t.Fatal("merged stacks belonging to different players")
+	}
+	if single := m.Spawn(NewGroundItemFor(owner, 1, 1, 410, 400)); single == stack || len(m.tileItems(410, 400)) != 3 {
+		t.Fatal("merged a non-stackable item")
+	}
+}
+
+func TestGroundItemMergeKeepsTimers(t *testing.T) {
+	groundTestItems(t)
+	m := NewGroundItemManager()
+	m.PublicTicks = 3
+	stack := m.Spawn(NewGroundItem(0, 10, 415, 400))
+	for i := 0; i < 2; i++ {
+		m.Tick()
+		m.Spawn(NewGroundItem(0, 1, 415, 400))
+	}
+	if stack.Amount != 12 {
+		t.Fatalf("stack holds %d after topping it up, want 12", stack.Amount)
+	}
+	m.Tick()
+	if stack.Visibility() != 0 || GetItem(415, 400, 0) != nil {
+		t.Fatal("topping up a stack put off its despawn")
+	}
+}
+
+func TestGroundItemTileCap(t *testing.T) {
+	groundTestItems(t)
+	m := NewGroundItemManager()
+	m.TileCap = 3
+	first := m.Spawn(NewGroundItem(1, 1, 420, 400))
+	m.Tick()
+	for i := 0; i < 5; i++ {
+		m.Spawn(NewGroundItem(1, 1, 420, 400))
+	}
+	if n := len(m.tileItems(420, 400)); n != 3 {
+		t.Fatalf("tile holds %d items, want 3", n)
+	}
+	if first.Visibility() != 0 {
+		t.Fatal("the oldest item on a full tile was not the one despawned")
+	}
+}
+
+func TestGroundItemTileCapPrivate(t *testing.T) {
+	groundTestItems(t)
+	m := NewGroundItemManager()
+	m.TileCap = 2
+	owner, other := strutil.Base37.Encode("owner"), strutil.Base37.Encode("other")
+	private := m.Spawn(NewGroundItemFor(other, 1, 1, 425, 400))
+	mine := m.Spawn(NewGroundItemFor(owner, 1, 1, 425, 400))
+	if second := m.Spawn(NewGroundItemFor(owner, 2, 1, 425, 400)); second == nil || mine.Visibility() != 0 || private.Visibility() != 1 {
+		t.Fatal("a drop onto a full tile did not push out the dropper's own item")
+	}
+
+	m.Spawn(NewGroundItemFor(other, 1, 1, 426, 400))
+	m.Spawn(NewGroundItemFor(other, 2, 1, 426, 400))
+	if m.Spawn(NewGroundItemFor(owner, 3, 1, 426, 400)) != nil {
+		t.Fatal("a drop onto a tile full of somebody else's private items was not refused")
+	}
+	for _, item := range m.tileItems(426, 400) {
+		if item.Owner != strutil.Base37.Decode(other) || item.Visibility() != 1 {
+			t.Fatal("a refused drop changed what was on the tile")
+		}
+	}
+	if placed := m.Place(NewGroundItemFor(owner, 3, 1, 426, 400)); placed == nil || len(m.tileItems(426, 400)) != 3 {
+		t.Fatal("an item the server dropped for a player was lost to a full tile")
+	}
+}
+
+func TestGroundItemDespawnOnce(t *testing.T) {
+	groundTestItems(t)
+	m := NewGroundItemManager()
+	item := m.Spawn(NewGroundItem(1, 1, 430, 400))
+	if !m.Despawn(item) {
+		t.Fatal("could not despawn item")
+	}
+	if m.Despawn(item) {
+		t.Fatal("despawned the same item twice")
+	}
+}
+
+func TestGroundItemRespawn(t *testing.T) {
+	groundTestItems(t)
+	m := NewGroundItemManager()
+	item := m.Spawn(NewPersistentGroundItem(1, 1, 440, 400, 3))
+	for i := 0; i < int(m.PrivateTicks+m.PublicTicks)+1; i++ {
+		m.Tick()
+	}
+	if GetItem(440, 400, 1) != item {
+		t.Fatal("persistent spawn despawned on its own")
+	}
+	m.Despawn(item)
+	for i := 0; i < 5 && GetItem(440, 400, 1) == nil; i++ {
+		m.Tick()
+	}
+	if GetItem(440, 400, 1) != item || item.Visibility() != 2 {
+		t.Fatal("persistent spawn did not respawn 3 seconds after being taken")
+	}
+}
+
+func TestGroundItemGravestone(t *testing.T) {
+	groundTestItems(t)
+	m := NewGroundItemManager()
+	m.PrivateTicks, m.PublicTicks = 2, 3
+	item := NewGroundItemFor(strutil.Base37.Encode("owner"), 1, 1, 450, 400)
+	item.SetVar("privateTicks", 4)
+	m.Spawn(item)
+	for i := 0; i < 3; i++ {
+		m.Tick()
+	}
+	if item.Visibility() != 1 {
+		t.Fatal("gravestone item was revealed before its privateTicks were up")
+	}
+	m.Tick()
+	if item.Visibility() != 2 {
+		t.Fatal("gravestone item was not revealed after its privateTicks")
+	}
+}
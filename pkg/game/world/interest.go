@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+package world
+
+// Interest management.
+//
+// Every region carries one revision counter per kind of entity that a client is kept in sync with.  Anything that
+// adds, removes, moves or hides an entity within a region bumps that regions counter for the entity's kind.
+//
+// Every player holds a viewSnapshot per kind, recording where it stood and the revision of every region it could
+// possibly care about, at the moment its known-entity set was last synchronized.  If the player has not moved since,
+// and none of those regions changed, then nothing could have entered or left its view-area, and the update packet
+// builders can skip their region scans entirely.  This keeps the per-tick update cost proportional to activity
+// in the world, rather than to how many entities are in it.
+
+const (
+	//viewPlayers Interest in other players
+	viewPlayers = iota
+	//viewNpcs Interest in NPCs
+	viewNpcs
+	//viewObjects Interest in scenary objects
+	viewObjects
+	//viewBoundarys Interest in boundary objects
+	viewBoundarys
+	//viewItems Interest in ground items
+	viewItems
+	//viewKinds The number of entity kinds that interest is tracked for
+	viewKinds
+)
+
+//touch Marks this region as having changed for the given kinds of entity.
+func (r *region) touch(kinds ...int) {
+	for _, kind := range kinds {
+		r.revisions[kind].Inc()
+	}
+}
+
+//revision Returns the current revision of this region for the given kind of entity.
+func (r *region) revision(kind int) uint64 {
+	return r.revisions[kind].Load()
+}
+
+//viewSnapshot Records the state of a players surroundings at the moment its view-area was last synchronized with the
+// client for a single kind of entity.
+type viewSnapshot struct {
+	x, y      int
+	radius    int
+	revisions map[*region]uint64
+}
+
+//stale Returns true if the view-area this snapshot describes may no longer match reality, e.g the player moved, its
+// view radius changed, or a region that was recorded has been modified since.
+func (v *viewSnapshot) stale(p *Player, kind int) bool {
+	if v.revisions == nil || v.x != p.X() || v.y != p.Y() || v.radius != p.VarInt("viewRadius", 16) {
+		return true
+	}
+	for r, rev := range v.revisions {
+		if r.revision(kind) != rev {
+			return true
+		}
+	}
+	return false
+}
+
+//snapshot Records the current state of the players surroundings for the given kind of entity.  The regions around the
+// player are always recorded, along with the region of every location in known, so that entities the player already
+// knows about are accounted for even after the player has left their region behind.
+// This must be taken before scanning the world, so that changes made during the scan render it stale.
+func (p *Player) snapshot(kind int, known []Location) viewSnapshot {
+	v := viewSnapshot{x: p.X(), y: p.Y(), radius: p.VarInt("viewRadius", 16), revisions: make(map[*region]uint64)}
+	for _, r := range Region(p.X(), p.Y()).neighbors() {
+		v.revisions[r] = r.revision(kind)
+	}
+	seen := make(map[int]struct{})
+	for _, l := range known {
+		rx, ry := l.X()/RegionSize, l.Y()/RegionSize
+		if _, ok := seen[rx*VerticalPlanes+ry]; ok {
+			continue
+		}
+		seen[rx*VerticalPlanes+ry] = struct{}{}
+		r := get(rx, ry)
+		if _, ok := v.revisions[r]; !ok {
+			v.revisions[r] = r.revision(kind)
+		}
+	}
+	return v
+}
+
+//viewChanged Returns true if the players view-area for the given kind of entity needs to be rescanned.
+func (p *Player) viewChanged(kind int) bool {
+	return p.views[kind].stale(p, kind)
+}
+
+//viewSynced Stores snapshot as the last synchronized view-area for the given kind of entity.
+func (p *Player) viewSynced(kind int, snapshot viewSnapshot) {
+	p.views[kind] = snapshot
+}
+
+//ResetView Forces the next update of every kind of entity to rescan this players surroundings.
+func (p *Player) ResetView() {
+	for i := range p.views {
+		p.views[i] = viewSnapshot{}
+	}
+}
+
+//locations Returns the locations of every entity in this list.
+func (l *entityList) locations() (list []Location) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	for _, e := range l.set {
+		switch e := e.(type) {
+		case *Object:
+			list = append(list, e.Location)
+		case *GroundItem:
+			list = append(list, e.Location)
+		}
+	}
+	return
+}
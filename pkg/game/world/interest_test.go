@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+package world
+
+import (
+	"testing"
+
+	"github.com/spkaeros/rscgo/pkg/definitions"
+)
+
+//populate fills the area surrounding x,y with count scenary objects, count ground items and count NPCs, and returns a
+// player standing at x,y that has already synchronized its view-area with them.
+// Every caller should use its own area of the map, as the region grid is shared by the whole package.
+func populate(x, y, count int) *Player {
+	if len(definitions.ScenaryObjects) == 0 {
+		definitions.ScenaryObjects = make([]definitions.ScenaryDefinition, 1)
+	}
+	for i := 0; i < count; i++ {
+		dx, dy := i%15-7, i/15%15-7
+		AddObject(NewObject(0, 0, x+dx, y+dy, false))
+		AddItem(NewPersistentGroundItem(10, 1, x+dx, y+dy, 60))
+		AddNpc(NewNpc(0, x+dx, y+dy, x+dx, x+dx, y+dy, y+dy))
+	}
+	p := NewPlayer(nil)
+	p.SetX(x)
+	p.SetY(y)
+	p.UpdateRegion(x, y)
+	// Only so many new entities get sent per tick, so it may take a few ticks to learn about everything
+	for i := 0; i < 64 && syncView(p) > 0; i++ {
+	}
+	return p
+}
+
+//syncView builds every view-area update packet for p, and returns how many of them had anything to say.
+func syncView(p *Player) (changed int) {
+	for _, build := range []func(*Player) bool{
+		func(p *Player) bool { return ObjectLocations(p) != nil },
+		func(p *Player) bool { return BoundaryLocations(p) != nil },
+		func(p *Player) bool { return ItemLocations(p) != nil },
+		func(p *Player) bool { return NPCPositions(p) != nil },
+	} {
+		if build(p) {
+			changed++
+		}
+	}
+	PlayerPositions(p)
+	return
+}
+
+func TestViewTracksRegionChanges(t *testing.T) {
+	p := populate(120, 120, 20)
+	if changed := syncView(p); changed != 0 {
+		t.Fatalf("idle view-area produced %d updates, expected 0", changed)
+	}
+
+	o := NewObject(0, 0, 121, 121, false)
+	AddObject(o)
+	if ObjectLocations(p) == nil {
+		t.Fatal("object spawned within view-area was not sent")
+	}
+	if !p.LocalObjects.Contains(o) {
+		t.Fatal("object spawned within view-area was not made known to the player")
+	}
+
+	RemoveObject(o)
+	if ObjectLocations(p) == nil || p.LocalObjects.Contains(o) {
+		t.Fatal("object removed from view-area was not removed from the player")
+	}
+
+	i := NewPersistentGroundItem(10, 1, 119, 119, 60)
+	AddItem(i)
+	if ItemLocations(p) == nil || !p.LocalItems.Contains(i) {
+		t.Fatal("ground item spawned within view-area was not sent")
+	}
+
+	p.SetX(p.X() + 1)
+	if !p.viewChanged(viewObjects) {
+		t.Fatal("moving did not invalidate the players view-area")
+	}
+}
+
+func benchmarkView(b *testing.B, x, y, count int, rescan bool) {
+	p := populate(x, y, count)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if rescan {
+			p.ResetView()
+		}
+		syncView(p)
+	}
+}
+
+//BenchmarkViewIdle measures a tick of view-area updates for a player standing still amongst a busy, but unchanging
+// area of the map.  This is the common case, and should cost next to nothing regardless of population.
+func BenchmarkViewIdle(b *testing.B) {
+	benchmarkView(b, 200, 200, 200, false)
+}
+
+//BenchmarkViewRescan measures the same as BenchmarkViewIdle, except that every tick is forced to scan the surrounding
+// regions, as every tick used to.
+func BenchmarkViewRescan(b *testing.B) {
+	benchmarkView(b, 300, 300, 200, true)
+}
+
+//BenchmarkViewIdleSparse is BenchmarkViewIdle for a mostly empty area of the map, for comparison.
+func BenchmarkViewIdleSparse(b *testing.B) {
+	benchmarkView(b, 400, 400, 10, false)
+}
+
+//BenchmarkViewRescanSparse is BenchmarkViewRescan for a mostly empty area of the map, for comparison.
+func BenchmarkViewRescanSparse(b *testing.B) {
+	benchmarkView(b, 500, 500, 10, true)
+}
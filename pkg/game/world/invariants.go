@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"fmt"
+	"sort"
+
+	"go.uber.org/atomic"
+
+	"github.com/spkaeros/rscgo/pkg/log"
+)
+
+// Item duplication invariants.
+//
+// Every transfer that moves items between inventories goes through an Exchange, which should never create or destroy
+// items, aside from what it deliberately releases to the world (e.g ground drops).  When DebugInvariants is set, each
+// exchange is audited while it still holds the lock of every inventory it touches: the per-item totals of the new
+// contents, plus whatever it released, must match the totals it started from.  If they do not, the audit logs the
+// offending accounts loudly and the exchange is abandoned before anything is committed.  Since nothing else can change
+// those inventories in the meantime, a concurrent change is never mistaken for part of the transfer, nor undone by it.
+
+//DebugInvariants When true, item exchanges are audited for duplication or loss.  Enabled with the --invariants flag.
+var DebugInvariants = false
+
+//InvariantFailures counts how many audited transfers failed to balance since the server started.
+var InvariantFailures = atomic.NewUint64(0)
+
+//itemAudit The item totals of several inventories, taken before an exchange rewrites them.
+type itemAudit struct {
+	name   string
+	invs   []*Inventory
+	before []map[int]int
+}
+
+//auditItems snapshots the provided inventories ahead of the transfer described by name.  The caller must hold every
+// inventories lock until it has verified the transfer.  Returns nil when DebugInvariants is not set; verify always
+// succeeds on a nil audit.
+func auditItems(name string, invs ...*Inventory) *itemAudit {
+	if !DebugInvariants {
+		return nil
+	}
+	a := &itemAudit{name: name, invs: invs}
+	for _, inv := range invs {
+		a.before = append(a.before, listTotals(inv.List))
+	}
+	return a
+}
+
+//verify compares lists, the new contents of each audited inventory in the order they were audited, against the
+// snapshot.  Returns true if every item is accounted for, either by lists or by released.  Otherwise it logs the
+// imbalance and returns false, and the caller must discard lists.
+func (a *itemAudit) verify(lists [][]*Item, released map[int]int) bool {
+	if a == nil {
+		return true
+	}
+	var after []map[int]int
+	totalBefore, totalAfter := make(map[int]int), make(map[int]int)
+	for i := range a.invs {
+		after = append(after, listTotals(lists[i]))
+		for id, amount := range a.before[i] {
+			totalBefore[id] += amount
+		}
+		for id, amount := range after[i] {
+			totalAfter[id] += amount
+		}
+	}
+	for id, amount := range released {
+		totalAfter[id] += amount
+	}
+	var imbalanced []int
+	for id, amount := range totalBefore {
+		if totalAfter[id] != amount {
+			imbalanced = append(imbalanced, id)
+		}
+	}
+	for id := range totalAfter {
+		if _, ok := totalBefore[id]; !ok {
+			imbalanced = append(imbalanced, id)
+		}
+	}
+	if len(imbalanced) == 0 {
+		return true
+	}
+	sort.Ints(imbalanced)
+
+	InvariantFailures.Inc()
+	log.Warn(fmt.Sprintf("Item invariant violated during %s!  Abandoning the transfer across %d inventories.", a.name, len(a.invs)))
+	for _, id := range imbalanced {
+		log.Warn(fmt.Sprintf("\titem %d: %d before, %d after (%d released)", id, totalBefore[id], totalAfter[id]-released[id], released[id]))
+	}
+	for i, inv := range a.invs {
+		log.Warn(fmt.Sprintf("\t%s: %v => %v", inventoryAccount(inv), a.before[i], after[i]))
+	}
+	return false
+}
+
+//listTotals returns the total amount of each item ID in list.
+func listTotals(list []*Item) map[int]int {
+	totals := make(map[int]int)
+	for _, item := range list {
+		// placeholders hold a place for an item, not any of it
+		if item.Amount != 0 {
+			totals[item.ID] += item.Amount
+		}
+	}
+	return totals
+}
+
+//inventoryAccount returns a human readable name for the owner of inv, for logging.
+func inventoryAccount(inv *Inventory) string {
+	if inv.Owner == nil {
+		return "<unowned>"
+	}
+	return inv.Owner.Username()
+}
@@ -11,7 +11,6 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/spkaeros/rscgo/pkg/definitions"
-	"github.com/spkaeros/rscgo/pkg/tasks"
 	"github.com/spkaeros/rscgo/pkg/errors"
 	"github.com/spkaeros/rscgo/pkg/strutil"
 	"github.com/spkaeros/rscgo/pkg/game/entity"
@@ -131,9 +130,8 @@ var ItemIndexer = atomic.NewUint32(0)
 // Value 0 means the item has expired and is no longer visible to anybody.
 //
 // Value 1 means the item is visible to only the Owner of it and game administrators(rank=2), e.g if you kill someone or something,
-// this will be the value for the first minute or two after it is created, and then will change to value 2.
-// NOTE: If this is the current value, but the belongsTo attribute is not set e.g nobody owns this item, it will update
-// itself to value 2 prior to when it normally would.
+// this will be the value for the first GroundItemPrivateTicks after it is created, and then will change to value 2.
+// NOTE: Items that nobody owns skip this value entirely.
 //
 // Value 2 means the item is visible to all players.  This is the value when e.g the game starts and makes the worlds
 // default item spawns, or an NPC kills a player and they drop their items and/or bones...This is the state that most
@@ -143,6 +141,13 @@ func (i *GroundItem) Visibility() int {
 	return i.VarInt("visibility", 0)
 }
 
+//SetVisibility Changes who the receiver item is visible to, and lets the players around it know to look again.
+// See Visibility for the meaning of each value.
+func (i *GroundItem) SetVisibility(v int) {
+	i.SetVar("visibility", v)
+	Region(i.X(), i.Y()).touch(viewItems)
+}
+
 //SpawnedTime Returns: the time this item was spawned into the game world.
 func (i *GroundItem) SpawnedTime() time.Time {
 	return i.VarTime("spawnTime")
@@ -173,39 +178,6 @@ func NewGroundItem(id, amount, x, y int) *GroundItem {
 		},
 	}
 	item.SetVar("visibility", 1)
-	tasks.TickList.Add(func() bool {
-		item.Inc("ticker", 1)
-		curTick := item.VarInt("ticker", 0)
-		// Visiblity is scoped to item owner but I guess it doesn't have an owner.
-		// Oh well, we'll just let everyone see it early.
-		if item.Visibility() == 1 && len(item.Owner) == 0 {
-			item.SetVar("visibility", 2)
-		}
-		// This keeps track of how many times we've ticked for ~71 sec since we started.
-		stage := curTick / 110
-		// ~71 sec.
-		if curTick%110 == 0 {
-			// item only seen by owner and administrators
-
-			if item.Visibility() == 1 {
-				if stage == 1 {
-					// 25% chance to stay visibility=1 until 2nd pass at ~142s...
-					if Chance(25) {
-						return false
-					}
-
-					item.SetVar("visibility", 2)
-					return false
-				}
-			}
-			// Time for everyone to see it!
-		}
-		if stage >= 3 {
-			item.Remove()
-		}
-		return item.Visibility() == 0 || stage >= 3
-	})
-
 	item.SetVar("spawnedTime", time.Now())
 	return item
 }
@@ -271,17 +243,9 @@ func (i *GroundItem) Stackable() bool {
 	return definitions.Items[i.ID].Stackable
 }
 
-//Remove removes the ground item from the world.
-func (i *GroundItem) Remove() {
-	i.UnsetVar("visibility")
-	RemoveItem(i)
-	if i.VarBool("persistent", false) {
-		go func() {
-			time.Sleep(time.Second * time.Duration(i.VarInt("respawnTime", 10)))
-			i.SetVar("visibility", 2)
-			AddItem(i)
-		}()
-	}
+//Remove removes the ground item from the world.  Returns true if it was in the world to be removed.
+func (i *GroundItem) Remove() bool {
+	return GroundItems.Despawn(i)
 }
 
 //VisibleTo Returns true if the ground item is visible to this player, otherwise returns false.
@@ -311,6 +275,20 @@ type Inventory struct {
 	Capacity        int
 	stackEverything bool
 	Lock            sync.RWMutex
+	serial          atomic.Uint64
+}
+
+//inventorySerials hands out the serial numbers that order inventory locks.
+var inventorySerials = atomic.NewUint64(0)
+
+//Serial returns a number that identifies this inventory for as long as the server runs, assigned on first use.
+// Code that locks several inventories at once locks them in ascending serial order, so it can never deadlock.
+func (i *Inventory) Serial() uint64 {
+	if serial := i.serial.Load(); serial != 0 {
+		return serial
+	}
+	i.serial.CAS(0, inventorySerials.Inc())
+	return i.serial.Load()
 }
 
 type itemSorter []*Item
@@ -438,7 +416,7 @@ func (i *Inventory) Add(id int, qty int) int {
 		return -1
 	}
 	if !i.CanHold(id, qty) {
-		AddItem(NewGroundItemFor(i.Owner.UsernameHash(), id, qty, i.Owner.X(), i.Owner.Y()))
+		PlaceItem(NewGroundItemFor(i.Owner.UsernameHash(), id, qty, i.Owner.X(), i.Owner.Y()))
 		i.Owner.Message("Your inventory is full, the " + definitions.Items[id].Name + " drops to the ground!")
 		return -1
 	}
@@ -565,6 +543,75 @@ func (i *Inventory) RemoveAll(offer *Inventory) int {
 	return count
 }
 
+//Swap exchanges the positions of the items at indexes a and b.  Returns false if either index is out of bounds.
+func (i *Inventory) Swap(a, b int) bool {
+	i.Lock.Lock()
+	defer i.Lock.Unlock()
+	if a < 0 || b < 0 || a >= len(i.List) || b >= len(i.List) {
+		return false
+	}
+	i.List[a], i.List[b] = i.List[b], i.List[a]
+	return true
+}
+
+//Insert moves the item at index from to index to, shifting the items between them over by one to make room.
+// Returns false if either index is out of bounds.
+func (i *Inventory) Insert(from, to int) bool {
+	i.Lock.Lock()
+	defer i.Lock.Unlock()
+	if from < 0 || to < 0 || from >= len(i.List) || to >= len(i.List) {
+		return false
+	}
+	item := i.List[from]
+	if from < to {
+		copy(i.List[from:to], i.List[from+1:to+1])
+	} else {
+		copy(i.List[to+1:from+1], i.List[to:from])
+	}
+	i.List[to] = item
+	return true
+}
+
+//Placeholder puts an empty slot for the item with the provided ID at index idx, or at the end if idx is past it, so
+// that the item goes back there when more of it is added.  Returns false if the inventory already holds the item, or
+// is full.
+func (i *Inventory) Placeholder(idx, id int) bool {
+	i.Lock.Lock()
+	defer i.Lock.Unlock()
+	if len(i.List) >= i.Capacity {
+		return false
+	}
+	for _, item := range i.List {
+		if item.ID == id {
+			return false
+		}
+	}
+	if idx < 0 || idx > len(i.List) {
+		idx = len(i.List)
+	}
+	i.List = append(i.List, nil)
+	copy(i.List[idx+1:], i.List[idx:])
+	i.List[idx] = &Item{ID: id}
+	return true
+}
+
+//ReleasePlaceholders removes the empty slot left for the item with the provided ID, or every empty slot if id is -1.
+// Returns how many were removed.
+func (i *Inventory) ReleasePlaceholders(id int) int {
+	i.Lock.Lock()
+	defer i.Lock.Unlock()
+	var list []*Item
+	for _, item := range i.List {
+		if item.Amount == 0 && (id == -1 || item.ID == id) {
+			continue
+		}
+		list = append(list, item)
+	}
+	released := len(i.List) - len(list)
+	i.List = list
+	return released
+}
+
 //Clear Clears all items out of the inventory.
 func (i *Inventory) Clear() {
 	i.Lock.Lock()
@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"sync"
+	"time"
+
+	"github.com/spkaeros/rscgo/pkg/log"
+)
+
+// Item provenance ledger.
+//
+// Every time an item changes hands or moves between a player and the world, an entry is appended to the ledger.
+// Entries are buffered in memory and flushed to the ledger service in batches from their own goroutine, so that
+// recording an item movement never blocks the game engine on database I/O.
+//
+// From and To are account names.  The empty string stands for the game world itself (the ground), and shops are
+// recorded as "shop:" followed by the shop's name.
+
+const (
+	LedgerTrade    = "trade"
+	LedgerDuel     = "duel"
+	LedgerShopBuy  = "shopbuy"
+	LedgerShopSell = "shopsell"
+	LedgerDeposit  = "deposit"
+	LedgerWithdraw = "withdraw"
+	LedgerDeath    = "death"
+	LedgerPickup   = "pickup"
+	LedgerDrop     = "drop"
+	LedgerMail     = "mail"
+)
+
+const (
+	// ledgerBuffer is how many entries may be waiting to be written before new entries are discarded.
+	ledgerBuffer = 4096
+	// ledgerBatch is the most entries to hand the ledger service in a single write.
+	ledgerBatch = 256
+	// ledgerFlush is how often pending entries are written even when a batch isn't full.
+	ledgerFlush = time.Second
+)
+
+//LedgerEntry A single item movement in the item ledger.
+type LedgerEntry struct {
+	Event    string
+	From, To string
+	ItemID   int
+	Amount   int
+	X, Y     int
+	Tick     uint64
+	Time     time.Time
+}
+
+//LedgerService An interface for persisting item ledger entries.
+type LedgerService interface {
+	LedgerWrite([]LedgerEntry)
+}
+
+//DefaultLedgerService the ledger service that item movements are written to.  If nil, entries are discarded.
+var DefaultLedgerService LedgerService
+
+var (
+	ledgerQueue = make(chan LedgerEntry, ledgerBuffer)
+	// ledgerFlushes carries requests for the writer to write out everything pending straight away, each closed once it
+	// has been.
+	ledgerFlushes = make(chan chan struct{})
+	ledgerStart   sync.Once
+)
+
+//ShopAccount returns the ledger account name for the provided shop.
+func ShopAccount(s *Shop) string {
+	if s == nil {
+		return "shop:"
+	}
+	return "shop:" + s.Name
+}
+
+//RecordItem appends an item movement to the item ledger.  The entry is written asynchronously.
+func RecordItem(event, from, to string, id, amount, x, y int) {
+	if amount <= 0 {
+		return
+	}
+	ledgerStart.Do(func() {
+		go ledgerWriter()
+	})
+	entry := LedgerEntry{Event: event, From: from, To: to, ItemID: id, Amount: amount, X: x, Y: y, Tick: Ticks.Load(), Time: time.Now()}
+	select {
+	case ledgerQueue <- entry:
+	default:
+		log.Warn("Item ledger queue is full; dropping entry:", entry)
+	}
+}
+
+//FlushLedger writes every queued ledger entry to the ledger service straight away, and waits for it to be written.
+// Called on shutdown, so that no item movement recorded before it is lost.
+func FlushLedger() {
+	ledgerStart.Do(func() {
+		go ledgerWriter()
+	})
+	done := make(chan struct{})
+	ledgerFlushes <- done
+	<-done
+}
+
+//ledgerWriter drains the ledger queue into DefaultLedgerService in batches.
+func ledgerWriter() {
+	ticker := time.NewTicker(ledgerFlush)
+	defer ticker.Stop()
+	batch := make([]LedgerEntry, 0, ledgerBatch)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if DefaultLedgerService != nil {
+			DefaultLedgerService.LedgerWrite(batch)
+		}
+		batch = make([]LedgerEntry, 0, ledgerBatch)
+	}
+	for {
+		select {
+		case entry := <-ledgerQueue:
+			batch = append(batch, entry)
+			if len(batch) >= ledgerBatch {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case done := <-ledgerFlushes:
+			for drained := false; !drained; {
+				select {
+				case entry := <-ledgerQueue:
+					batch = append(batch, entry)
+					if len(batch) >= ledgerBatch {
+						flush()
+					}
+				default:
+					drained = true
+				}
+			}
+			flush()
+			close(done)
+		}
+	}
+}
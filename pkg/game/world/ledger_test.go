@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"sync"
+	"testing"
+)
+
+//ledgerRecorder A ledger service that keeps every entry written to it.
+type ledgerRecorder struct {
+	entries []LedgerEntry
+	sync.Mutex
+}
+
+func (r *ledgerRecorder) LedgerWrite(entries []LedgerEntry) {
+	r.Lock()
+	defer r.Unlock()
+	r.entries = append(r.entries, entries...)
+}
+
+func TestFlushLedger(t *testing.T) {
+	service := DefaultLedgerService
+	defer func() {
+		DefaultLedgerService = service
+	}()
+	recorder := &ledgerRecorder{}
+	DefaultLedgerService = recorder
+	for i := 0; i < ledgerBatch+10; i++ {
+		RecordItem(LedgerDrop, "flusher", "", 1, 1, 100, 100)
+	}
+	FlushLedger()
+	recorder.Lock()
+	defer recorder.Unlock()
+	written := 0
+	for _, entry := range recorder.entries {
+		if entry.From == "flusher" {
+			written++
+		}
+	}
+	if written != ledgerBatch+10 {
+		t.Errorf("flushed %d ledger entries, want %d", written, ledgerBatch+10)
+	}
+}
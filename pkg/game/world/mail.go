@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spkaeros/rscgo/pkg/log"
+	"github.com/spkaeros/rscgo/pkg/strutil"
+)
+
+// Player mail.
+//
+// Mail lets players who are not online at the same time send each other a message along with a few items.  Sent items
+// are taken out of the senders inventory and held in escrow by the mail service until the recipient next opens their
+// bank, at which point the mail is deleted from escrow and its items are put into their bank.  Every move in and out of
+// escrow is recorded in the item ledger under the recipients mail account.
+
+const (
+	//MailItems The most item stacks that can be attached to a single piece of mail.
+	MailItems = 5
+	//MailMessageLength The longest message that can be sent with a piece of mail.
+	MailMessageLength = 80
+)
+
+//Mail A message and items sent from one player to another.
+type Mail struct {
+	ID      int
+	From    string
+	To      string
+	Message string
+	Items   []*Item
+	Sent    time.Time
+}
+
+//MailService An interface for holding mail in escrow until it is delivered.
+type MailService interface {
+	//MailSend puts mail into escrow.  Returns true if it was stored.
+	MailSend(mail *Mail) bool
+	//MailPending returns every piece of mail waiting to be delivered to username, oldest first.
+	MailPending(username string) []*Mail
+	//MailDelete takes the mail with the provided ID out of escrow.  Returns true only if it was there to take.
+	MailDelete(id int) bool
+}
+
+//DefaultMailService the service that holds mail in escrow.  If nil, mail can not be sent.
+var DefaultMailService MailService
+
+//MailAccount returns the ledger account name for the mail waiting to be delivered to username.
+func MailAccount(username string) string {
+	return "mail:" + username
+}
+
+//Muted returns true if this player has been muted, and may not talk to or send mail to other players.
+func (p *Player) Muted() bool {
+	return time.Now().Before(p.Attributes.VarTime("muteTimer"))
+}
+
+//Mute stops this player from talking to or sending mail to other players for the provided duration.
+// A duration of zero or less unmutes them.
+func (p *Player) Mute(duration time.Duration) {
+	if duration <= 0 {
+		p.Attributes.UnsetVar("muteTimer")
+		return
+	}
+	p.Attributes.SetVar("muteTimer", time.Now().Add(duration))
+}
+
+//SendMail sends message to the player named to, along with the items in the provided inventory slots.  The items are
+// taken out of this players inventory straight away.  This talks to the database, so it should not be called from
+// the game engine goroutine.
+func (p *Player) SendMail(to, message string, slots []int) bool {
+	if DefaultMailService == nil {
+		p.Message("The mail service is not available right now.")
+		return false
+	}
+	if p.Muted() {
+		p.Message("You are muted, and can not send mail right now.")
+		return false
+	}
+	if len(message) > MailMessageLength {
+		message = message[:MailMessageLength]
+	}
+	if len(slots) > MailItems {
+		p.Message("You can only send up to " + strconv.Itoa(MailItems) + " items at a time.")
+		return false
+	}
+	if p.IsPanelOpened() || p.HasState(StateBanking, StateShopping) {
+		p.Message("Please finish what you are doing before sending mail.")
+		return false
+	}
+	mail := &Mail{From: p.Username(), To: to, Message: message, Sent: time.Now()}
+	escrow := &Inventory{Capacity: MailItems, stackEverything: true}
+	exchange := NewExchange("mail", p.Inventory, escrow)
+	seen := make(map[int]bool)
+	for _, slot := range slots {
+		item := p.Inventory.Get(slot)
+		if item == nil || seen[slot] {
+			p.Message("You can only attach items from different slots of your inventory.")
+			return false
+		}
+		if item.Worn {
+			p.Message("You need to take off your " + item.Name() + " before you can send it.")
+			return false
+		}
+		seen[slot] = true
+		exchange.Offer(p.Inventory, item.ID, item.Amount)
+	}
+	if err := exchange.Apply(); err != nil {
+		p.Message("Your inventory changed before your mail could be sent.")
+		return false
+	}
+	mail.Items = escrow.List
+	if !DefaultMailService.MailSend(mail) {
+		// The items never made it into escrow, so give them back
+		refund := NewExchange("mail refund", escrow, p.Inventory)
+		refund.OfferAll(escrow, escrow)
+		if err := refund.Apply(); err != nil {
+			log.Warn("Could not return unsent mail items to", p.Username()+":", err, mail.Items)
+		}
+		p.Message("Your mail could not be sent right now.  Please try again later.")
+		return false
+	}
+	for _, item := range mail.Items {
+		RecordItem(LedgerMail, p.Username(), MailAccount(to), item.ID, item.Amount, p.X(), p.Y())
+	}
+	log.Info.Printf("%v sent mail to %v with %d items: %q\n", p.Username(), to, len(mail.Items), message)
+	p.Message("Your mail has been sent to " + to + ".")
+	if recipient, ok := Players.FindHash(strutil.Base37.Encode(to)); ok && recipient != nil {
+		recipient.Message("@yel@You have new mail from " + p.Username() + "!  Visit a bank to collect it.")
+	}
+	return true
+}
+
+//CollectMail delivers any mail waiting for this player into their bank.  This talks to the database, so it should not
+// be called from the game engine goroutine.
+func (p *Player) CollectMail() {
+	if DefaultMailService == nil {
+		return
+	}
+	delivered := false
+	for _, mail := range DefaultMailService.MailPending(p.Username()) {
+		if !DefaultMailService.MailDelete(mail.ID) {
+			// Already delivered by somebody else
+			continue
+		}
+		escrow := &Inventory{List: mail.Items, Capacity: MailItems, stackEverything: true}
+		deposit := NewExchange("mail delivery", escrow, p.bank)
+		deposit.OfferAll(escrow, escrow)
+		if err := deposit.Apply(); err != nil {
+			if !DefaultMailService.MailSend(mail) {
+				log.Warn("Could not put undeliverable mail back into escrow:", mail.From, "->", mail.To, mail.Items)
+			}
+			p.Message("@yel@You have mail from " + mail.From + ", but there is no room in your bank for it.")
+			continue
+		}
+		for _, item := range mail.Items {
+			RecordItem(LedgerMail, MailAccount(p.Username()), p.Username(), item.ID, item.Amount, p.X(), p.Y())
+		}
+		var items []string
+		for _, item := range mail.Items {
+			items = append(items, strconv.Itoa(item.Amount)+" "+item.Name())
+		}
+		p.Message("@yel@Mail from " + mail.From + ": @whi@" + mail.Message)
+		if len(items) > 0 {
+			p.Message("@yel@It came with: @whi@" + strings.Join(items, ", ") + ".  They have been put in your bank.")
+		}
+		delivered = true
+	}
+	if delivered {
+		p.RefreshBank()
+	}
+}
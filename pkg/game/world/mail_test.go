@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+
+package world
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spkaeros/rscgo/pkg/strutil"
+)
+
+//memoryMail A MailService that holds mail in memory, for testing.
+type memoryMail struct {
+	sync.Mutex
+	next int
+	mail map[int]*Mail
+}
+
+func (m *memoryMail) MailSend(mail *Mail) bool {
+	m.Lock()
+	defer m.Unlock()
+	m.next++
+	mail.ID = m.next
+	m.mail[mail.ID] = mail
+	return true
+}
+
+func (m *memoryMail) MailPending(username string) (pending []*Mail) {
+	m.Lock()
+	defer m.Unlock()
+	for id := 1; id <= m.next; id++ {
+		if mail, ok := m.mail[id]; ok && mail.To == username {
+			pending = append(pending, mail)
+		}
+	}
+	return
+}
+
+func (m *memoryMail) MailDelete(id int) bool {
+	m.Lock()
+	defer m.Unlock()
+	_, ok := m.mail[id]
+	delete(m.mail, id)
+	return ok
+}
+
+func newMailTester(name string) *Player {
+	p := NewPlayer(nil)
+	p.SetVar("username", strutil.Base37.Encode(name))
+	return p
+}
+
+func TestMailEscrow(t *testing.T) {
+	groundTestItems(t)
+	service := &memoryMail{mail: make(map[int]*Mail)}
+	DefaultMailService = service
+	defer func() {
+		DefaultMailService = nil
+	}()
+
+	sender, recipient := newMailTester("sender"), newMailTester("recipient")
+	sender.Inventory.Add(0, 500)
+	sender.Inventory.Add(1, 1)
+	sender.Inventory.Add(2, 1)
+
+	if sender.SendMail(recipient.Username(), "hello", []int{0, 0}) {
+		t.Fatal("sent the same slot twice")
+	}
+	if !sender.SendMail(recipient.Username(), "hello", []int{0, 2}) {
+		t.Fatal("could not send mail")
+	}
+	if sender.Inventory.CountID(0) > 0 || sender.Inventory.CountID(2) > 0 || sender.Inventory.CountID(1) != 1 {
+		t.Fatalf("sender still holds mailed items: %v", sender.Inventory.List)
+	}
+	if len(service.MailPending(recipient.Username())) != 1 {
+		t.Fatal("mail was not held in escrow")
+	}
+
+	recipient.CollectMail()
+	if recipient.Bank().CountID(0) != 500 || recipient.Bank().CountID(2) != 1 {
+		t.Fatalf("recipient bank is missing mailed items: %v", recipient.Bank().List)
+	}
+	if len(service.MailPending(recipient.Username())) != 0 {
+		t.Fatal("delivered mail was left in escrow")
+	}
+	recipient.CollectMail()
+	if recipient.Bank().CountID(0) != 500 {
+		t.Fatal("mail was delivered twice")
+	}
+}
+
+func TestMailMuted(t *testing.T) {
+	DefaultMailService = &memoryMail{mail: make(map[int]*Mail)}
+	defer func() {
+		DefaultMailService = nil
+	}()
+	p := newMailTester("sender")
+	p.Mute(time.Minute)
+	if p.SendMail("recipient", "hello", nil) {
+		t.Fatal("muted player sent mail")
+	}
+	p.Mute(0)
+	if !p.SendMail("recipient", "hello", nil) {
+		t.Fatal("unmuted player could not send mail")
+	}
+}
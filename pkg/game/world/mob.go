@@ -239,12 +239,6 @@ func (m *Mob) Busy() bool {
 	return m.State()&StateBusy != 0
 }
 
-func (m *Mob) BusyInput() bool {
-	return m.State()&StateWaitEvent == StateChatChoosing || m.State()&StateWaitEvent == StateItemChoosing ||
-		m.State()&StateWaitEvent == StateObjectChoosing || m.State() == StateIdle
-	//	return m.State() != StateIdle && m.State() != MSItemAction
-}
-
 func (m *Mob) IsFighting() bool {
 	return m.HasState(StateFighting)
 }
@@ -381,8 +375,7 @@ func (m *Mob) SetLocation(location Location, teleport bool) {
 }
 
 func (p *Player) SetLocation(l Location, teleport bool) {
-	p.UpdateRegion(l.X(), l.Y())
-	p.Mob.SetLocation(l, teleport)
+	p.SetCoords(l.X(), l.Y(), teleport)
 }
 
 func (n *NPC) SetLocation(l Location, teleport bool) {
@@ -403,8 +396,12 @@ func (m *Mob) SetCoords(x, y int, teleport bool) {
 }
 
 func (p *Player) SetCoords(x, y int, teleport bool) {
-	p.UpdateRegion(x, y)
+	from := p.Location.Clone()
+	changed := p.UpdateRegion(x, y)
 	p.Mob.SetCoords(x, y, teleport)
+	if changed && p.Connected() {
+		Queue(&RegionChangeEvent{Player: p, From: from, To: NewLocation(x, y)})
+	}
 }
 
 func (n *NPC) SetCoords(x, y int, teleport bool) {
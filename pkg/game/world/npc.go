@@ -13,6 +13,8 @@ import (
 	"sync"
 	"time"
 
+	"go.uber.org/atomic"
+
 	"github.com/spkaeros/rscgo/pkg/definitions"
 	"github.com/spkaeros/rscgo/pkg/tasks"
 	"github.com/spkaeros/rscgo/pkg/game/entity"
@@ -22,6 +24,10 @@ import (
 //Npcs A collection of every NPC in the game, sorted by index
 var Npcs = NewMobList()
 
+//npcIndexes Counts out the server index of every NPC.  NPCs can be taken out of Npcs, e.g when the scripts that
+// spawned them are reloaded, so indexes can not be derived from its size without handing out duplicates.
+var npcIndexes = atomic.NewUint32(0)
+
 //NPC Represents a single non-playable character within the game world.
 type NPC struct {
 	Mob
@@ -48,7 +54,7 @@ func NewNpc(id int, startX int, startY int, minX, maxX, minY, maxY int) *NPC {
 		Mob: Mob{
 			skills: entity.SkillTable{},
 			Entity: Entity{
-				Index:    Npcs.Size(),
+				Index:    int(npcIndexes.Inc() - 1),
 				Location: NewLocation(startX, startY),
 			},
 			AttributeList: entity.NewAttributeList(),
@@ -159,7 +165,9 @@ func (n *NPC) UpdateRegion(x, y int) {
 			curArea.NPCs.Remove(n)
 		}
 		newArea.NPCs.Add(n)
+		newArea.touch(viewNpcs)
 	}
+	curArea.touch(viewNpcs)
 }
 
 //ResetNpcUpdateFlags Resets the synchronization update flags for all NPCs in the game world.
@@ -188,8 +196,6 @@ type NpcBlockingTrigger struct {
 	Action func(*Player, *NPC)
 }
 
-//NpcDeathTriggers List of script callbacks to run when you kill an NPC
-var NpcDeathTriggers []NpcBlockingTrigger
 
 func (n *NPC) Damage(dmg int) {
 	for _, r := range Region(n.X(), n.Y()).neighbors() {
@@ -229,11 +235,7 @@ func (n *NPC) TotalDamage() (total int) {
 
 func (n *NPC) Killed(killer entity.MobileEntity) {
 	if killer, ok := killer.(*Player); ok {
-		for _, t := range NpcDeathTriggers {
-			if t.Check(killer, n) {
-				go t.Action(killer, n)
-			}
-		}
+		go Emit(&NpcKilledEvent{Player: killer, Npc: n})
 	}
 	// first pass is to find the total so we can split up the exp properly
 	// this is because the total is not guaranteed to match max hitpoints since
@@ -257,9 +259,9 @@ func (n *NPC) Killed(killer entity.MobileEntity) {
 	n.meleeRangeDamage.RUnlock()
 
 	if dropPlayer != nil {
-		AddItem(NewGroundItemFor(dropPlayer.UsernameHash(), DefaultDrop, 1, n.X(), n.Y()))
+		PlaceItem(NewGroundItemFor(dropPlayer.UsernameHash(), DefaultDrop, 1, n.X(), n.Y()))
 	} else {
-		AddItem(NewGroundItem(DefaultDrop, 1, n.X(), n.Y()))
+		PlaceItem(NewGroundItem(DefaultDrop, 1, n.X(), n.Y()))
 	}
 	
 	killer.ResetFighting()
@@ -286,6 +288,7 @@ func (n *NPC) Respawn() {
 	}
 	n.SetLocation(n.StartPoint, true)
 	n.UnsetVar("removed")
+	Region(n.X(), n.Y()).touch(viewNpcs)
 }
 
 //TraversePath If the mob has a path, calling this method will change the mobs location to the next location described by said Path data structure.  This should be called no more than once per game tick.
@@ -324,7 +327,7 @@ func (n *NPC) ChatIndirect(target *Player, msg string) {
 }
 
 //Chat sends chat messages to target and all of target's view area players, with a 1800ms(3 tick) delay between each
-// message.
+// message.  Stops early if the coroutine target is running gets cancelled.
 func (n *NPC) Chat(target *Player, msgs ...string) {
 	if len(msgs) <= 0 {
 		return
@@ -336,6 +339,8 @@ func (n *NPC) Chat(target *Player, msgs ...string) {
 			sleep = 4
 		}
 		n.ChatIndirect(target, msg)
-		time.Sleep(time.Millisecond*640*time.Duration(sleep))
+		if !target.SleepTicks(sleep) {
+			return
+		}
 	}
 }
@@ -155,11 +155,12 @@ func ShopOpen(shop *Shop) (p *net.Packet) {
 	return p
 }
 
+//SleepWord Builds a packet to open the sleep screen, containing an image of the players current sleep word.
 func SleepWord(player *Player) (p *net.Packet) {
-	// TODO: Figure this out
-	return net.NewEmptyPacket(117)
+	return net.NewEmptyPacket(117).AddBytes(encodeSleepWord(renderSleepWord(player.VarString("sleepWord", ""))))
 }
 
+//SleepFatigue Builds a packet containing the fatigue that the player will wake up with, shown on the sleep screen.
 func SleepFatigue(player *Player) (p *net.Packet) {
 	return net.NewEmptyPacket(244).AddUint16(uint16(player.VarInt("sleepFatigue", 0)))
 }
@@ -243,29 +244,39 @@ func NPCPositions(player *Player) (p *net.Packet) {
 		return false
 	})
 
-	newCount := 0
-	for _, n := range player.NewNPCs() {
-		if player.LocalNPCs.Size() >= 255 {
-			break
-		}
-		if newCount >= 25 {
-			if player.VarInt("viewRadius", 16) > 1 {
-				player.Dec("viewRadius", 1)
+	// Nothing could have come into view if we haven't moved, nobody left, and no NPCs around us have moved
+	if removing.Size() > 0 || player.viewChanged(viewNpcs) {
+		view := player.snapshot(viewNpcs, nil)
+		newCount := 0
+		complete := true
+		for _, n := range player.NewNPCs() {
+			if player.LocalNPCs.Size() >= 255 {
+				complete = false
+				break
 			}
-			break
+			if newCount >= 25 {
+				if player.VarInt("viewRadius", 16) > 1 {
+					player.Dec("viewRadius", 1)
+				}
+				complete = false
+				break
+			}
+			if player.VarInt("viewRadius", 16) < 16 {
+				player.Inc("viewRadius", 1)
+			}
+			newCount++
+			player.LocalNPCs.Add(n)
+			p.AddBitmask(n.Index, 12)
+			// bitwise trick avoids branching to do a manual addition, and maintains binary compatibility with the original protocol
+			p.AddSignedBits(n.X()-player.X(), 5)
+			p.AddSignedBits(n.Y()-player.Y(), 5)
+			p.AddBitmask(n.Direction(), 4)
+			p.AddBitmask(n.ID, 10)
+			changed++
 		}
-		if player.VarInt("viewRadius", 16) < 16 {
-			player.Inc("viewRadius", 1)
+		if complete {
+			player.viewSynced(viewNpcs, view)
 		}
-		newCount++
-		player.LocalNPCs.Add(n)
-		p.AddBitmask(n.Index, 12)
-		// bitwise trick avoids branching to do a manual addition, and maintains binary compatibility with the original protocol
-		p.AddSignedBits(n.X()-player.X(), 5)
-		p.AddSignedBits(n.Y()-player.Y(), 5)
-		p.AddBitmask(n.Direction(), 4)
-		p.AddBitmask(n.ID, 10)
-		changed++
 	}
 	if changed <= 0 {
 		return nil
@@ -346,10 +357,17 @@ func PlayerPositions(player *Player) (p *net.Packet) {
 	for _, p1 := range removing {
 		player.LocalPlayers.Remove(p1)
 	}
+	// Nothing could have come into view if we haven't moved, nobody left, and no players around us have moved
+	if len(removing) <= 0 && !player.viewChanged(viewPlayers) {
+		return
+	}
+	view := player.snapshot(viewPlayers, nil)
+	complete := true
 	newPlayerCount := 0
 	player.NewPlayers().RangePlayers(func(p1 *Player) bool {
 		if player.LocalPlayers.Size() >= 255 {
 			// We can only support so many players.  This might even be too much
+			complete = false
 			return false
 		}
 		if newPlayerCount >= 25 {
@@ -357,6 +375,7 @@ func PlayerPositions(player *Player) (p *net.Packet) {
 			if player.VarInt("viewRadius", 16) > 1 {
 				player.Dec("viewRadius", 1)
 			}
+			complete = false
 			return false
 		} else if player.VarInt("viewRadius", 16) < 16 {
 			// Grow view area back out after it had been shrunk
@@ -377,6 +396,9 @@ func PlayerPositions(player *Player) (p *net.Packet) {
 		}
 		return false
 	})
+	if complete {
+		player.viewSynced(viewPlayers, view)
+	}
 //	if changed+newPlayerCount <= 0 {
 //		return nil
 //	}
@@ -538,6 +560,10 @@ func ClearDistantChunks(player *Player) (p *net.Packet) {
 //ObjectLocations Builds a packet with the view-area object positions in it, relative to the player.
 // If no new objects are available and no existing local objects are removed from area, returns nil.
 func ObjectLocations(player *Player) (p *net.Packet) {
+	if !player.viewChanged(viewObjects) {
+		return nil
+	}
+	view := player.snapshot(viewObjects, player.LocalObjects.locations())
 	changed := 0
 	p = net.NewEmptyPacket(48)
 	var removing = []*Object{}
@@ -577,6 +603,7 @@ func ObjectLocations(player *Player) (p *net.Packet) {
 		player.LocalObjects.Add(o)
 		changed++
 	}
+	player.viewSynced(viewObjects, view)
 	if changed == 0 {
 		return nil
 	}
@@ -586,6 +613,10 @@ func ObjectLocations(player *Player) (p *net.Packet) {
 //BoundaryLocations Builds a packet with the view-area boundary positions in it, relative to the player.
 // If no new objects are available and no existing local boundarys are removed from area, returns nil.
 func BoundaryLocations(player *Player) (p *net.Packet) {
+	if !player.viewChanged(viewBoundarys) {
+		return nil
+	}
+	view := player.snapshot(viewBoundarys, player.LocalObjects.locations())
 	changed := 0
 	p = net.NewEmptyPacket(91)
 	var removing = []*Object{}
@@ -629,6 +660,7 @@ func BoundaryLocations(player *Player) (p *net.Packet) {
 		player.LocalObjects.Add(o)
 		changed++
 	}
+	player.viewSynced(viewBoundarys, view)
 	if changed == 0 {
 		return nil
 	}
@@ -638,6 +670,10 @@ func BoundaryLocations(player *Player) (p *net.Packet) {
 //ItemLocations Builds a packet with the view-area item positions in it, relative to the player.
 // If no new items are available and no existing items are removed from area, returns nil.
 func ItemLocations(player *Player) (p *net.Packet) {
+	if !player.viewChanged(viewItems) {
+		return nil
+	}
+	view := player.snapshot(viewItems, player.LocalItems.locations())
 	changed := 0
 	p = net.NewEmptyPacket(99)
 	var removing = []*GroundItem{}
@@ -678,6 +714,7 @@ func ItemLocations(player *Player) (p *net.Packet) {
 		player.LocalItems.Add(i)
 		changed++
 	}
+	player.viewSynced(viewItems, view)
 	if changed == 0 {
 		return nil
 	}
@@ -787,21 +824,40 @@ func EquipmentStats(player *Player) (p *net.Packet) {
 	p.AddUint8(uint8(player.MagicPoints()))
 	p.AddUint8(uint8(player.PrayerPoints()))
 	p.AddUint8(uint8(player.RangedPoints()))
-	p.AddUint8(uint8(player.VarInt("questPoints", 0xFF)))
+	p.AddUint8(uint8(player.QuestPoints()))
+	return
+}
+
+//QuestList Builds a packet containing the players progress in every quest listed in the quest journal.
+// Each quest is sent as a single byte: 0 for not started, 1 for completed, and 2 for in progress.  The stock client only
+// checks for completed quests, and draws every other quest in red; clients that know about the in progress value
+// can use it to draw started quests in yellow.
+func QuestList(player *Player) (p *net.Packet) {
+	p = net.NewEmptyPacket(5)
+	for i := 0; i < QuestCount; i++ {
+		switch {
+		case player.QuestCompleted(i):
+			p.AddUint8(1)
+		case player.QuestStarted(i):
+			p.AddUint8(2)
+		default:
+			p.AddUint8(0)
+		}
+	}
 	return
 }
 
 var BankClose = net.NewEmptyPacket(203)
 
 func BankOpen(player *Player) (p *net.Packet) {
+	view := player.BankView()
 	p = net.NewEmptyPacket(42)
-	p.AddUint8(uint8(player.bank.Size()))
+	p.AddUint8(uint8(len(view)))
 	p.AddUint8(uint8(player.bank.Capacity))
-	player.bank.Range(func(item *Item) bool {
+	for _, item := range view {
 		p.AddUint16(uint16(item.ID))
 		p.AddSmart08_32(item.Amount)
-		return false
-	})
+	}
 	return p
 }
 
@@ -22,6 +22,7 @@ import (
 
 	"github.com/gobwas/ws"
 	"github.com/gobwas/ws/wsutil"
+	"go.uber.org/atomic"
 
 	"github.com/spkaeros/rscgo/pkg/definitions"
 	"github.com/spkaeros/rscgo/pkg/tasks"
@@ -60,14 +61,17 @@ type (
 		PostTickables tasks.Scripts
 		tickAction    tasks.StatusReturnCall
 		ActionLock    sync.RWMutex
-		ReplyMenuC    chan int8
+		coroutine     *Coroutine
+		coroutineLock sync.Mutex
 		killer        sync.Once
 		hasReader     bool
 		Websocket     bool
 		InQueue       chan *net.Packet
 		Reader        *bufio.Reader
 		Writer		  net.WriteFlusher
+		writeLock     sync.Mutex
 		DatabaseIndex int
+		views         [viewKinds]viewSnapshot
 		Mob
 	}
 )
@@ -90,26 +94,7 @@ func (p *Player) CanAttack(target entity.MobileEntity) bool {
 	if p.State()&StateFightingDuel == StateFightingDuel {
 		return p.Duel.Target == target && p.DuelMagic()
 	}
-	targetp := AsPlayer(target)
-	ourWild := p.Wilderness()
-	targetWild := targetp.Wilderness()
-	if ourWild < 1 || targetWild < 1 {
-		p.Message("You cannot attack other players outside of the wilderness!")
-		return false
-	}
-	delta := p.CombatDelta(target)
-	if delta < 0 {
-		delta = -delta
-	}
-	if delta > ourWild {
-		p.Message("You must move to at least level " + strconv.Itoa(delta) + " wilderness to attack " + targetp.Username() + "!")
-		return false
-	}
-	if delta > targetWild {
-		p.Message(targetp.Username() + " is not in high enough wilderness for you to attack!")
-		return false
-	}
-	return true
+	return DefaultPvpRules.CanAttack(p, AsPlayer(target))
 }
 
 func (p *Player) Username() string {
@@ -432,8 +417,9 @@ func (l Location) ReachableCoords(x, y int) bool {
 	return true
 }
 
-//UpdateRegion if this player is currently in a region, removes it from that region, and adds it to the region at x,y
-func (p *Player) UpdateRegion(x, y int) {
+//UpdateRegion if this player is currently in a region, removes it from that region, and adds it to the region at x,y.
+// Returns true if x,y is in a different region.
+func (p *Player) UpdateRegion(x, y int) bool {
 	curArea := Region(p.X(), p.Y())
 	newArea := Region(x, y)
 	if newArea != curArea {
@@ -441,7 +427,10 @@ func (p *Player) UpdateRegion(x, y int) {
 			curArea.Players.Remove(p)
 		}
 		newArea.Players.Add(p)
+		newArea.touch(viewPlayers)
 	}
+	curArea.touch(viewPlayers)
+	return newArea != curArea
 }
 
 //DistributeMeleeExp This is a helper method to distribute experience amongst the players melee stats according to
@@ -572,6 +561,7 @@ func (p *Player) DequipItem(item *Item) {
 
 //ResetAll in order, calls ResetFighting, ResetTrade, ResetTickAction, ResetFollowing, and CloseOptionMenu.
 func (p *Player) ResetAll() {
+	p.StopCoroutine("walked away")
 	p.ResetFighting()
 	p.ResetDuel()
 	p.ResetTrade()
@@ -696,6 +686,34 @@ func (p *Player) SetTradeTarget(index int) {
 	p.SetVar("tradetarget", index)
 }
 
+//Trade The state shared by both players of an open trade window.
+type Trade struct {
+	completed atomic.Bool
+}
+
+//Complete returns true to exactly one caller, which is then responsible for carrying out the trade.  Both players
+// confirming at the same time will see each others acceptance, so this elects which of them moves the items.
+func (t *Trade) Complete() bool {
+	return t.completed.CAS(false, true)
+}
+
+//OpenTrade puts this player and target into a new trade with one another.
+func (p *Player) OpenTrade(target *Player) {
+	t := &Trade{}
+	p.SetVar("trade", t)
+	target.SetVar("trade", t)
+	p.AddState(StateTrading)
+	target.AddState(StateTrading)
+}
+
+//Trade returns the trade this player is in, or nil if there is not one.
+func (p *Player) Trade() *Trade {
+	if t, ok := p.VarChecked("trade").(*Trade); ok {
+		return t
+	}
+	return nil
+}
+
 //IsTrading returns true if this player is in a trade, otherwise returns false.
 func (p *Player) IsTrading() bool {
 	return p.HasState(StateTrading)
@@ -711,6 +729,7 @@ func (p *Player) ResetTrade() {
 		p.UnsetVar("tradetarget")
 		p.UnsetVar("trade1accept")
 		p.UnsetVar("trade2accept")
+		p.UnsetVar("trade")
 		p.TradeOffer.Clear()
 		p.RemoveState(StateTrading)
 	}
@@ -840,12 +859,15 @@ func (p *Player) Destroy() {
 			p.Inventory.Owner = nil
 			if Players.Find(p) > -1 {
 				log.Debug("Unregistered:{'" + p.Username() + "'@'" + p.CurrentIP() + "'}")
+				p.StopCoroutine("logged out")
+				Emit(&LogoutEvent{Player: p})
 				p.ResetAll()
 				p.UpdateStatus(false)
 				p.SetConnected(false)
 				go func() {
 					DefaultPlayerService.PlayerSave(p)
 					RemovePlayer(p)
+					UnloadVars(p)
 				}()
 				return
 			}
@@ -984,6 +1006,8 @@ func (p *Player) Initialize() {
 	p.WritePacket(Fatigue(p))
 	p.WritePacket(EquipmentStats(p))
 	p.SendCombatPoints()
+	// quest panel
+	p.WritePacket(QuestList(p))
 
 	// inventory panel
 	p.WritePacket(InventoryItems(p))
@@ -998,29 +1022,20 @@ func (p *Player) Initialize() {
 		}
 		p.Attributes.SetVar("lastLogin", time.Now())
 	}
-	for _, fn := range LoginTriggers {
-		fn(p)
-	}
+	Emit(&LoginEvent{Player: p})
 }
+
+//WritePacket frames the packet and writes it out to the client immediately.
 func (p *Player) WritePacket(packet *net.Packet) {
+	p.WriteFrames(packet.Frame())
+}
+
+//WriteFrames writes already framed packet data out to the client immediately.
+func (p *Player) WriteFrames(frames []byte) {
+	p.writeLock.Lock()
+	defer p.writeLock.Unlock()
 	defer p.Writer.Flush()
-	if packet.Opcode == 0 {
-		p.Writer.Write(packet.FrameBuffer)
-		return
-	}
-	header := []byte{0, 0}
-	frameLength := len(packet.FrameBuffer)
-	if frameLength >= 160 {
-		header[0] = byte(frameLength>>8 + 160)
-		header[1] = byte(frameLength)
-	} else {
-		header[0] = byte(frameLength)
-		if frameLength > 0 {
-			frameLength--
-			header[1] = packet.FrameBuffer[frameLength]
-		}
-	}
-	p.Writer.Write(append(header, packet.FrameBuffer[:frameLength]...))
+	p.Writer.Write(frames)
 }
 
 //NewPlayer Returns a reference to a new player.
@@ -1069,7 +1084,8 @@ func (p *Player) OpenAppearanceChanger() {
 }
 
 //Chat sends a player NPC chat message packet to the player and all other players around it.  If multiple msgs are
-// provided, will sleep the goroutine for 3-4 ticks between each message, depending on length of message.
+// provided, will sleep for 3-4 ticks between each message, depending on length of message, and stops early if the
+// players coroutine is cancelled.
 func (p *Player) Chat(msgs ...string) {
 	for _, msg := range msgs {
 		sleep := 3
@@ -1081,7 +1097,9 @@ func (p *Player) Chat(msgs ...string) {
 			player.QueueQuestChat(p, m, msg)
 		}
 		p.QueueQuestChat(p, m, msg)
-		time.Sleep(time.Millisecond*640*time.Duration(sleep))
+		if !p.SleepTicks(sleep) {
+			return
+		}
 	}
 }
 
@@ -1148,32 +1166,48 @@ func (p *Player) QueueItemBubble(owner *Player, id int) {
 	p.SetVar("bubbleQ", append(p.VarChecked("bubbleQ").([]ItemBubble), ItemBubble{owner, id}))
 }
 
+//OpenOptionMenu opens an option menu and waits for the players reply, which they say out loud if they are talking to
+// an NPC.  Returns the index of the option they chose, or -1 if the menu could not be opened or was closed.
 func (p *Player) OpenOptionMenu(options ...string) int {
+	return p.openOptionMenu(true, options...)
+}
+
+//optionMenuReply The opcode of the packet that a player answers an option menu with.
+const optionMenuReply = 116
+
+//openOptionMenu opens an option menu and waits for the players reply.  If echo is set and the player is talking to an
+// NPC, the player says the option they chose out loud.  The reply is awaited by the coroutine the player is running, so
+// the menu is never opened outside of one.
+func (p *Player) openOptionMenu(echo bool, options ...string) int {
+	if p.Coroutine() == nil {
+		log.Warn("Option menu opened outside of a coroutine for", p.Username())
+		return -1
+	}
 	// Can get option menu during most states, even fighting, but not trading, or if we're already in a menu...
 	if p.IsPanelOpened() || p.HasState(StateMenu) {
 		return -1
 	}
 	p.AddState(StateMenu)
 	p.SendPacket(OptionMenuOpen(options...))
-	p.ReplyMenuC = make(chan int8)
-	select {
-	case r, ok := <-p.ReplyMenuC:
-		if !p.HasState(StateMenu) || !ok {
-			return -1
-		}
-		close(p.ReplyMenuC)
-		p.RemoveState(StateMenu)
-		if r < 0 || r > int8(len(options)-1) {
-			log.Warn("Invalid option menu reply:", r)
-			return -1
-		}
+	reply, ok := p.AwaitInput(optionMenuReply)
+	if !ok {
+		p.CloseOptionMenu()
+		return -1
+	}
+	if !p.HasState(StateMenu) {
+		return -1
+	}
+	p.RemoveState(StateMenu)
+	r := int(reply.ReadUint8())
+	if r > len(options)-1 {
+		log.Warn("Invalid option menu reply:", r)
+		return -1
+	}
 
-		if p.TargetNpc() != nil && p.HasState(StateChatting) {
-			p.Chat(options[r])
-		}
-		return int(r)
+	if echo && p.TargetNpc() != nil && p.HasState(StateChatting) {
+		p.Chat(options[r])
 	}
-	return -1
+	return r
 }
 
 //CloseOptionMenu closes any open option menus.
@@ -1186,7 +1220,7 @@ func (p *Player) CloseOptionMenu() {
 
 //CanWalk returns true if this player is in a state that allows walking.
 func (p *Player) CanWalk() bool {
-	if p.BusyInput() {
+	if p.Coroutine() != nil {
 		return true
 	}
 	return !p.HasState(MSBatching, StateFighting, StateTrading, StateDueling, StateChangingLooks, StateSleeping, StateChatting, StateBusy, StateShopping)
@@ -1233,16 +1267,36 @@ func (p *Player) IncCurStat(idx int, lvl int) {
 	p.SendStat(idx)
 }
 
-//SetCurStat sets this players current stat at idx to lvl and updates the client about it.
+//IncExp Adds amt experience to the stat at idx, and fatigues this player by the same amount.  Players that are at full
+// fatigue are too tired to gain any experience.
 func (p *Player) IncExp(idx int, amt int) {
 	//if idx <= 3 {
 	//	p.Attributes.Inc("combatPoints", amt)
 	//	p.SendCombatPoints()
 	//	return
 	//}
+	if p.Tired() {
+		p.Message("@gre@You are too tired to gain experience, get some rest!")
+		return
+	}
 	amt *= 20
+	p.SetFatigue(p.Fatigue() + amt)
+	if p.Fatigue() > MaxFatigue {
+		p.SetFatigue(MaxFatigue)
+	}
+	p.SendFatigue()
+	p.incExp(idx, amt)
+}
+
+//IncExpRested Adds amt experience to the stat at idx, without any regard for this players fatigue.  Meant for
+// rewards, such as from quests, rather than for training.
+func (p *Player) IncExpRested(idx int, amt int) {
+	p.incExp(idx, amt*20)
+}
+
+//incExp adds amt raw experience to the stat at idx, and handles any level ups that come of it.
+func (p *Player) incExp(idx int, amt int) {
 	p.Skills().IncExp(idx, amt)
-	// TODO: Fatigue
 	delta := entity.ExperienceToLevel(p.Skills().Experience(idx)) - p.Skills().Maximum(idx)
 	if delta > 0 {
 		p.PlaySound("advance")
@@ -1254,6 +1308,7 @@ func (p *Player) IncExp(idx int, amt int) {
 		if oldCombat != p.Skills().CombatLevel() {
 			p.UpdateAppearance()
 		}
+		Emit(&LevelUpEvent{Player: p, Skill: idx, Level: p.Skills().Maximum(idx)})
 	} else {
 		p.SendStatExp(idx)
 	}
@@ -1337,7 +1392,7 @@ func (p *Player) Skulled() bool {
 
 func (p *Player) SetSkulled(val bool) {
 	if val {
-		p.Attributes.SetVar("skullTicks", TicksTwentyMin)
+		p.Attributes.SetVar("skullTicks", DefaultPvpRules.SkullTicks)
 	} else {
 		p.Attributes.UnsetVar("skullTicks")
 	}
@@ -1364,12 +1419,12 @@ func (p *Player) SkullOn(p1 *Player) {
 
 func (p *Player) SkulledOn(user uint64) bool {
 	t, ok := p.Skulls()[user]
-	return ok && time.Since(t) <= time.Minute*time.Duration(20)
+	return ok && time.Since(t) <= ticksDuration(DefaultPvpRules.SkullTicks)
 }
 
 func (p *Player) AddSkull(user uint64) {
 	if p.SkulledOn(user) {
-		// we skulled on them within 20 mins ago, ignore call
+		// we skulled on them within the last skull duration, ignore call
 		return
 	}
 	p.SetSkulled(true)
@@ -1394,9 +1449,7 @@ func (p *Player) StartCombat(defender entity.MobileEntity) {
 	attacker := entity.MobileEntity(p)
 	if targetp := AsPlayer(defender); targetp != nil {
 		targetp.PlaySound("underattack")
-		if !p.IsDueling() && !targetp.SkulledOn(p.UsernameHash()) {
-			p.SkullOn(targetp)
-		}
+		p.Attacked(targetp)
 	}
 	p.SetVar("targetMob", defender)
 	p.SetVar("fightTarget", defender)
@@ -1433,6 +1486,9 @@ func (p *Player) StartCombat(defender entity.MobileEntity) {
 			AsNpc(defender).CacheDamage(AsPlayer(attacker).UsernameHash(), nextHit)
 		}
 		defender.Damage(nextHit)
+		if attackerp, defenderp := AsPlayer(attacker), AsPlayer(defender); attackerp != nil && defenderp != nil {
+			DefaultPvpRules.Fighting(attackerp, defenderp)
+		}
 		if defender.Skills().Current(entity.StatHits) <= 0 {
 			if attackerp := AsPlayer(attacker); attackerp != nil {
 				attackerp.PlaySound("victory")
@@ -1477,23 +1533,46 @@ func (p *Player) Killed(killer entity.MobileEntity) {
 	p.SendStats()
 	p.SetDirection(North)
 
-	deathItems := []*GroundItem{NewGroundItem(DefaultDrop, 1, p.X(), p.Y())}
+	death := NewDeath(p, killer)
+	var deathItems []*GroundItem
+	bones := 0
+	if death.Bones {
+		deathItems = append(deathItems, NewGroundItem(DefaultDrop, 1, p.X(), p.Y()))
+		bones = 1
+	}
+	event := LedgerDeath
+	// everything but the bones comes out of the players inventory, through this exchange
+	drops := NewExchange(event, p.Inventory, nil)
 	if !p.IsDueling() {
-		keepCount := 0
-		if p.PrayerActivated(8) {
-			// protect item prayer
-			keepCount++
-		}
-		if !p.Skulled() {
-			keepCount += 3
+		if death.Keep != KeepAll {
+			deathItems = append(deathItems, p.Inventory.DeathDrops(death.Keep)...)
 		}
-		deathItems = append(deathItems, p.Inventory.DeathDrops(keepCount)...)
 	} else {
-		p.DuelOffer.Lock.RLock()
-		for _, i := range p.DuelOffer.List {
-			deathItems = append(deathItems, NewGroundItem(i.ID, i.Amount, p.X(), p.Y()))
+		event = LedgerDuel
+		drops = NewExchange("duel stake", p.Inventory, nil)
+		paid := false
+		if killer != nil && killer.IsPlayer() {
+			// Stakes go straight into the winners inventory, and only hit the ground if they won't fit there.
+			winner := AsPlayer(killer)
+			payout := NewExchange("duel stake", p.Inventory, winner.Inventory)
+			payout.OfferAll(p.Inventory, p.DuelOffer)
+			if err := payout.Apply(); err != nil {
+				log.Info.Println("Duel stakes could not be paid out directly:", err)
+			} else {
+				paid = true
+				p.DuelOffer.Range(func(item *Item) bool {
+					RecordItem(LedgerDuel, p.Username(), winner.Username(), item.ID, item.Amount, winner.X(), winner.Y())
+					return true
+				})
+			}
+		}
+		if !paid {
+			p.DuelOffer.Lock.RLock()
+			for _, i := range p.DuelOffer.List {
+				deathItems = append(deathItems, NewGroundItem(i.ID, i.Amount, p.X(), p.Y()))
+			}
+			p.DuelOffer.Lock.RUnlock()
 		}
-		p.DuelOffer.Lock.RUnlock()
 		if p.Duel.Target != nil {
 			p.Duel.Target.ResetDuel()
 		}
@@ -1505,24 +1584,48 @@ func (p *Player) Killed(killer entity.MobileEntity) {
 		killerp.DistributeMeleeExp(p.ExperienceReward() / 4)
 		killerp.Message("You have defeated " + p.Username() + "!")
 	}
-	for i, v := range deathItems {
-		// becomes universally visible on NPCs, or temporarily private otherwise
-		if i == 0 || p.Inventory.RemoveByID(v.ID, v.Amount) > -1 {
-			if killer != nil && killer.IsPlayer() {
-				v.Owner = AsPlayer(killer).Username()
-			}
-			AddItem(v)
+	for _, v := range deathItems[bones:] {
+		drops.Release(p.Inventory, v.ID, v.Amount)
+	}
+	dropped := deathItems
+	if err := drops.Apply(); err != nil {
+		// nothing left the inventory, so only the bones may hit the ground
+		log.Cheatf("Death items failed during removal: %v owner:%v, killer:%v!\n", err, p, killer)
+		dropped = deathItems[:bones]
+	}
+	for _, v := range dropped {
+		// held in the gravestone for its owner, temporarily private to a killing player, or universally visible otherwise
+		if death.Gravestone > 0 {
+			v.Owner = p.Username()
+			v.SetVar("privateTicks", death.Gravestone)
+		} else if killer != nil && killer.IsPlayer() {
+			v.Owner = AsPlayer(killer).Username()
+		}
+	}
+	if death.Gravestone > 0 && len(dropped) > bones {
+		// ticks are 640ms long
+		if seconds := death.Gravestone * 64 / 100; seconds >= 120 {
+			p.Message("A gravestone will hold your items here for " + strconv.Itoa(seconds/60) + " minutes.")
 		} else {
-			log.Cheatf("Death item failed during removal: %v,%v owner:%v, killer:%v!\n", v.ID, v.Amount, p, killer)
+			p.Message("A gravestone will hold your items here for " + strconv.Itoa(seconds) + " seconds.")
+		}
+	}
+	for i, v := range dropped {
+		PlaceItem(v)
+		if i >= bones {
+			// Items land on the ground either way; the killer, if any, is who they are reserved for
+			RecordItem(event, p.Username(), "", v.ID, v.Amount, v.X(), v.Y())
 		}
 	}
 
 	p.SendEquipBonuses()
 	p.ResetFighting()
-	p.SetSkulled(false)
+	if death.Unskull {
+		p.SetSkulled(false)
+	}
 
 	plane := p.Plane()
-	p.SetLocation(SpawnPoint, true)
+	p.SetLocation(death.Respawn, true)
 	if p.Plane() != plane {
 		p.SendPlane()
 	}
@@ -1577,7 +1680,7 @@ func (p *Player) ItemBubble(id int) {
 		p.SetVar("bubbleQ", []ItemBubble{bubble})
 		return
 	}
-	p.SetVar("bubbleQ", append(q.([]ItemBubble)))
+	p.SetVar("bubbleQ", append(q.([]ItemBubble), bubble))
 }
 
 //SetStat sets the current, maximum, and experience levels of the skill at idx to lvl, and updates the client about it.
@@ -1622,8 +1725,12 @@ func (p *Player) OpenBank() {
 	if p.IsFighting() || p.IsDueling() || p.State()&(StatePanelActive|StateFighting|StateDueling) != 0 {
 		return
 	}
+	if !p.UnlockBank() {
+		return
+	}
 	p.AddState(StateBanking)
 	p.SendPacket(BankOpen(p))
+	go p.CollectMail()
 }
 
 //CloseBank closes the bank screen for this player and sets the appropriate state variables
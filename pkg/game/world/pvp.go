@@ -0,0 +1,179 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spkaeros/rscgo/pkg/game/entity"
+	"github.com/spkaeros/rscgo/pkg/log"
+)
+
+// Player versus player rules.
+//
+// Whether one player may attack another, and what happens when they do, is decided by the active PvpRules.  Every
+// timer below is measured in game ticks, and is checked against the time.Time records kept on each player.
+
+//PvpRules A set of rules for players fighting each other.
+type PvpRules struct {
+	//Anywhere Whether players may fight outside of the wilderness.
+	Anywhere bool
+	//LevelRange How many combat levels apart players may be, on top of the wilderness level they are standing in.
+	LevelRange int
+	//MultiZones Areas in which a player may be attacked by more than one player at a time.
+	MultiZones [][2]Location
+	//SkullTicks How long a player stays skulled after attacking somebody.
+	SkullTicks int
+	//RetaliationTicks How long after being attacked a player may fight back without being skulled.
+	RetaliationTicks int
+	//PjTicks How long after being attacked a player in a single combat area is protected from everybody else.
+	PjTicks int
+	//RetreatTicks How long after retreating a player may not be attacked.
+	RetreatTicks int
+	//RagTicks How long after retreating a player may not attack anybody.
+	RagTicks int
+}
+
+//PvpPresets The PvP rule sets that can be chosen in the server config, by name.
+var PvpPresets = map[string]PvpRules{
+	// Wilderness only, and the difference in combat levels may be no more than the wilderness level.
+	"classic": {SkullTicks: TicksTwentyMin, RetaliationTicks: TicksTwentyMin, RetreatTicks: 5},
+	// Classic, with protection from being piled on or hit-and-run attacks.
+	"protected": {SkullTicks: TicksTwentyMin, RetaliationTicks: TicksTwentyMin, PjTicks: 16, RetreatTicks: 5, RagTicks: 16},
+	// Fighting anywhere, within 15 combat levels of each other outside of the wilderness.
+	"pk": {Anywhere: true, LevelRange: 15, SkullTicks: TicksTwentyMin, RetaliationTicks: TicksTwentyMin, PjTicks: 8, RetreatTicks: 5},
+}
+
+//DefaultPvpRules The PvP rules used by the game world.
+var DefaultPvpRules = PvpPresets["classic"]
+
+//SetPvpRules selects the PvP preset with the provided name, using multiZones as its multi-combat areas.
+// Returns false, leaving the current rules alone, if there is no preset by that name.
+func SetPvpRules(name string, multiZones [][2]Location) bool {
+	rules, ok := PvpPresets[strings.ToLower(name)]
+	if !ok {
+		log.Warn("Unknown PvP rule preset:", name)
+		return false
+	}
+	rules.MultiZones = multiZones
+	DefaultPvpRules = rules
+	return true
+}
+
+//ticksDuration returns how long the provided number of game ticks lasts.
+func ticksDuration(ticks int) time.Duration {
+	return time.Minute * time.Duration(ticks) / TicksMinute
+}
+
+//Multi returns true if l is within a multi-combat area.
+func (r PvpRules) Multi(l Location) bool {
+	for _, zone := range r.MultiZones {
+		if l.WithinArea(zone) {
+			return true
+		}
+	}
+	return false
+}
+
+//CanAttack returns true if attacker may attack target under these rules, otherwise it tells the attacker why not and
+// returns false.
+func (r PvpRules) CanAttack(attacker, target *Player) bool {
+	ourWild, targetWild := attacker.Wilderness(), target.Wilderness()
+	if !r.Anywhere && (ourWild < 1 || targetWild < 1) {
+		attacker.Message("You cannot attack other players outside of the wilderness!")
+		return false
+	}
+	if ourWild < 0 {
+		ourWild = 0
+	}
+	if targetWild < 0 {
+		targetWild = 0
+	}
+	delta := attacker.CombatDelta(target)
+	if delta > ourWild+r.LevelRange {
+		if r.Anywhere && ourWild == 0 {
+			attacker.Message("You can only attack players within " + strconv.Itoa(r.LevelRange) + " levels of your own here!")
+		} else {
+			attacker.Message("You must move to at least level " + strconv.Itoa(delta-r.LevelRange) + " wilderness to attack " + target.Username() + "!")
+		}
+		return false
+	}
+	if delta > targetWild+r.LevelRange {
+		attacker.Message(target.Username() + " is not in high enough wilderness for you to attack!")
+		return false
+	}
+	if r.RagTicks > 0 && time.Since(attacker.LastRetreat()) <= ticksDuration(r.RagTicks) {
+		attacker.Message("You have only just retreated from combat!")
+		return false
+	}
+	if r.RetreatTicks > 0 && time.Since(target.LastRetreat()) <= ticksDuration(r.RetreatTicks) {
+		return false
+	}
+	if r.PjTicks > 0 && !r.Multi(target.Location) {
+		if last, ok := target.Var("pvpAttacker"); ok && last != attacker.UsernameHash() &&
+			time.Since(target.VarTime("pvpAttackedTime")) <= ticksDuration(r.PjTicks) {
+			attacker.Message(target.Username() + " is already under attack!")
+			return false
+		}
+	}
+	return true
+}
+
+//Retaliating returns true if attacker is fighting back against target, who attacked them recently enough that it
+// shouldn't count against them.
+func (r PvpRules) Retaliating(attacker, target *Player) bool {
+	t, ok := target.Skulls()[attacker.UsernameHash()]
+	return ok && time.Since(t) <= ticksDuration(r.RetaliationTicks)
+}
+
+//Attacked records that attacker has just attacked target, skulling the attacker unless they are retaliating.
+func (r PvpRules) Attacked(attacker, target *Player) {
+	if attacker.IsDueling() {
+		return
+	}
+	if !r.Retaliating(attacker, target) {
+		attacker.SkullOn(target)
+	}
+	r.Fighting(attacker, target)
+}
+
+//Fighting records that attacker and target are still fighting one another, e.g after every round of combat, so that
+// nobody else can attack either of them in single combat until they have been apart for long enough.
+func (r PvpRules) Fighting(attacker, target *Player) {
+	if attacker.IsDueling() {
+		return
+	}
+	now := time.Now()
+	target.SetVar("pvpAttacker", attacker.UsernameHash())
+	target.SetVar("pvpAttackedTime", now)
+	attacker.SetVar("pvpAttacker", target.UsernameHash())
+	attacker.SetVar("pvpAttackedTime", now)
+}
+
+//Attacked records that this player has just attacked target under the current PvP rules, e.g with a spell.
+func (p *Player) Attacked(target entity.MobileEntity) {
+	if targetp := AsPlayer(target); targetp != nil {
+		DefaultPvpRules.Attacked(p, targetp)
+	}
+}
+
+//TickSkull counts down this players skull by one tick, removing it once it has run out.
+func (p *Player) TickSkull() {
+	if !p.Skulled() {
+		return
+	}
+	p.Attributes.Dec("skullTicks", 1)
+	if !p.Skulled() {
+		p.SetSkulled(false)
+	}
+}
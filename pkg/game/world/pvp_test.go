@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+
+package world
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spkaeros/rscgo/pkg/strutil"
+)
+
+func newPvpTester(name string, x, y int) *Player {
+	p := NewPlayer(nil)
+	p.SetVar("username", strutil.Base37.Encode(name))
+	p.SetLocation(NewLocation(x, y), true)
+	return p
+}
+
+func TestPvpWilderness(t *testing.T) {
+	rules := PvpPresets["classic"]
+	a, b := newPvpTester("a", 220, 445), newPvpTester("b", 220, 445)
+	if rules.CanAttack(a, b) {
+		t.Fatal("classic rules allowed fighting outside of the wilderness")
+	}
+	a.SetLocation(NewLocation(220, 400), true)
+	b.SetLocation(NewLocation(220, 400), true)
+	if !rules.CanAttack(a, b) {
+		t.Fatal("classic rules did not allow fighting in the wilderness")
+	}
+	a.Skills().SetMax(0, 99)
+	a.Skills().SetCur(0, 99)
+	if rules.CanAttack(a, b) {
+		t.Fatalf("classic rules allowed a combat level difference of %d at wilderness level %d", a.CombatDelta(b), a.Wilderness())
+	}
+	if pk := PvpPresets["pk"]; !pk.CanAttack(newPvpTester("c", 220, 445), newPvpTester("d", 220, 445)) {
+		t.Fatal("pk rules did not allow fighting outside of the wilderness")
+	}
+}
+
+func TestPvpRetaliation(t *testing.T) {
+	rules := PvpPresets["classic"]
+	a, b := newPvpTester("a", 220, 400), newPvpTester("b", 220, 400)
+	rules.Attacked(a, b)
+	if !a.Skulled() {
+		t.Fatal("attacker was not skulled")
+	}
+	rules.Attacked(b, a)
+	if b.Skulled() {
+		t.Fatal("retaliating player was skulled")
+	}
+}
+
+func TestPvpSingleCombat(t *testing.T) {
+	rules := PvpPresets["protected"]
+	a, b, c := newPvpTester("a", 220, 400), newPvpTester("b", 220, 400), newPvpTester("c", 220, 400)
+	rules.Attacked(a, b)
+	if rules.CanAttack(c, b) {
+		t.Fatal("a player under attack in a single combat area was attacked by somebody else")
+	}
+	if !rules.CanAttack(a, b) {
+		t.Fatal("a player could not keep attacking their own opponent")
+	}
+	rules.MultiZones = [][2]Location{{NewLocation(200, 380), NewLocation(240, 420)}}
+	if !rules.CanAttack(c, b) {
+		t.Fatal("a player under attack in a multi-combat area could not be attacked by somebody else")
+	}
+}
+
+func TestPvpSingleCombatLasts(t *testing.T) {
+	rules := PvpPresets["protected"]
+	a, b, c := newPvpTester("a", 220, 400), newPvpTester("b", 220, 400), newPvpTester("c", 220, 400)
+	rules.Attacked(a, b)
+	// the fight started long enough ago for its protection to have run out, had nobody hit anybody since
+	started := time.Now().Add(-ticksDuration(rules.PjTicks + 1))
+	a.SetVar("pvpAttackedTime", started)
+	b.SetVar("pvpAttackedTime", started)
+	if !rules.CanAttack(c, b) {
+		t.Fatal("a player was still protected long after their fight started")
+	}
+	rules.Fighting(b, a)
+	if rules.CanAttack(c, b) || rules.CanAttack(c, a) {
+		t.Fatal("a player still in a fight in a single combat area was attacked by somebody else")
+	}
+}
+
+func TestPvpRetreat(t *testing.T) {
+	rules := PvpPresets["protected"]
+	a, b, c := newPvpTester("a", 220, 400), newPvpTester("b", 220, 400), newPvpTester("c", 220, 400)
+	b.UpdateLastRetreat()
+	if rules.CanAttack(a, b) {
+		t.Fatal("a player who just retreated was attacked")
+	}
+	if rules.CanAttack(b, c) {
+		t.Fatal("a player who just retreated attacked somebody")
+	}
+}
+
+func TestTickSkull(t *testing.T) {
+	p := newPvpTester("a", 220, 400)
+	p.Attributes.SetVar("skullTicks", 2)
+	p.TickSkull()
+	if !p.Skulled() {
+		t.Fatal("skull ran out early")
+	}
+	p.TickSkull()
+	if p.Skulled() {
+		t.Fatal("skull did not run out")
+	}
+}
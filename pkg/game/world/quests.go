@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+package world
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/spkaeros/rscgo/pkg/log"
+)
+
+//QuestCount The number of quests listed in the clients quest journal.
+const QuestCount = 50
+
+//QuestComplete The stage that a quest is set to once a player has completed it.  A stage of zero means the quest has not
+// been started yet, and any other value is up to the script defining the quest.
+const QuestComplete = -1
+
+//Quest Describes a quest that players are able to take part in.  Quests are defined by scripts, via bind.quest.
+type Quest struct {
+	//ID The index of this quest in the clients quest journal.
+	ID int
+	//Name The name of this quest, as it appears in the clients quest journal.
+	Name string
+	//Points How many quest points this quest awards upon completion.
+	Points int
+	//Rewards Lines describing the rewards for this quest, listed on the scroll shown upon completion.
+	Rewards []string
+	//Reward Called when a player completes this quest, to hand out anything beyond the quest points.  May be nil.
+	Reward func(*Player)
+}
+
+//QuestContainer A thread-safe set of quest definitions, mapped to their IDs.
+type QuestContainer struct {
+	set map[int]*Quest
+	sync.RWMutex
+}
+
+//Quests Every quest that has been defined by the scripts, mapped to its ID.
+var Quests = &QuestContainer{set: make(map[int]*Quest)}
+
+//Add Adds q to the container, replacing any quest that was already defined with the same ID.
+func (c *QuestContainer) Add(q *Quest) {
+	c.Lock()
+	defer c.Unlock()
+	if q.ID < 0 || q.ID >= QuestCount {
+		log.Warn("Tried to define quest '"+q.Name+"' with out of bounds ID:", q.ID)
+		return
+	}
+	c.set[q.ID] = q
+}
+
+//Get Returns the quest with the given ID, or nil if no such quest is defined.
+func (c *QuestContainer) Get(id int) *Quest {
+	c.RLock()
+	defer c.RUnlock()
+	return c.set[id]
+}
+
+//Range Calls fn for every defined quest, in order of ID.
+func (c *QuestContainer) Range(fn func(*Quest)) {
+	c.RLock()
+	list := make([]*Quest, 0, len(c.set))
+	for _, q := range c.set {
+		list = append(list, q)
+	}
+	c.RUnlock()
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+	for _, q := range list {
+		fn(q)
+	}
+}
+
+//Clear Removes every quest definition from the container.
+func (c *QuestContainer) Clear() {
+	c.Lock()
+	defer c.Unlock()
+	c.set = make(map[int]*Quest)
+}
+
+//replace Replaces every quest definition in the container with the quests in list, all at once.
+func (c *QuestContainer) replace(list []*Quest) {
+	set := make(map[int]*Quest, len(list))
+	for _, q := range list {
+		set[q.ID] = q
+	}
+	c.Lock()
+	defer c.Unlock()
+	c.set = set
+}
+
+//questKey Returns the name of the persistent attribute that holds the stage of the quest with the given ID.
+func questKey(id int) string {
+	return "quest" + strconv.Itoa(id)
+}
+
+//QuestStage Returns this players stage in the quest with the given ID.  0 means not started, and QuestComplete means
+// completed.
+func (p *Player) QuestStage(id int) int {
+	return p.Attributes.VarInt(questKey(id), 0)
+}
+
+//SetQuestStage Sets this players stage in the quest with the given ID, and updates their quest journal to reflect it.
+// Completing a quest should be done with CompleteQuest rather than this, so that the rewards are handed out.
+func (p *Player) SetQuestStage(id, stage int) {
+	if stage == 0 {
+		p.Attributes.UnsetVar(questKey(id))
+	} else {
+		p.Attributes.SetVar(questKey(id), stage)
+	}
+	p.SendPacket(QuestList(p))
+}
+
+//QuestStarted Returns true if this player has started, but not yet completed, the quest with the given ID.
+func (p *Player) QuestStarted(id int) bool {
+	stage := p.QuestStage(id)
+	return stage != 0 && stage != QuestComplete
+}
+
+//QuestCompleted Returns true if this player has completed the quest with the given ID.
+func (p *Player) QuestCompleted(id int) bool {
+	return p.QuestStage(id) == QuestComplete
+}
+
+//QuestPoints Returns how many quest points this player has earned.
+func (p *Player) QuestPoints() int {
+	return p.Attributes.VarInt("questPoints", 0)
+}
+
+//CompleteQuest Marks the quest with the given ID as completed for this player, awards its quest points and rewards,
+// and shows the quest completion scroll.  Does nothing if the quest is undefined, or was already completed.
+func (p *Player) CompleteQuest(id int) {
+	q := Quests.Get(id)
+	if q == nil {
+		log.Warn("Tried to complete undefined quest", id, "for", p.Username())
+		return
+	}
+	if p.QuestCompleted(id) {
+		return
+	}
+	p.Attributes.SetVar(questKey(id), QuestComplete)
+	p.Attributes.Inc("questPoints", q.Points)
+	if q.Reward != nil {
+		q.Reward(p)
+	}
+	points := strconv.Itoa(q.Points) + " quest point"
+	if q.Points != 1 {
+		points += "s"
+	}
+	p.PlaySound("advance")
+	p.Message("Well done you have completed the " + q.Name + " quest")
+	p.Message("@gre@You have gained " + points + "!")
+	p.SendPacket(QuestList(p))
+	p.SendPacket(EquipmentStats(p))
+	p.SendPacket(BigInformationBox("@yel@Congratulations!% %You have completed the " + q.Name + " quest!% %" +
+		"@whi@Rewards:%" + strings.Join(append([]string{points}, q.Rewards...), "%")))
+}
@@ -0,0 +1,288 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/vm"
+
+	"github.com/spkaeros/rscgo/pkg/log"
+	"github.com/spkaeros/rscgo/pkg/tasks"
+)
+
+//ScriptSandbox The limits that all script code runs under.
+type ScriptSandbox struct {
+	// Steps is how many statements a single call into a script may run before it is interrupted.  0 means no limit.
+	Steps int64
+	// Timeout is how long a script file, or a line ran with ::run, may take to run from top to bottom.  0 means no
+	// limit.  Callbacks that scripts bind are only held to Steps, as they spend most of their time waiting on players.
+	Timeout time.Duration
+}
+
+//Sandbox The limits that every script runs under.
+var Sandbox = &ScriptSandbox{Steps: 1000000, Timeout: 5 * time.Second}
+
+//DefaultScriptImports The packages that scripts may import when no allowlist has been configured.
+var DefaultScriptImports = []string{"bind", "world", "log", "ids", "vars", "fmt", "math", "math/rand", "regexp", "sort",
+	"strconv", "strings", "time"}
+
+//allPackages Every package that was importable before an allowlist was first applied.
+var allPackages struct {
+	values map[string]map[string]reflect.Value
+	types  map[string]map[string]reflect.Type
+	sync.Once
+}
+
+//SetScriptImports Restricts the packages that scripts can import to those named in allow.  This should be called
+// before any scripts run, as the package table is shared by every script.
+func SetScriptImports(allow []string) {
+	allPackages.Do(func() {
+		allPackages.values, allPackages.types = env.Packages, env.PackageTypes
+	})
+	values := make(map[string]map[string]reflect.Value)
+	types := make(map[string]map[string]reflect.Type)
+	for _, name := range allow {
+		if pkg, ok := allPackages.values[name]; ok {
+			values[name] = pkg
+		} else {
+			log.Warn("Script import allowlist names an unknown package:", name)
+		}
+		if pkgTypes, ok := allPackages.types[name]; ok {
+			types[name] = pkgTypes
+		}
+	}
+	env.Packages, env.PackageTypes = values, types
+}
+
+//errBudgetSpent The error a script call fails with once it has ran every statement it was allowed.
+var errBudgetSpent = fmt.Errorf("script ran past its statement budget")
+
+//scriptBudget A context that counts the statements a script runs.  The VM checks Done before every statement it runs,
+// so counting calls to it counts statements, and closing the channel it returns interrupts the script.
+type scriptBudget struct {
+	context.Context
+	steps, limit int64
+	spent        chan struct{}
+	once         sync.Once
+}
+
+//Done Counts a statement, and returns a closed channel once the budget is spent.
+func (b *scriptBudget) Done() <-chan struct{} {
+	if b.limit > 0 && atomic.AddInt64(&b.steps, 1) > b.limit {
+		b.once.Do(func() {
+			close(b.spent)
+		})
+		return b.spent
+	}
+	return b.Context.Done()
+}
+
+//Err Returns errBudgetSpent once the budget is spent, otherwise the error of the wrapped context.
+func (b *scriptBudget) Err() error {
+	select {
+	case <-b.spent:
+		return errBudgetSpent
+	default:
+		return b.Context.Err()
+	}
+}
+
+//context Returns a new context to run one call into a script under, and the function that releases it.  Calls that
+// run a script from top to bottom are given the timeout as well as the statement budget.  The call is interrupted if
+// parent is done.
+func (s *ScriptSandbox) context(parent context.Context, timed bool) (*scriptBudget, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	if timed && s.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(parent, s.Timeout)
+	}
+	return &scriptBudget{Context: ctx, limit: s.Steps, spent: make(chan struct{})}, cancel
+}
+
+//explain Returns err attributed to the script file at path and the line it happened on, and if the script was
+// interrupted, the reason why.
+func explain(ctx context.Context, path string, err error) error {
+	if err == nil {
+		return nil
+	}
+	msg, line := err.Error(), 0
+	if vmErr, ok := err.(*vm.Error); ok {
+		msg, line = vmErr.Message, vmErr.Pos.Line
+	}
+	if strings.Contains(msg, vm.ErrInterrupt.Error()) && ctx.Err() != nil {
+		msg += ": " + ctx.Err().Error()
+	}
+	if line > 0 {
+		return fmt.Errorf("%s:%d: %s", path, line, msg)
+	}
+	return fmt.Errorf("%s: %s", path, msg)
+}
+
+//Execute Runs src in e under the sandbox.  path names the script in any error that is returned, and panics are
+// recovered and returned as errors.
+func (s *ScriptSandbox) Execute(e *env.Env, path, src string) (ret interface{}, err error) {
+	ctx, cancel := s.context(context.Background(), true)
+	defer cancel()
+	defer func() {
+		if r := recover(); r != nil {
+			ret, err = nil, fmt.Errorf("%s: panic: %v", path, r)
+		}
+	}()
+	// The prelude is ran on its own, so that line numbers in errors match up with the script file.
+	if _, err = vm.ExecuteContext(ctx, e, &vm.Options{}, scriptPrelude); err != nil {
+		return nil, explain(ctx, path, err)
+	}
+	trackEffects(e)
+	ret, err = vm.ExecuteContext(ctx, e, &vm.Options{}, src)
+	return ret, explain(ctx, path, err)
+}
+
+//interfaceType The type of an empty interface, which the VM boxes its values in.
+var interfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+
+//isScriptFunc Returns true if t is the type the VM gives to functions defined in a script, which take a context and
+// then their arguments as boxed reflect.Values, and return their result and error the same way.
+func isScriptFunc(t reflect.Type) bool {
+	valueType := reflect.TypeOf(reflect.Value{})
+	return t.Kind() == reflect.Func && t.NumIn() >= 1 && t.In(0) == reflect.TypeOf((*context.Context)(nil)).Elem() &&
+		t.NumOut() == 2 && t.Out(0) == valueType && t.Out(1) == valueType
+}
+
+//Wrap Returns fn, a function handed over from script code, as a Go function of type typ that runs it under the sandbox.
+// An error or panic in fn is logged against origin, and makes the call return zero values instead of bringing down
+// the goroutine that made it.  Callbacks that are handed a player, or an event about a player, as their first argument
+// are interrupted when the coroutine that player is running gets cancelled.  If fn is nil, or can not be made into typ, a nil function of type
+// typ is returned.
+func (s *ScriptSandbox) Wrap(fn interface{}, typ reflect.Type, origin string) reflect.Value {
+	raw := reflect.ValueOf(fn)
+	if !raw.IsValid() || raw.Kind() != reflect.Func || raw.IsNil() {
+		return reflect.Zero(typ)
+	}
+	script := isScriptFunc(raw.Type())
+	if !script && !raw.Type().ConvertibleTo(typ) {
+		log.Warn(fmt.Sprintf("%s: can not use %v as %v", origin, raw.Type(), typ))
+		return reflect.Zero(typ)
+	}
+	if !script {
+		raw = raw.Convert(typ)
+	}
+	zero := func() []reflect.Value {
+		out := make([]reflect.Value, typ.NumOut())
+		for i := range out {
+			out[i] = reflect.Zero(typ.Out(i))
+		}
+		return out
+	}
+	return reflect.MakeFunc(typ, func(in []reflect.Value) (out []reflect.Value) {
+		out = zero()
+		parent := context.Background()
+		if p := callbackPlayer(in); p != nil {
+			parent = p.coroutineContext()
+		}
+		ctx, cancel := s.context(parent, false)
+		defer cancel()
+		defer func() {
+			if r := recover(); r != nil {
+				out = zero()
+				log.Warn(fmt.Sprintf("%s: panic in script callback: %v", origin, r))
+			}
+		}()
+		if !script {
+			return raw.Call(in)
+		}
+		args := []reflect.Value{reflect.ValueOf(context.Context(ctx))}
+		for i := 1; i < raw.Type().NumIn(); i++ {
+			// Scripts may leave off trailing parameters they have no use for, or declare more than they are given.
+			if i-1 < len(in) {
+				args = append(args, reflect.ValueOf(in[i-1]))
+			} else {
+				args = append(args, reflect.ValueOf(reflect.Zero(interfaceType)))
+			}
+		}
+		rvs := raw.Call(args)
+		if callErr := rvs[1].Interface().(reflect.Value); callErr.IsValid() && !callErr.IsNil() {
+			if parent.Err() == nil {
+				// Scripts interrupted by their coroutine being cancelled were stopped on purpose.
+				log.Warn(explain(ctx, origin, callErr.Interface().(error)))
+			}
+			return
+		}
+		if typ.NumOut() == 0 {
+			return
+		}
+		ret := rvs[0].Interface().(reflect.Value)
+		for ret.IsValid() && ret.Kind() == reflect.Interface && !ret.IsNil() {
+			ret = ret.Elem()
+		}
+		if ret.IsValid() && ret.Type().ConvertibleTo(typ.Out(0)) {
+			out[0] = ret.Convert(typ.Out(0))
+		} else if ret.IsValid() && !(ret.Kind() == reflect.Interface && ret.IsNil()) {
+			log.Warn(fmt.Sprintf("%s: script callback returned %v, wanted %v", origin, ret.Type(), typ.Out(0)))
+		}
+		return
+	})
+}
+
+//callbackPlayer Returns the player that the first of the arguments to a script callback is, or is an event about.
+func callbackPlayer(in []reflect.Value) *Player {
+	if len(in) == 0 || !in[0].IsValid() {
+		return nil
+	}
+	if p, ok := in[0].Interface().(*Player); ok {
+		return p
+	}
+	if _, ok := in[0].Interface().(Event); ok && in[0].Kind() == reflect.Ptr && !in[0].IsNil() {
+		if field := in[0].Elem().FieldByName("Player"); field.IsValid() && field.Type() == reflect.TypeOf((*Player)(nil)) {
+			return field.Interface().(*Player)
+		}
+	}
+	return nil
+}
+
+//sandboxed Converts fn, a function handed over from script code, into the Go function that ptr points to, wrapped to
+// run under the script sandbox and attributed to the script that is currently being loaded.
+func sandboxed(fn interface{}, ptr interface{}) {
+	target := reflect.ValueOf(ptr).Elem()
+	target.Set(Sandbox.Wrap(fn, target.Type(), scriptOrigin()))
+}
+
+//loadScript Runs the script file at path inside of e, under the sandbox.  Only files under ./scripts may be loaded.
+func loadScript(e *env.Env, path string) interface{} {
+	clean := filepath.Clean(path)
+	if filepath.IsAbs(clean) || !strings.HasPrefix(clean, "scripts"+string(filepath.Separator)) {
+		panic("can only load scripts from ./scripts, not " + path)
+	}
+	data, err := ioutil.ReadFile(clean)
+	if err != nil {
+		panic(err)
+	}
+	ret, err := Sandbox.Execute(e, clean, string(data))
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+func init() {
+	tasks.ScriptContext = func() (context.Context, context.CancelFunc) {
+		ctx, cancel := Sandbox.context(context.Background(), false)
+		return ctx, cancel
+	}
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+
+package world
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mattn/anko/env"
+
+	"github.com/spkaeros/rscgo/pkg/ids"
+)
+
+func TestSandboxInterruptsRunawayScript(t *testing.T) {
+	sandbox := &ScriptSandbox{Steps: 1000}
+	_, err := sandbox.Execute(ScriptEnv(), "loop.ank", "x = 0\nfor {\n\tx++\n}")
+	if err == nil || !strings.HasPrefix(err.Error(), "loop.ank:") || !strings.Contains(err.Error(), errBudgetSpent.Error()) {
+		t.Fatalf("runaway script was not interrupted with an attributed error: %v", err)
+	}
+}
+
+func TestSandboxAttributesErrors(t *testing.T) {
+	_, err := Sandbox.Execute(ScriptEnv(), "broken.ank", "a = 1\nb = 2\nundefinedFunction()")
+	if err == nil || !strings.HasPrefix(err.Error(), "broken.ank:3:") {
+		t.Fatalf("error was not attributed to the file and line: %v", err)
+	}
+}
+
+func TestSandboxedCallbacks(t *testing.T) {
+	sandbox := &ScriptSandbox{Steps: 1000}
+	e := ScriptEnv()
+	tests := []struct {
+		name, src string
+	}{
+		{"loop", "func(item) { for { } }"},
+		{"error", "func(item) { return undefinedFunction() }"},
+		{"panic", "func(item) { return item.Amount > 0 }"},
+	}
+	for _, test := range tests {
+		fn, err := sandbox.Execute(e, test.name+".ank", test.src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var check func(*Item) bool
+		reflect.ValueOf(&check).Elem().Set(sandbox.Wrap(fn, reflect.TypeOf(check), test.name+".ank"))
+		if check(nil) {
+			t.Errorf("%s: failed callback returned true", test.name)
+		}
+	}
+
+	var panics func(*Item) bool
+	reflect.ValueOf(&panics).Elem().Set(sandbox.Wrap(func(*Item) bool {
+		panic("boom")
+	}, reflect.TypeOf(panics), "go"))
+	if panics(nil) {
+		t.Error("panicking callback returned true")
+	}
+
+	fn, err := sandbox.Execute(e, "check.ank", "func(item) { return item.ID == 10 }")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var check func(*Item) bool
+	reflect.ValueOf(&check).Elem().Set(sandbox.Wrap(fn, reflect.TypeOf(check), "check.ank"))
+	if !check(&Item{ID: 10}) || check(&Item{ID: 11}) {
+		t.Error("sandboxed callback returned the wrong result")
+	}
+}
+
+func TestScriptImportAllowlist(t *testing.T) {
+	values, types := env.Packages, env.PackageTypes
+	defer func() {
+		env.Packages, env.PackageTypes = values, types
+	}()
+	SetScriptImports([]string{"bind", "world", "log", "ids", "strings"})
+	if _, err := Sandbox.Execute(ScriptEnv(), "os.ank", `os = import("os")`); err == nil {
+		t.Error("script imported a package missing from the allowlist")
+	}
+	if _, err := Sandbox.Execute(ScriptEnv(), "strings.ank", `strings = import("strings")`); err != nil {
+		t.Error("script could not import an allowed package:", err)
+	}
+}
+
+func TestScriptIds(t *testing.T) {
+	v, err := Sandbox.Execute(ScriptEnv(), "ids.ank", `ids = import("ids")
+return [ids.RAW_COD, ids.ADAM_BAR, ids.npcs.MAN, ids.objects.TREE]`)
+	if err != nil {
+		t.Fatal("could not read ids:", err)
+	}
+	want := []interface{}{ids.RAW_COD, ids.ADAMANTITE_BAR, ids.NPC_MAN, ids.OBJECT_TREE}
+	if !reflect.DeepEqual(v, want) {
+		t.Errorf("ids = %v, want %v", v, want)
+	}
+}
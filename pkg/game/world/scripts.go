@@ -10,20 +10,28 @@
 package world
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
-	"github.com/mattn/anko/vm"
+	"github.com/mattn/anko/env"
 
 	"github.com/spkaeros/rscgo/pkg/log"
+	"github.com/spkaeros/rscgo/pkg/tasks"
 )
 
 var scriptWatcher *fsnotify.Watcher
 
+//Sleep Pauses the calling goroutine for d.  Scripts and NPC dialogue wait through this rather than time.Sleep, so that
+// tests can run them without waiting around.
+var Sleep = time.Sleep
+
 //ItemTrigger A type that defines a callback to run when certain item actions are performed, and a predicate to decide
 // whether or not the callback should run
 type ItemTrigger struct {
@@ -68,128 +76,496 @@ type NpcActionPredicate = func(*Player, *NPC) bool
 //NpcAction A type alias for an NPC related action.
 type NpcAction = func(*Player, *NPC)
 
-//LoginTriggers a list of actions to run when a player logs in.
-var LoginTriggers []func(player *Player)
+//var Triggers []Trigger
 
-//InvOnBoundaryTriggers a list of actions to run when a player uses an inventory item on a boundary object
-var InvOnBoundaryTriggers []func(player *Player, object *Object, item *Item) bool
+//liveTriggers Holds the *publishedTriggers of the live script set.  Packet handlers look triggers up from their own
+// goroutines while the engine swaps script sets, so a swap replaces the whole lot in one atomic store.
+var liveTriggers atomic.Value
 
-//InvOnObjectTriggers a list of actions to run when a player uses an inventory item on a object
-var InvOnObjectTriggers []func(player *Player, object *Object, item *Item) bool
+//publishedTriggers The spell and command triggers of a published script set.  Never modified once it is stored.
+type publishedTriggers struct {
+	spells   map[int]Trigger
+	commands map[string]func(*Player, []string)
+}
 
-//ItemTriggers List of script callbacks to run for inventory item actions
-var ItemTriggers []ItemTrigger
+//SpellTrigger Returns the trigger that the live scripts bound to the spell with the given index, if there is one.
+func SpellTrigger(idx int) (Trigger, bool) {
+	if live, ok := liveTriggers.Load().(*publishedTriggers); ok {
+		fn, ok := live.spells[idx]
+		return fn, ok && fn != nil
+	}
+	return nil, false
+}
 
-//InvOnPlayerTriggers a list of actions to run when a player uses an inventory item on another player object
-var InvOnPlayerTriggers []ItemOnPlayerTrigger
+//CommandHandler Returns the handler for the command with the given name, either bound by the live scripts or built in.
+func CommandHandler(name string) (func(*Player, []string), bool) {
+	if live, ok := liveTriggers.Load().(*publishedTriggers); ok {
+		fn, ok := live.commands[name]
+		return fn, ok && fn != nil
+	}
+	fn, ok := CommandHandlers[name]
+	return fn, ok && fn != nil
+}
 
-//ObjectTriggers List of script callbacks to run for object actions
-var ObjectTriggers []ObjectTrigger
+type SpellDef map[string]interface{}
 
-//BoundaryTriggers List of script callbacks to run for boundary actions
-var BoundaryTriggers []ObjectTrigger
+//ScriptSet A complete registry of everything that the scripts have bound into the world.  A reload builds a whole new
+// set off to the side, and it only replaces the live set once every script in it has ran and it has validated.
+type ScriptSet struct {
+	// Version counts up by one for every set that gets built, so that any set can be told apart from the others.
+	Version int
+	// Events holds every event handler that the scripts have bound.
+	Events   *EventBus
+	Spells   map[int]Trigger
+	Commands map[string]func(*Player, []string)
+	Quests   []*Quest
+	// Schedules holds the jobs that the scripts want ran at calendar times, by name.
+	Schedules map[string]*tasks.CronJob
+	// conflicts describes the triggers in this set that get in one another's way, other than those on its events.
+	conflicts []string
+	// effects holds what the scripts did to the world from their top level while this set was being built.
+	effects []scriptEffect
+	// withdrawn is true while the effects of this set are undone.
+	withdrawn bool
+}
 
-//NpcTriggers List of script callbacks to run for NPC talking actions
-var NpcTriggers []NpcTrigger
+//scriptEffect Something that a script did to the world while its set was being built, e.g spawning an NPC, and how to
+// take it back and put it back again.  redo is nil for effects that can not be put back, such as timers.
+type scriptEffect struct {
+	undo, redo func()
+}
 
-//var Triggers []Trigger
+//NewScriptSet Returns a new, empty script set.
+func NewScriptSet() *ScriptSet {
+	return &ScriptSet{Events: NewEventBus(), Spells: make(map[int]Trigger), Commands: make(map[string]func(*Player, []string)),
+		Schedules: make(map[string]*tasks.CronJob)}
+}
 
-var SpellTriggers = make(map[int]Trigger)
+//String Returns a summary of how many of each kind of trigger this set holds.
+func (s *ScriptSet) String() string {
+	return fmt.Sprintf("Bind[%v, %d spell, %d command, %d quest, %d schedule]", s.Events, len(s.Spells), len(s.Commands), len(s.Quests), len(s.Schedules))
+}
 
-type SpellDef map[string]interface{}
+//conflict Records a conflict between triggers bound into this set.
+func (s *ScriptSet) conflict(format string, args ...interface{}) {
+	s.conflicts = append(s.conflicts, fmt.Sprintf(format, args...))
+}
 
-//NpcAtkTriggers List of script callbacks to run when you attack an NPC
-var NpcAtkTriggers []NpcBlockingTrigger
+//Conflicts Describes every trigger in this set that is shadowed by another one handling the same thing, e.g two
+// object triggers for the same object ID, or two commands of the same name.
+func (s *ScriptSet) Conflicts() []string {
+	return append(append([]string(nil), s.conflicts...), s.Events.Conflicts()...)
+}
 
-//Clear clears all of the lists of triggers.
-func Clear() {
-	ItemTriggers = ItemTriggers[:0]
-	ObjectTriggers = ObjectTriggers[:0]
-	NpcTriggers = NpcTriggers[:0]
-	NpcAtkTriggers = NpcAtkTriggers[:0]
-	NpcDeathTriggers = NpcDeathTriggers[:0]
-	BoundaryTriggers = BoundaryTriggers[:0]
-	LoginTriggers = LoginTriggers[:0]
-	InvOnBoundaryTriggers = InvOnBoundaryTriggers[:0]
-	InvOnObjectTriggers = InvOnObjectTriggers[:0]
+//Validate Returns an error describing the first problem found with this set that would break the world if it went
+// live, e.g a callback that was bound as nil, or two quests sharing an ID.
+func (s *ScriptSet) Validate() error {
+	if err := s.Events.Validate(); err != nil {
+		return err
+	}
+	for id, fn := range s.Spells {
+		if fn == nil {
+			return fmt.Errorf("spell trigger %d is nil", id)
+		}
+	}
+	for name, fn := range s.Commands {
+		if fn == nil {
+			return fmt.Errorf("command '%s' is nil", name)
+		}
+	}
+	ids := make(map[int]string)
+	for _, q := range s.Quests {
+		if q.ID < 0 || q.ID >= QuestCount {
+			return fmt.Errorf("quest '%s' has out of bounds ID %d", q.Name, q.ID)
+		}
+		if name, ok := ids[q.ID]; ok {
+			return fmt.Errorf("quests '%s' and '%s' share ID %d", name, q.Name, q.ID)
+		}
+		ids[q.ID] = q.Name
+	}
+	return nil
 }
 
-//RunScripts Loads all of the scripts in ./scripts.  This will ignore any folders named definitions or lib.
-func RunScripts() {
-	var err error
-	if scriptWatcher == nil {
-		scriptWatcher, err = fsnotify.NewWatcher()
-		if err != nil {
-			log.Info.Println(err)
-			return
+//publish Points every global trigger list, and the live event bus, at the contents of this set.  The maps are copied, so that binding more
+// into a live set later on never mutates a map that somebody else may be reading.
+func (s *ScriptSet) publish() {
+	commands := make(map[string]func(*Player, []string), len(CommandHandlers)+len(s.Commands))
+	for name, fn := range CommandHandlers {
+		commands[name] = fn
+	}
+	for name, fn := range s.Commands {
+		commands[name] = fn
+	}
+	spells := make(map[int]Trigger, len(s.Spells))
+	for id, fn := range s.Spells {
+		spells[id] = fn
+	}
+	liveEvents.Lock()
+	liveEvents.bus = s.Events
+	liveEvents.Unlock()
+	liveTriggers.Store(&publishedTriggers{spells: spells, commands: commands})
+	Quests.replace(s.Quests)
+	jobs := make([]*tasks.CronJob, 0, len(s.Schedules))
+	for _, job := range s.Schedules {
+		jobs = append(jobs, job)
+	}
+	tasks.Crontab.SwapScripted(jobs)
+}
+
+//effect Records an effect that the scripts in this set just had on the world, if the set is still being built.  The
+// triggers of a set can run world functions long after it was built; what they do belongs to the game, not the set.
+func (s *ScriptSet) effect(undo, redo func()) {
+	scripts.Lock()
+	defer scripts.Unlock()
+	if scripts.loading == s {
+		s.effects = append(s.effects, scriptEffect{undo, redo})
+	}
+}
+
+//withdraw Undoes the effects of this set, newest first.  The caller must hold the scripts lock.
+func (s *ScriptSet) withdraw() {
+	if s == nil || s.withdrawn {
+		return
+	}
+	s.withdrawn = true
+	for i := len(s.effects) - 1; i >= 0; i-- {
+		s.effects[i].undo()
+	}
+}
+
+//restore Puts back the effects of this set, if they were withdrawn.  The caller must hold the scripts lock.
+func (s *ScriptSet) restore() {
+	if !s.withdrawn {
+		return
+	}
+	s.withdrawn = false
+	for _, effect := range s.effects {
+		if effect.redo != nil {
+			effect.redo()
 		}
-		go func() {
-			lastEvent := time.Now()
-			lastPath := ""
-			for {
-				select {
-				case event := <-scriptWatcher.Events:
-					if time.Since(lastEvent) < time.Second && lastPath == event.Name {
-						continue
-					}
-					if event.Op&fsnotify.Write == fsnotify.Write {
-						lastEvent = time.Now()
-						lastPath = event.Name
-						log.Info.Println("Reloading " + event.Name)
-						_, err := vm.Execute(ScriptEnv(), &vm.Options{Debug: true}, load(event.Name))
-
-						if err != nil {
-							log.Info.Println("Anko error ['"+event.Name+"']:", err)
-							continue
-						}
-					}
-				case err := <-scriptWatcher.Errors:
-					if err != nil {
-						log.Info.Println(err)
-					}
+	}
+}
+
+//Unload Undoes what the scripts in this set did to the world from their top level, e.g the NPCs, objects and ground
+// items that they spawned.  Installed sets are unloaded when they are replaced; sets from LoadScripts never are, so
+// whoever loaded one should unload it once they are done with it.
+func (s *ScriptSet) Unload() {
+	scripts.Lock()
+	defer scripts.Unlock()
+	s.withdraw()
+}
+
+//trackEffects Replaces the world functions that e imported, if e is running a script file for the set being built,
+// with ones that record their effects against that set so that they are undone along with it.
+func trackEffects(e *env.Env) {
+	scripts.Lock()
+	set := scripts.loading
+	if set == nil || scripts.env != e {
+		scripts.Unlock()
+		return
+	}
+	scripts.Unlock()
+	if value, err := e.Get("world"); err == nil {
+		if pack, ok := value.(*env.Env); ok {
+			pack.Define("addNpc", func(n *NPC) {
+				AddNpc(n)
+				set.effect(func() {
+					RemoveNpc(n)
+					Npcs.Remove(n)
+				}, func() {
+					Npcs.Add(n)
+					AddNpc(n)
+				})
+			})
+			pack.Define("addObject", func(o *Object) {
+				AddObject(o)
+				set.effect(func() {
+					RemoveObject(o)
+				}, func() {
+					AddObject(o)
+				})
+			})
+			pack.Define("addItem", func(i *GroundItem) *GroundItem {
+				spawned := AddItem(i)
+				if spawned == i {
+					// items merged into a stack that was already there are not ours to take back
+					set.effect(func() {
+						GroundItems.Withdraw(i)
+					}, func() {
+						AddItem(i)
+					})
 				}
-			}
-		}()
+				return spawned
+			})
+		}
 	}
+	e.Define("runAfter", func(d time.Duration, fn interface{}) *time.Timer {
+		var action func()
+		sandboxed(fn, &action)
+		if action == nil {
+			return nil
+		}
+		timer := time.AfterFunc(d, action)
+		set.effect(func() {
+			timer.Stop()
+		}, nil)
+		return timer
+	})
+}
 
+//scripts Tracks the live script set, the one it replaced, and the one waiting to replace it on the next tick.
+var scripts = struct {
+	live, previous, pending *ScriptSet
+	// loading is the set that bind calls register into while a build is running.
+	loading *ScriptSet
+	// origin is the path of the script file being ran by the current build, and env is the environment running it.
+	origin  string
+	env     *env.Env
+	version int
+	sync.Mutex
+}{live: NewScriptSet()}
+
+//building Serializes script builds, as the bind package can only register into one set at a time.
+var building sync.Mutex
+
+//binding Calls fn with the set that bind calls should register into right now: the set being built if there is one,
+// otherwise the live set, which is then queued to be republished on the next tick.
+func binding(fn func(*ScriptSet)) {
+	scripts.Lock()
+	defer scripts.Unlock()
+	if scripts.loading != nil {
+		fn(scripts.loading)
+		return
+	}
+	fn(scripts.live)
+	if scripts.pending == nil {
+		scripts.pending = scripts.live
+	}
+}
+
+//scriptOrigin Returns the path of the script file that is being loaded right now, for attributing errors to.  Code
+// bound from outside of a build, e.g by the run command, is attributed to the live scripts.
+func scriptOrigin() string {
+	scripts.Lock()
+	defer scripts.Unlock()
+	if scripts.loading == nil {
+		return "live scripts"
+	}
+	return scripts.origin
+}
+
+//scriptPrelude Imports the packages every script expects to have on hand.
+const scriptPrelude = "bind = import(\"bind\")\nworld = import(\"world\")\nlog = import(\"log\")\nids = import(\"ids\")\n\n"
+
+//scriptFile The path and source code of a single script.
+type scriptFile struct {
+	path, source string
+}
+
+//readScripts Reads in all of the scripts in ./scripts.  This will ignore any folders named lib.  Fails if any script
+// can not be read, so that a reload never goes ahead without one of them.
+func readScripts() (files []scriptFile, err error) {
 	err = filepath.Walk("./scripts", func(path string, info os.FileInfo, err error) error {
-		if !info.Mode().IsDir() && strings.HasSuffix(path, "ank") && !strings.Contains(path, "defyyyyyyyt6") && !strings.Contains(path, "lib") {
-
-			_, err := vm.Execute(ScriptEnv(), &vm.Options{Debug: true}, "bind = import(\"bind\")\nworld = import(\"world\")\nlog = import(\"log\")\nids = import(\"ids\")\n\n"+load(path))
-			//stmt, err := parser.ParseSrc(load(path))
-			//if err != nil {
-			//	log.Warning.Printf("ParseSrc error - received: %v - script: %v", err, path)
-			//}
-			//// Note: Still want to run the code even after a parse error to see what happens
-			//_, err = vm.Run(ScriptEnv(), &vm.Options{Debug: true}, stmt)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsDir() && strings.HasSuffix(path, "ank") && !strings.Contains(path, "lib") {
+			data, err := ioutil.ReadFile(path)
 			if err != nil {
-				log.Warn("Anko error ['"+path+"']:", err)
-				//				log.Info.Println(env.String())
-				return nil
+				return err
 			}
-			//log.Info.Println(val)
-			return scriptWatcher.Add(path)
+			files = append(files, scriptFile{path, string(data)})
 		}
-
 		return nil
 	})
-	if err != nil {
-		log.Info.Println(err)
+	return
+}
+
+//buildScripts Runs every script in files against a brand new script set, and returns it.  Every script runs even after
+// one has failed, so that all of the failures get logged; the returned error describes the first of them, or the
+// first validation problem if every script ran cleanly.
+func buildScripts(files []scriptFile) (*ScriptSet, error) {
+	building.Lock()
+	defer building.Unlock()
+	set := NewScriptSet()
+	scripts.Lock()
+	scripts.version++
+	set.Version = scripts.version
+	scripts.loading = set
+	scripts.Unlock()
+	defer func() {
+		scripts.Lock()
+		scripts.loading, scripts.env = nil, nil
+		scripts.Unlock()
+	}()
+
+	var first error
+	failed := 0
+	for _, file := range files {
+		e := ScriptEnv()
+		scripts.Lock()
+		scripts.origin, scripts.env = file.path, e
+		scripts.Unlock()
+		_, err := Sandbox.Execute(e, file.path, file.source)
+		if err != nil {
+			log.Warn("Anko error:", err)
+			if first == nil {
+				first = err
+			}
+			failed++
+		}
+	}
+	for _, conflict := range set.Conflicts() {
+		log.Warn("Conflicting triggers:", conflict)
+	}
+	if first != nil {
+		return set, fmt.Errorf("%d of %d scripts failed, first was %v", failed, len(files), first)
+	}
+	if err := set.Validate(); err != nil {
+		return set, fmt.Errorf("scripts version %d is invalid: %v", set.Version, err)
+	}
+	return set, nil
+}
+
+//queueScripts Marks set to replace the live script set on the next tick, replacing anything already waiting.  A set
+// that was waiting and is not live never will be, so its effects are undone.
+func queueScripts(set *ScriptSet) {
+	scripts.Lock()
+	defer scripts.Unlock()
+	if scripts.pending != nil && scripts.pending != set && scripts.pending != scripts.live {
+		scripts.pending.withdraw()
+	}
+	scripts.pending = set
+}
+
+//SwapScripts Replaces the live script set with the one that is waiting to go live, if there is one.  The game engine
+// calls this between ticks, so that nothing in the engine ever sees the triggers half-replaced.
+func SwapScripts() {
+	scripts.Lock()
+	defer scripts.Unlock()
+	if scripts.pending == nil {
 		return
 	}
+	if scripts.pending != scripts.live {
+		scripts.live.withdraw()
+		scripts.pending.restore()
+		scripts.previous, scripts.live = scripts.live, scripts.pending
+		log.Info.Printf("Installed scripts version %d: %v\n", scripts.live.Version, scripts.live)
+	}
+	scripts.pending = nil
+	scripts.live.publish()
+}
+
+//LiveScripts Returns the script set that is currently live.
+func LiveScripts() *ScriptSet {
+	scripts.Lock()
+	defer scripts.Unlock()
+	return scripts.live
+}
+
+//reloadScripts Builds a new script set from files and queues it to go live on the next tick.  If any script fails or
+// the set does not validate, nothing is queued and the live set stays in place.
+func reloadScripts(files []scriptFile) (*ScriptSet, error) {
+	set, err := buildScripts(files)
+	if err != nil {
+		set.Unload()
+		return nil, err
+	}
+	queueScripts(set)
+	return set, nil
+}
+
+//LoadScripts Builds a new script set from the script files at paths, and returns it without installing it.  This is
+// how tests get at the triggers of a handful of scripts without touching the live set.
+func LoadScripts(paths ...string) (*ScriptSet, error) {
+	files := make([]scriptFile, 0, len(paths))
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, scriptFile{path, string(data)})
+	}
+	return buildScripts(files)
+}
+
+//ReloadScripts Builds a new script set from all of the scripts in ./scripts, and queues it to replace the live set on
+// the next tick.  If any script fails, the live set is left in place and the error is returned.
+func ReloadScripts() (*ScriptSet, error) {
+	files, err := readScripts()
+	if err != nil {
+		return nil, err
+	}
+	return reloadScripts(files)
 }
 
-func load(filePath string) string {
-	file, err := os.Open(filePath)
+//RollbackScripts Queues the script set that was live before the current one to go live again on the next tick.
+func RollbackScripts() (*ScriptSet, error) {
+	scripts.Lock()
+	defer scripts.Unlock()
+	if scripts.previous == nil {
+		return nil, fmt.Errorf("there is no previous scripts version to roll back to")
+	}
+	if scripts.pending != nil && scripts.pending != scripts.previous && scripts.pending != scripts.live {
+		scripts.pending.withdraw()
+	}
+	scripts.pending = scripts.previous
+	return scripts.previous, nil
+}
+
+//RunScripts Loads all of the scripts in ./scripts and installs them straight away, then starts watching them for
+// changes.  This is meant to be called once, before the game engine starts; there is no older set to fall back on at
+// that point, so any scripts that failed are logged and the server starts with the rest.
+func RunScripts() {
+	files, err := readScripts()
 	if err != nil {
-		log.Warn("Error opening script file:", err)
-		return ""
+		log.Info.Println(err)
+		return
 	}
-	data, err := ioutil.ReadAll(file)
+	set, err := buildScripts(files)
 	if err != nil {
-		log.Warn("Error reading script file:", err)
-		return ""
+		log.Warn("Starting without some scripts:", err)
 	}
+	queueScripts(set)
+	SwapScripts()
+	watchScripts(files)
+}
 
-	return string(data)
+//watchScripts Watches files for changes, and reloads every script whenever one of them is written to, going through
+// the same build-validate-swap path as the reload command.
+func watchScripts(files []scriptFile) {
+	if scriptWatcher != nil {
+		return
+	}
+	var err error
+	scriptWatcher, err = fsnotify.NewWatcher()
+	if err != nil {
+		log.Info.Println(err)
+		return
+	}
+	for _, file := range files {
+		if err := scriptWatcher.Add(file.path); err != nil {
+			log.Info.Println(err)
+		}
+	}
+	go func() {
+		lastEvent := time.Time{}
+		for {
+			select {
+			case event := <-scriptWatcher.Events:
+				if event.Op&fsnotify.Write != fsnotify.Write || time.Since(lastEvent) < time.Second {
+					continue
+				}
+				lastEvent = time.Now()
+				log.Info.Println("Reloading scripts, " + event.Name + " changed")
+				if _, err := ReloadScripts(); err != nil {
+					log.Warn("Kept the live scripts in place:", err)
+				}
+			case err := <-scriptWatcher.Errors:
+				if err != nil {
+					log.Info.Println(err)
+				}
+			}
+		}
+	}()
 }
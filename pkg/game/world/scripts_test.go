@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+
+package world
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spkaeros/rscgo/pkg/tasks"
+)
+
+//installScripts Makes set the live script set straight away, as though a tick had passed since it was queued.
+func installScripts(set *ScriptSet) {
+	queueScripts(set)
+	SwapScripts()
+}
+
+//liveHandlers Returns how many handlers the live scripts have subscribed to the event with the given name.
+func liveHandlers(name string) int {
+	liveEvents.RLock()
+	defer liveEvents.RUnlock()
+	return liveEvents.bus.Len(name)
+}
+
+//liveSpell Returns true if the live scripts have a trigger for the spell with the given index.
+func liveSpell(idx int) bool {
+	_, ok := SpellTrigger(idx)
+	return ok
+}
+
+//liveCommand Returns true if there is a handler for the command with the given name.
+func liveCommand(name string) bool {
+	_, ok := CommandHandler(name)
+	return ok
+}
+
+func TestReloadSwapsWholeSet(t *testing.T) {
+	defer installScripts(NewScriptSet())
+	good := []scriptFile{
+		{"item.ank", `bind.item(func(item) { return true }, func(player, item) {})`},
+		{"spell.ank", `bind.spell(1, func(player, spell) {})`},
+		{"command.ank", `bind.command("hello", func(player, args) {})`},
+		{"schedule.ank", `bind.schedule("hourly", "@hourly", func() {})`},
+	}
+	first, err := reloadScripts(good)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if liveHandlers("itemAction") != 0 {
+		t.Fatal("reloaded scripts went live before the swap")
+	}
+	SwapScripts()
+	if LiveScripts() != first || liveHandlers("itemAction") != 1 || !liveSpell(1) || !liveCommand("hello") || len(tasks.Crontab.Jobs()) != 1 {
+		t.Fatalf("swap did not install the new set: %v", LiveScripts())
+	}
+
+	second, err := reloadScripts(good[:1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	SwapScripts()
+	if LiveScripts() != second || second.Version <= first.Version {
+		t.Fatalf("second reload was not installed as a newer version")
+	}
+	if liveHandlers("itemAction") != 1 || liveSpell(1) || liveCommand("hello") || len(tasks.Crontab.Jobs()) != 0 {
+		t.Fatalf("triggers from the old set survived the reload: %v", second)
+	}
+
+	rolledBack, err := RollbackScripts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	SwapScripts()
+	if rolledBack != first || LiveScripts() != first || !liveCommand("hello") {
+		t.Fatal("rollback did not reinstall the previous set")
+	}
+}
+
+func TestFailedReloadKeepsLiveSet(t *testing.T) {
+	defer installScripts(NewScriptSet())
+	live, err := reloadScripts([]scriptFile{{"item.ank", `bind.item(func(item) { return true }, func(player, item) {})`}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	SwapScripts()
+
+	broken := []scriptFile{
+		{"npc.ank", `bind.npc(func(npc) { return true }, func(player, npc) {})`},
+		{"broken.ank", `bind.object(func(object, click) { return true }, func(player, object, click) {})
+undefinedFunction()`},
+	}
+	if _, err := reloadScripts(broken); err == nil {
+		t.Fatal("reload with a failing script succeeded")
+	}
+	SwapScripts()
+	if LiveScripts() != live || liveHandlers("itemAction") != 1 || liveHandlers("talkToNpc") != 0 || liveHandlers("objectAction") != 0 {
+		t.Fatal("failed reload replaced the live triggers")
+	}
+
+	if _, err := reloadScripts([]scriptFile{{"nil.ank", `bind.onLogin(nil)`}}); err == nil {
+		t.Fatal("reload binding a nil trigger validated")
+	}
+	if _, err := reloadScripts([]scriptFile{{"quests.ank", `bind.quest(1, "One", 1, func(player) {})
+bind.quest(1, "Other", 1, func(player) {})`}}); err == nil {
+		t.Fatal("reload defining two quests with the same ID validated")
+	}
+	SwapScripts()
+	if LiveScripts() != live {
+		t.Fatal("invalid reload replaced the live triggers")
+	}
+}
+
+func TestReloadUnreadableScript(t *testing.T) {
+	defer installScripts(NewScriptSet())
+	live, err := reloadScripts([]scriptFile{{"item.ank", `bind.item(func(item) { return true }, func(player, item) {})`}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	SwapScripts()
+
+	dir, err := ioutil.TempDir("", "scripts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir("scripts", 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join("scripts", "npc.ank"), []byte(`bind.npc(func(npc) { return true }, func(player, npc) {})`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// a link to nowhere is listed like any other script, but can never be read
+	if err := os.Symlink(filepath.Join(dir, "missing"), filepath.Join("scripts", "item.ank")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReloadScripts(); err == nil {
+		t.Fatal("reload went ahead without a script it could not read")
+	}
+	SwapScripts()
+	if LiveScripts() != live || liveHandlers("itemAction") != 1 || liveHandlers("talkToNpc") != 0 {
+		t.Fatal("reload that could not read a script replaced the live triggers")
+	}
+}
+
+//spawnedNpcs Returns how many NPCs with the given ID are in the game, and how many of those are in the region of x,y.
+func spawnedNpcs(id, x, y int) (spawned, placed int) {
+	Npcs.RangeNpcs(func(n *NPC) bool {
+		if n.ID == id {
+			spawned++
+			if Region(x, y).NPCs.Contains(n) {
+				placed++
+			}
+		}
+		return false
+	})
+	return
+}
+
+func TestReloadUndoesTopLevelEffects(t *testing.T) {
+	defer installScripts(NewScriptSet())
+	spawn := []scriptFile{{"spawn.ank", `world.addNpc(newNpc(4000, 120, 650, 115, 125, 645, 655))
+bind.command("spawned", func(player, args) {
+	world.addNpc(newNpc(4000, 121, 650, 115, 125, 645, 655))
+})`}}
+	if _, err := reloadScripts(spawn); err != nil {
+		t.Fatal(err)
+	}
+	SwapScripts()
+	if spawned, placed := spawnedNpcs(4000, 120, 650); spawned != 1 || placed != 1 {
+		t.Fatalf("top level spawn left %d NPCs, %d in place, want 1", spawned, placed)
+	}
+	command, _ := CommandHandler("spawned")
+	command(nil, nil)
+
+	if _, err := reloadScripts(spawn); err != nil {
+		t.Fatal(err)
+	}
+	SwapScripts()
+	if spawned, placed := spawnedNpcs(4000, 120, 650); spawned != 2 || placed != 2 {
+		t.Fatalf("reload left %d NPCs, %d in place, want the new spawn and the one from the command", spawned, placed)
+	}
+
+	if _, err := reloadScripts([]scriptFile{{"empty.ank", ``}}); err != nil {
+		t.Fatal(err)
+	}
+	SwapScripts()
+	if spawned, placed := spawnedNpcs(4000, 120, 650); spawned != 1 || placed != 1 {
+		t.Fatalf("reload without the spawn left %d NPCs, %d in place, want only the one from the command", spawned, placed)
+	}
+	if _, err := RollbackScripts(); err != nil {
+		t.Fatal(err)
+	}
+	SwapScripts()
+	if spawned, placed := spawnedNpcs(4000, 120, 650); spawned != 2 || placed != 2 {
+		t.Fatalf("rollback left %d NPCs, %d in place, want its spawn back", spawned, placed)
+	}
+	if _, err := reloadScripts([]scriptFile{{"broken.ank", `world.addNpc(newNpc(4000, 120, 650, 115, 125, 645, 655))
+undefinedFunction()`}}); err == nil {
+		t.Fatal("reload with a failing script succeeded")
+	}
+	if spawned, _ := spawnedNpcs(4000, 120, 650); spawned != 2 {
+		t.Fatalf("failed reload left %d NPCs, want its spawn undone", spawned)
+	}
+	LiveScripts().Unload()
+	if spawned, _ := spawnedNpcs(4000, 120, 650); spawned != 1 {
+		t.Fatalf("unloading left %d NPCs, want only the one from the command", spawned)
+	}
+	var leftover []*NPC
+	Npcs.RangeNpcs(func(n *NPC) bool {
+		if n.ID == 4000 {
+			leftover = append(leftover, n)
+		}
+		return false
+	})
+	for _, n := range leftover {
+		RemoveNpc(n)
+		Npcs.Remove(n)
+	}
+}
@@ -0,0 +1,363 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+//Package scripttest provides a harness for testing the Anko scripts in ./scripts with go test.  Scripts are loaded
+// into a script set of their own, and their triggers are ran against fake players that record everything the server
+// sends to them, so that tests can check the messages, option menus, inventories and stats that the scripts touch.
+// Whatever a harness adds to the game world, e.g NPCs, shops and persistent variables, is taken back out when its
+// test finishes, so that no test sees what the ones before it left behind.
+package scripttest
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/spkaeros/rscgo/pkg/definitions"
+	"github.com/spkaeros/rscgo/pkg/game/entity"
+	"github.com/spkaeros/rscgo/pkg/game/net"
+	"github.com/spkaeros/rscgo/pkg/game/world"
+	"github.com/spkaeros/rscgo/pkg/strutil"
+	"github.com/spkaeros/rscgo/pkg/tasks"
+)
+
+//Harness Runs the triggers from a selection of scripts against a fake world.
+type Harness struct {
+	T testing.TB
+	// Scripts holds every trigger that the selected scripts bound.
+	Scripts *world.ScriptSet
+}
+
+//databaseIndexes Counts out a database index for every fake player, so that no two of them share player variables.
+var databaseIndexes = atomic.NewInt32(0)
+
+//rootOnce Makes sure the working directory is only moved to the repository root once.
+var rootOnce sync.Once
+
+//chdirRoot Moves the working directory up to the repository root, which is where the scripts expect to be ran from.
+func chdirRoot(t testing.TB) {
+	rootOnce.Do(func() {
+		dir, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for {
+			if info, err := os.Stat(filepath.Join(dir, "scripts")); err == nil && info.IsDir() {
+				if err := os.Chdir(dir); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			parent := filepath.Dir(dir)
+			if parent == dir {
+				t.Fatal("could not find the scripts directory above the working directory")
+			}
+			dir = parent
+		}
+	})
+}
+
+//New Loads the scripts at paths, relative to the repository root, into a script set of their own and returns a harness
+// that runs their triggers.  Triggers run as coroutines on their player, with the engine's tick driven for them as fast
+// as they park, and anything that still sleeps on the clock does not sleep at all, so that dialogues finish straight
+// away.
+func New(t testing.TB, paths ...string) *Harness {
+	t.Helper()
+	chdirRoot(t)
+	sleep := world.Sleep
+	world.Sleep = func(time.Duration) {}
+	t.Cleanup(func() {
+		world.Sleep = sleep
+	})
+	shops := make(map[string]bool)
+	for _, name := range world.Shops.Names() {
+		shops[name] = true
+	}
+	worldVars := world.WorldVars().Names()
+	saved := make(map[string]interface{}, len(worldVars))
+	for _, name := range worldVars {
+		saved[name] = world.WorldVars().Get(name, nil)
+	}
+	t.Cleanup(func() {
+		for _, name := range world.Shops.Names() {
+			if !shops[name] {
+				world.Shops.Remove(name)
+			}
+		}
+		for _, name := range world.WorldVars().Names() {
+			if value, ok := saved[name]; ok {
+				world.WorldVars().Set(name, value)
+			} else {
+				world.WorldVars().Unset(name)
+			}
+		}
+	})
+	set, err := world.LoadScripts(paths...)
+	t.Cleanup(set.Unload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Harness{T: t, Scripts: set}
+}
+
+//DefineItem Defines the item with the given ID for the rest of the test, so that scripts can look up its name and
+// whether it stacks.
+func (h *Harness) DefineItem(id int, def definitions.ItemDefinition) {
+	items := definitions.Items
+	if id >= len(items) {
+		grown := make([]definitions.ItemDefinition, id+1)
+		copy(grown, items)
+		definitions.Items = grown
+	} else {
+		definitions.Items = append([]definitions.ItemDefinition(nil), items...)
+	}
+	definitions.Items[id] = def
+	h.T.Cleanup(func() {
+		definitions.Items = items
+	})
+}
+
+//NewPlayer Returns a new connected player named name, standing in Lumbridge, whose outgoing packets are recorded.
+func (h *Harness) NewPlayer(name string) *Player {
+	p := &Player{Player: world.NewPlayer(nil), h: h}
+	p.Writer = &Recorder{player: p}
+	p.SetVar("username", strutil.Base37.Encode(name))
+	// fake players are counted down from -1, so that they can never share variables with a real one
+	p.DatabaseIndex = int(databaseIndexes.Dec())
+	h.T.Cleanup(func() {
+		world.UnloadVars(p.Player)
+	})
+	p.SetConnected(true)
+	for i := 0; i < 18; i++ {
+		p.Skills().SetCur(i, 1)
+		p.Skills().SetMax(i, 1)
+	}
+	p.Skills().SetCur(entity.StatHits, 10)
+	p.Skills().SetMax(entity.StatHits, 10)
+	return p
+}
+
+//NewNpc Returns a new NPC of the given type, standing next to p.  It is taken back out of the game when the test
+// finishes.
+func (h *Harness) NewNpc(id int, p *Player) *world.NPC {
+	npc := world.NewNpc(id, p.X()+1, p.Y(), p.X()+1, p.X()+1, p.Y(), p.Y())
+	h.T.Cleanup(func() {
+		world.Npcs.Remove(npc)
+	})
+	return npc
+}
+
+//maxTicks How many engine ticks a harness drives a trigger for, before deciding that it is stuck.
+const maxTicks = 1000
+
+//run Runs fn as a coroutine for p, with p in state, and drives the engine's tick until it has finished.  The option
+// menus it opens are answered from p's queue of choices.
+func (h *Harness) run(p *Player, state world.MobState, fn func()) {
+	c := p.StartCoroutine(state, fn)
+	for ticks := 0; p.Coroutine() == c; ticks++ {
+		if ticks == maxTicks {
+			h.T.Errorf("%s was still running a trigger after %d ticks", p.Username(), maxTicks)
+			c.Cancel("stuck")
+		}
+		p.Lock()
+		reply := p.reply
+		p.reply = nil
+		p.Unlock()
+		if reply != nil {
+			p.HandleInput(reply)
+		}
+		tasks.TickList.Tick()
+	}
+}
+
+//TalkTo Emits the event for p talking to npc on the scripts, with the two of them in conversation until it has been
+// handled.  Returns false if no script handled it.
+func (h *Harness) TalkTo(p *Player, npc *world.NPC) (handled bool) {
+	p.SetVar("targetMob", npc)
+	npc.AddState(world.StateChatting)
+	defer func() {
+		p.UnsetVar("targetMob")
+		npc.RemoveState(world.StateChatting)
+	}()
+	h.run(p, world.StateChatting, func() {
+		handled = h.Emit(&world.TalkToNpcEvent{Player: p.Player, Npc: npc})
+	})
+	return
+}
+
+//UseObject Emits the event for p clicking on object on the scripts.  Returns false if no script handled it.
+func (h *Harness) UseObject(p *Player, object *world.Object, click int) (handled bool) {
+	h.run(p, world.MSBatching, func() {
+		handled = h.Emit(&world.ObjectActionEvent{Player: p.Player, Object: object, Click: click})
+	})
+	return
+}
+
+//UseItem Emits the event for p clicking on item in their inventory on the scripts.  Returns false if no script
+// handled it.
+func (h *Harness) UseItem(p *Player, item *world.Item) (handled bool) {
+	h.run(p, world.MSItemAction, func() {
+		handled = h.Emit(&world.ItemActionEvent{Player: p.Player, Item: item})
+	})
+	return
+}
+
+//Emit Hands ev to the event handlers bound by the scripts, and returns true if one of them cancelled it, which for
+// player actions means that it was handled.
+func (h *Harness) Emit(ev world.Event) bool {
+	return h.Scripts.Events.Emit(ev)
+}
+
+//CastSpell Runs the trigger for the spell with the given index, as though p had cast it on target.  Returns false if
+// the spell has no trigger.
+func (h *Harness) CastSpell(p *Player, idx int, target entity.MobileEntity) bool {
+	trigger, ok := h.Scripts.Spells[idx]
+	if !ok || trigger == nil {
+		return false
+	}
+	trigger(p.Player, map[string]interface{}{"idx": idx, "target": target})
+	return true
+}
+
+//Command Runs the command with the given name, as though p had typed it in.  Returns false if no script bound it.
+func (h *Harness) Command(p *Player, name string, args ...string) bool {
+	fn, ok := h.Scripts.Commands[name]
+	if !ok {
+		return false
+	}
+	h.run(p, world.StateIdle, func() {
+		fn(p.Player, args)
+	})
+	return true
+}
+
+//Player A fake player, that records every packet the server sends to it, and answers option menus from a queue.
+type Player struct {
+	*world.Player
+	h       *Harness
+	packets []*net.Packet
+	answers []int
+	// reply is the answer to the option menu this player was last shown, until it is handed to the coroutine awaiting it.
+	reply *net.Packet
+	sync.Mutex
+}
+
+//Answer Queues up choices to pick, in order, from the next option menus this player is shown.
+func (p *Player) Answer(choices ...int) {
+	p.Lock()
+	defer p.Unlock()
+	p.answers = append(p.answers, choices...)
+}
+
+//Packets Returns every packet that has been sent to this player so far.
+func (p *Player) Packets() []*net.Packet {
+	p.Lock()
+	defer p.Unlock()
+	return append([]*net.Packet(nil), p.packets...)
+}
+
+//Messages Returns the text of every server message sent to this player so far.
+func (p *Player) Messages() (messages []string) {
+	for _, packet := range p.Packets() {
+		if packet.Opcode == 131 {
+			messages = append(messages, string(packet.FrameBuffer[1:]))
+		}
+	}
+	return
+}
+
+//Menus Returns the options of every option menu this player has been shown so far.
+func (p *Player) Menus() (menus [][]string) {
+	for _, packet := range p.Packets() {
+		if packet.Opcode != 245 {
+			continue
+		}
+		var options []string
+		buf := packet.FrameBuffer[1:]
+		count := int(buf[0])
+		buf = buf[1:]
+		for i := 0; i < count; i++ {
+			options = append(options, string(buf[1:1+buf[0]]))
+			buf = buf[1+buf[0]:]
+		}
+		menus = append(menus, options)
+	}
+	return
+}
+
+//chats Returns the text of every chat message queued up for this player under the given attribute.
+func (p *Player) chats(name string, owner entity.MobileEntity) (lines []string) {
+	queue, ok := p.Var(name)
+	if !ok {
+		return
+	}
+	for _, msg := range queue.([]world.ChatMessage) {
+		if owner == nil || msg.Owner == owner {
+			lines = append(lines, msg.Message())
+		}
+	}
+	return
+}
+
+//Said Returns every line this player has said out loud in dialogue so far.
+func (p *Player) Said() []string {
+	return p.chats("questChatQ", p.Player)
+}
+
+//Heard Returns every line that npc has said to this player so far.
+func (p *Player) Heard(npc *world.NPC) []string {
+	return p.chats("npcChatQ", npc)
+}
+
+//Recorder A writer that splits the frames written to it back into packets, and records them against its player.
+type Recorder struct {
+	player *Player
+}
+
+//Write Records every packet framed in data.  Option menus are answered from the players queue of choices, and fail
+// the test if the queue has run dry, which gives up on the trigger that opened them.
+func (r *Recorder) Write(data []byte) (int, error) {
+	for buf := data; len(buf) >= 2; {
+		var payload []byte
+		if length := int(buf[0]); length >= 160 {
+			length = (length-160)<<8 + int(buf[1])
+			payload, buf = buf[2:2+length], buf[2+length:]
+		} else if length > 0 {
+			payload = append(append([]byte(nil), buf[2:2+length-1]...), buf[1])
+			buf = buf[2+length-1:]
+		} else {
+			buf = buf[2:]
+			continue
+		}
+		packet := net.NewPacket(payload[0], payload)
+		r.player.Lock()
+		r.player.packets = append(r.player.packets, packet)
+		answer := -1
+		if packet.Opcode == 245 && len(r.player.answers) > 0 {
+			answer, r.player.answers = r.player.answers[0], r.player.answers[1:]
+			r.player.reply = net.NewPacket(116, []byte{byte(answer)})
+		}
+		r.player.Unlock()
+		if packet.Opcode == 245 && answer < 0 {
+			r.player.h.T.Errorf("%s was shown an option menu with no answer queued", r.player.Username())
+			r.player.StopCoroutine("no answer queued")
+		}
+	}
+	return len(data), nil
+}
+
+//Flush Does nothing, as every write is recorded straight away.
+func (r *Recorder) Flush() error {
+	return nil
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+
+package scripttest
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/spkaeros/rscgo/pkg/definitions"
+	"github.com/spkaeros/rscgo/pkg/game/entity"
+)
+
+func TestEatingHeals(t *testing.T) {
+	h := New(t, "scripts/general/edible.ank")
+	h.DefineItem(132, definitions.ItemDefinition{Name: "Cooked meat"})
+	p := h.NewPlayer("eater")
+	p.Skills().SetCur(entity.StatHits, 5)
+	meat := p.Inventory.Get(p.Inventory.Add(132, 1))
+	if !h.UseItem(p, meat) {
+		t.Fatal("no trigger for eating cooked meat")
+	}
+	if p.Inventory.CountID(132) > 0 {
+		t.Error("the meat was not eaten")
+	}
+	if hits := p.Skills().Current(entity.StatHits); hits != 8 {
+		t.Errorf("eating healed to %d hits, want 8", hits)
+	}
+	want := []string{"You eat the Cooked meat...", "It heals some health."}
+	if got := p.Messages(); !reflect.DeepEqual(got, want) {
+		t.Errorf("eating sent messages %q, want %q", got, want)
+	}
+}
+
+func TestBankerDialogue(t *testing.T) {
+	h := New(t, "scripts/general/banker.ank")
+	p := h.NewPlayer("customer")
+	banker := h.NewNpc(95, p)
+	p.Answer(2, 0)
+	if !h.TalkTo(p, banker) {
+		t.Fatal("no trigger for talking to a banker")
+	}
+	menus := p.Menus()
+	if len(menus) != 2 || len(menus[0]) != 3 || menus[1][0] != "And what do you do?" {
+		t.Fatalf("banker showed menus %q", menus)
+	}
+	heard := p.Heard(banker)
+	if len(heard) == 0 || heard[len(heard)-1] != "So leave your valuables with us if you want to keep them safe" {
+		t.Errorf("banker said %q", heard)
+	}
+	if said := p.Said(); len(said) != 2 || said[1] != "And what do you do?" {
+		t.Errorf("player said %q", said)
+	}
+}
+
+func TestQuestGuideDialogue(t *testing.T) {
+	h := New(t, "scripts/tutorial_island/questguide.ank")
+	p := h.NewPlayer("newcomer")
+	guide := h.NewNpc(489, p)
+	if !h.TalkTo(p, guide) {
+		t.Fatal("no trigger for talking to the quest guide")
+	}
+	if msgs := p.Messages(); len(msgs) != 1 || msgs[0] != "You need to speak to the previous guide first." || len(p.Menus()) != 0 {
+		t.Fatalf("quest guide talked to a player who skipped ahead: %q", msgs)
+	}
+
+	p = h.NewPlayer("student")
+	p.SetCache("tutorial", 60)
+	p.Answer(1)
+	h.TalkTo(p, guide)
+	if said := p.Said(); len(said) != 3 || said[2] != "Okay thanks for the advice" {
+		t.Errorf("player said %q", said)
+	}
+	if stage := p.Cache("tutorial"); fmt.Sprint(stage) != "65" {
+		t.Errorf("tutorial stage is %v after the quest guide, want 65", stage)
+	}
+}
+
+func TestStatCommand(t *testing.T) {
+	h := New(t, "scripts/commands/setstat.ank")
+	p := h.NewPlayer("admin")
+	if !h.Command(p, "stat", "cooking", "50") {
+		t.Fatal("no stat command")
+	}
+	if lvl := p.Skills().Maximum(entity.StatCooking); lvl != 50 {
+		t.Errorf("cooking is level %d after ::stat, want 50", lvl)
+	}
+	h.Command(p, "stat", "cooking")
+	if msgs := p.Messages(); len(msgs) != 1 || msgs[0] != "Invalid args.  Usage: ::stat <skill> <lvl>" {
+		t.Errorf("bad ::stat sent messages %q", msgs)
+	}
+}
+
+func TestMissingSpell(t *testing.T) {
+	h := New(t, "scripts/skills/magic.ank")
+	p := h.NewPlayer("wizard")
+	// spell 3 is Enchant lvl-1 amulet, which has no handler yet
+	if !h.CastSpell(p, 3, nil) {
+		t.Fatal("Enchant lvl-1 amulet has no trigger")
+	}
+	if msgs := p.Messages(); len(msgs) != 1 || msgs[0] != "Not yet added" {
+		t.Errorf("casting Enchant lvl-1 amulet sent messages %q, want \"Not yet added\"", msgs)
+	}
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"math"
+)
+
+// Shop pricing and restocking models.
+//
+// Every shop has a ShopModel, which decides how often it restocks, how far each restock moves an item back toward its
+// stocked amount, and how far an item's price moves away from the shop's base percentages as its amount drifts away
+// from the stocked amount.  Models are plain values with no ties to the game loop, so they can be swapped per shop.
+
+//ShopModel decides how a shop restocks and prices its items.
+type ShopModel interface {
+	//Interval returns how many ticks pass between each restock step.  Zero or less disables restocking.
+	Interval() int
+	//Restock returns the amount a shop should hold of item id after one restock step, given the amount it currently
+	// holds and the amount it normally stocks.
+	Restock(id, stocked, amount int) int
+	//PriceDelta returns the percentage to add to the shop's base buying or selling percentage for item id, given the
+	// amount the shop currently holds and the amount it normally stocks.
+	PriceDelta(id, stocked, amount int) int
+}
+
+//ClassicShopModel The classic RSC shop behaviour.  Prices change by a flat percentage for every item the shop is short
+// of or over its stock, which can be overridden per item.
+type ClassicShopModel struct {
+	// Ticks between restock steps.
+	Ticks int
+	// How many items are restocked per step when the shop is short of its stock.
+	Step int
+	// How many items the shop gets rid of per step when it holds more than its stock, e.g after players sell to it.
+	// Zero keeps sold items forever.
+	Decay int
+	// Percent the price moves for every item the shop is short of or over its stock.
+	DeltaRate int
+	// Per-item overrides of DeltaRate.
+	ItemRates map[int]int
+}
+
+//DefaultShopModel returns the model shops use unless they are configured otherwise.
+func DefaultShopModel() ShopModel {
+	return &ClassicShopModel{Ticks: ShopGeneralRespawnTime, Step: 1, Decay: 1, DeltaRate: ShopNormalDeltaRate}
+}
+
+func (m *ClassicShopModel) Interval() int {
+	return m.Ticks
+}
+
+func (m *ClassicShopModel) Restock(id, stocked, amount int) int {
+	return restockStep(stocked, amount, m.Step, m.Decay)
+}
+
+func (m *ClassicShopModel) PriceDelta(id, stocked, amount int) int {
+	rate, ok := m.ItemRates[id]
+	if !ok {
+		rate = m.DeltaRate
+	}
+	return (stocked - amount) * rate
+}
+
+//CurveShopModel Prices follow a curve based on how far an item's amount has deviated from its stock, relative to the
+// size of that stock, so that large stocks aren't marked up as hard as small ones by the same number of sales.
+type CurveShopModel struct {
+	// Ticks between restock steps.
+	Ticks int
+	// How many items are restocked per step when the shop is short of its stock.
+	Step int
+	// How many items the shop gets rid of per step when it holds more than its stock.  Zero keeps sold items forever.
+	Decay int
+	// Percent the price moves when the shop is short or over by its entire stock.
+	Elasticity int
+	// The furthest, in percent, that the price may move away from the base percentage in either direction.  Zero leaves
+	// it unlimited, other than never taking more than the whole price off.
+	Limit int
+}
+
+func (m *CurveShopModel) Interval() int {
+	return m.Ticks
+}
+
+func (m *CurveShopModel) Restock(id, stocked, amount int) int {
+	return restockStep(stocked, amount, m.Step, m.Decay)
+}
+
+func (m *CurveShopModel) PriceDelta(id, stocked, amount int) int {
+	deviation := float64(stocked-amount) / math.Max(1, float64(stocked))
+	// Shortages get dearer faster the closer the shop is to running out, while surpluses ease off gradually.
+	var delta float64
+	if deviation > 0 {
+		delta = float64(m.Elasticity) * deviation * deviation
+	} else {
+		delta = -float64(m.Elasticity) * math.Sqrt(-deviation)
+	}
+	if m.Limit > 0 {
+		delta = math.Max(-float64(m.Limit), math.Min(float64(m.Limit), delta))
+	}
+	// A big enough surplus would otherwise take the price below nothing.
+	return int(math.Max(-100, math.Round(delta)))
+}
+
+//restockStep moves amount toward stocked, by up to step items when short and up to decay items when over.
+func restockStep(stocked, amount, step, decay int) int {
+	if amount < stocked {
+		return int(math.Min(float64(amount+step), float64(stocked)))
+	}
+	if amount > stocked {
+		return int(math.Max(float64(amount-decay), float64(stocked)))
+	}
+	return amount
+}
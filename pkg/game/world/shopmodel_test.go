@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"testing"
+)
+
+func TestRestockStep(t *testing.T) {
+	tests := []struct {
+		stocked, amount, step, decay, want int
+	}{
+		{5, 5, 1, 1, 5},
+		{5, 0, 1, 1, 1},
+		{5, 4, 3, 1, 5},
+		{5, 9, 1, 1, 8},
+		{5, 9, 1, 10, 5},
+		{5, 9, 1, 0, 9},
+		{0, 3, 1, 2, 1},
+	}
+	for _, test := range tests {
+		if got := restockStep(test.stocked, test.amount, test.step, test.decay); got != test.want {
+			t.Errorf("restockStep(%d, %d, %d, %d) = %d, want %d", test.stocked, test.amount, test.step, test.decay, got, test.want)
+		}
+	}
+}
+
+func TestClassicPriceDelta(t *testing.T) {
+	model := &ClassicShopModel{DeltaRate: ShopNormalDeltaRate, ItemRates: map[int]int{7: 1}}
+	tests := []struct {
+		id, stocked, amount, want int
+	}{
+		{1, 5, 5, 0},
+		{1, 5, 3, 6},
+		{1, 5, 0, 15},
+		{1, 5, 8, -9},
+		{1, 0, 4, -12},
+		{7, 5, 3, 2},
+		{7, 5, 8, -3},
+	}
+	for _, test := range tests {
+		if got := model.PriceDelta(test.id, test.stocked, test.amount); got != test.want {
+			t.Errorf("PriceDelta(%d, %d, %d) = %d, want %d", test.id, test.stocked, test.amount, got, test.want)
+		}
+	}
+}
+
+func TestCurvePriceDelta(t *testing.T) {
+	model := &CurveShopModel{Elasticity: 100, Limit: 60}
+	if got := model.PriceDelta(1, 10, 10); got != 0 {
+		t.Errorf("PriceDelta at stock = %d, want 0", got)
+	}
+	// Running short should only ever make items dearer, and more so the closer the shop is to running out.
+	last := 0
+	for amount := 9; amount >= 0; amount-- {
+		got := model.PriceDelta(1, 10, amount)
+		if got < last {
+			t.Errorf("PriceDelta(1, 10, %d) = %d, dropped below %d", amount, got, last)
+		}
+		last = got
+	}
+	if last != 60 {
+		t.Errorf("PriceDelta when sold out = %d, want it clamped to 60", last)
+	}
+	if got := model.PriceDelta(1, 10, 5); got != 25 {
+		t.Errorf("PriceDelta(1, 10, 5) = %d, want 25", got)
+	}
+	if got := model.PriceDelta(1, 100, 50); got != 25 {
+		t.Errorf("PriceDelta(1, 100, 50) = %d, want 25, same as a small stock at the same deviation", got)
+	}
+	// Surpluses make items cheaper.
+	if got := model.PriceDelta(1, 100, 125); got != -50 {
+		t.Errorf("PriceDelta(1, 100, 125) = %d, want -50", got)
+	}
+	if got := model.PriceDelta(1, 10, 1000); got != -60 {
+		t.Errorf("PriceDelta(1, 10, 1000) = %d, want it clamped to -60", got)
+	}
+	// Without a limit, surpluses still never take more than the whole price off.
+	model.Limit = 0
+	if got := model.PriceDelta(1, 10, 1000); got != -100 {
+		t.Errorf("PriceDelta(1, 10, 1000) without a limit = %d, want it clamped to -100", got)
+	}
+	if got := model.PriceDelta(1, 0, 50); got != -100 {
+		t.Errorf("PriceDelta(1, 0, 50) without a limit = %d, want it clamped to -100", got)
+	}
+	if got := model.PriceDelta(1, 100, 125); got != -50 {
+		t.Errorf("PriceDelta(1, 100, 125) without a limit = %d, want -50", got)
+	}
+}
+
+func TestShopRestock(t *testing.T) {
+	shop := NewShop(ShopBuyPriceBasePercent, ShopSellPriceBasePercent, []*Item{{ID: 1, Amount: 5}, {ID: 2, Amount: 1}}, "test")
+	shop.Model = &ClassicShopModel{Ticks: 10, Step: 2, Decay: 1, DeltaRate: ShopNormalDeltaRate}
+	shop.SetAmount(1, 0)
+	shop.Add(2, 2)
+	shop.Add(3, 2)
+
+	steps := 0
+	for shop.Restock() {
+		steps++
+		if steps > 10 {
+			t.Fatal("shop never finished restocking")
+		}
+	}
+	if steps != 3 {
+		t.Errorf("restocking took %d steps, want 3", steps)
+	}
+	if got := shop.Inventory.Count(1); got != 5 {
+		t.Errorf("restocked to %d of item 1, want 5", got)
+	}
+	if got := shop.Inventory.Count(2); got != 1 {
+		t.Errorf("decayed to %d of item 2, want 1", got)
+	}
+	if shop.Inventory.Contains(3) {
+		t.Errorf("unstocked item 3 is still on the shelves after decaying away")
+	}
+	if shop.Stock.Contains(3) {
+		t.Errorf("selling to the shop changed what it stocks")
+	}
+}
+
+func TestShopPriceDelta(t *testing.T) {
+	shop := NewShop(ShopBuyPriceBasePercent, ShopSellPriceBasePercent, []*Item{{ID: 1, Amount: 5}}, "test")
+	if got := shop.DeltaPercentModID(1); got != 0 {
+		t.Errorf("DeltaPercentModID at stock = %d, want 0", got)
+	}
+	shop.Remove(1, 2)
+	if got := shop.DeltaPercentModID(1); got != 2*ShopNormalDeltaRate {
+		t.Errorf("DeltaPercentModID after selling 2 = %d, want %d", got, 2*ShopNormalDeltaRate)
+	}
+	shop.Model = &CurveShopModel{Elasticity: 100}
+	if got := shop.DeltaPercentModID(1); got != 16 {
+		t.Errorf("DeltaPercentModID with curve model = %d, want 16", got)
+	}
+}
@@ -10,8 +10,11 @@
 package world
 
 import (
+	"sort"
 	"sync"
 
+	"go.uber.org/atomic"
+
 	"github.com/spkaeros/rscgo/pkg/tasks"
 )
 
@@ -55,6 +58,10 @@ type (
 		Inventory *ShopItems
 		// Descriptive name for this shop.
 		Name string
+		// Decides how this shop restocks and prices its items.
+		Model ShopModel
+		// True if the inventory has changed since the shop was last saved.
+		changed atomic.Bool
 		// List of players actively using the shop
 		Players *MobList
 	}
@@ -115,6 +122,18 @@ func (s *ShopContainer) Get(name string) *Shop {
 	return shop
 }
 
+//Names returns the names of every shop in this collection, sorted.
+func (s *ShopContainer) Names() []string {
+	s.RLock()
+	var names []string
+	for name := range s.set {
+		names = append(names, name)
+	}
+	s.RUnlock()
+	sort.Strings(names)
+	return names
+}
+
 func (s *ShopContainer) Range(fn func(*Shop)) {
 	s.RLock()
 	for _, value := range s.set {
@@ -138,131 +157,153 @@ var (
 	Shops = &ShopContainer{
 		set: make(map[string]*Shop),
 	}
-	// Represents a default general shop's stock.
+	//ShopGeneralTemplate is the name of the shop definition that every general store copies its stock and rates from.
+	//
 	// The distinction of what makes it a general shop is that it buys any tradeable items the player tries to sell it,
 	// where a normal shop only deals in its initial stock.
 	// For every inventory count change, whether it be up or down from stock count, the shop changes its prices by a
 	// certain percentage according to whichever direction in which the count has gone.
-	//
-	// This is the basic default definition of a general shop:
-	// Default stock:
-	// 	2 jugs
-	//	2 shears
-	//	2 buckets
-	//	2 tinderboxes
-	//	2 chisels
-	//	5 hammers
-	//	10 sleeping bags
-	// Base sale percent:
-	// 	130% of basePrice
-	// Base purchase percent:
-	// 	40% of basePrice
-	//
-	generalStock = &ShopItems{
-		set: shopItemSet{
-			{ID: 140, Amount: 2},   // 2 jugs
-			{ID: 144, Amount: 2},   // 2 shears
-			{ID: 21, Amount: 2},    // 2 buckets
-			{ID: 166, Amount: 2},   // 2 tinderboxes
-			{ID: 167, Amount: 2},   // 2 chisels
-			{ID: 168, Amount: 5},   // 5 hammers
-			{ID: 1263, Amount: 10}, // 10 sleeping bags
-		},
-	}
+	ShopGeneralTemplate = "general"
+	shopSaver           sync.Once
 )
 
+//ShopService An interface for persisting the live stock levels of shops across restarts.
+type ShopService interface {
+	ShopStock(name string) []*Item
+	ShopSave(*Shop)
+}
+
+//DefaultShopService the shop stock persistence service in use by the game server.
+var DefaultShopService ShopService
+
 //NewShop creates a new Shop instance using the arguments provided, and returns it.
 //
 // Returns: a new Shop instance, made with the given arguments
-func NewShop(percentPurchasesPrice, percentSalesPrice int, stock shopItemSet, name string) *Shop {
+func NewShop(percentPurchasesPrice, percentSalesPrice int, stock []*Item, name string) *Shop {
 	s := &ShopItems{set: stock}
-	return &Shop{BasePurchasePercent: percentPurchasesPrice, BaseSalePercent: percentSalesPrice, Stock: s, Inventory: s.Clone(), Name: name}
+	return &Shop{BasePurchasePercent: percentPurchasesPrice, BaseSalePercent: percentSalesPrice, Model: DefaultShopModel(),
+		Stock: s, Inventory: s.Clone(), Name: name, Players: NewMobList()}
 }
 
 // Creates a new general shop, and adds it automatically to the world-local ShopContainer instance before returning it
 //
-// Returns: Shops.get(name), after building and adding a new general shop to it, using a generic general shop definition.
+// Returns: Shops.get(name), after building and adding a new general shop to it, using the general shop template definition.
 func NewGeneralShop(name string) *Shop {
-	shop := &Shop{true, 40, 130, generalStock.Clone(), generalStock.Clone(), name, NewMobList()}
-	Shops.Add(name, shop)
+	shop := &Shop{BuysUnstocked: true, BasePurchasePercent: ShopBuyPriceBasePercent, BaseSalePercent: ShopSellPriceBasePercent,
+		Model: DefaultShopModel(), Stock: &ShopItems{}, Name: name, Players: NewMobList()}
+	if Shops.Contains(ShopGeneralTemplate) {
+		template := Shops.Get(ShopGeneralTemplate)
+		shop.BasePurchasePercent, shop.BaseSalePercent = template.BasePurchasePercent, template.BaseSalePercent
+		shop.Model = template.Model
+		shop.Stock = template.Stock.Clone()
+	}
+	shop.Inventory = shop.Stock.Clone()
+	AddShop(shop)
+	return shop
+}
+
+//AddShop restores the shops persisted stock levels, adds it to Shops, and starts restocking it.
+func AddShop(shop *Shop) {
+	shop.LoadStock()
+	Shops.Add(shop.Name, shop)
+	shop.StartRestocking()
+	shopSaver.Do(func() {
+		tasks.TickList.Add(func() bool {
+			if Ticks.Load()%TicksMinute == 0 {
+				Shops.Range(func(shop *Shop) {
+					if shop.changed.Load() {
+						go shop.Save()
+					}
+				})
+			}
+			return false
+		})
+	})
+}
+
+//LoadStock replaces the shops live inventory with whatever was persisted for it, if anything.
+func (s *Shop) LoadStock() {
+	if DefaultShopService == nil {
+		return
+	}
+	saved := DefaultShopService.ShopStock(s.Name)
+	if saved == nil {
+		return
+	}
+	inventory := &ShopItems{set: saved}
+	s.Stock.Range(func(item *Item) bool {
+		// stocked items stay listed, even when sold out
+		if !inventory.Contains(item.ID) {
+			inventory.set = append(inventory.set, &Item{ID: item.ID})
+		}
+		return false
+	})
+	s.Inventory = inventory
+}
+
+//Save persists the live inventory of this shop.
+func (s *Shop) Save() {
+	if DefaultShopService == nil {
+		return
+	}
+	s.changed.Store(false)
+	DefaultShopService.ShopSave(s)
+}
+
+//StartRestocking schedules this shop to move its inventory a step back toward its stock as often as its model asks.
+func (s *Shop) StartRestocking() {
 	shopTicker := 0
 	tasks.TickList.Add(func() bool {
 		shopTicker++
-		if shopTicker == 20 { // 12.8s
+		if interval := s.Model.Interval(); interval > 0 && shopTicker >= interval {
 			shopTicker = 0
-			changed := false
-			shop.Inventory.Range(func(item *Item) bool {
-				if shop.Stock.Count(item.ID) == item.Amount {
-					return false
-				}
-				changed = true
-				//				if stockedAmount <= 0 {
-				//					changed = true
-
-				//					if item.Amount > 0 {
-				//						item.Amount--
-				//					}
-				//					return item.Amount == 0
-				//				} else {
-				// We always have these items around and work on changing the amount back to normal when it changes
-				//				if stockedAmount < item.Amount {
-				// We're low on this item, increase toward normal.
-				//						changed = true
-				//					item.Amount--
-				//					if item.Amount == 0 {
-				//						shop.Inventory.Remove(item)
-				//					}
-				//				}
-				if shop.Stock.Count(item.ID) > item.Amount {
-					item.Amount++
-				} else {
-					item.Amount--
-				}
-				//				if stockedAmount > item.Amount {
-				// We are overstocked with this item, decrease toward normal.
-				//					item.Amount++
-				//						changed = true
-				//				}
-
-				//				}
-				return item.Amount == 0
-			})
-			if changed {
-				shop.Players.RangePlayers(func(player *Player) bool {
-					player.SendPacket(ShopOpen(shop))
-					return false
-				})
+			if s.Restock() {
+				s.Refresh()
 			}
-			//			if changed {
-			//				shop.Players.Range(func(player *Player) {
-			//					if player.HasState(StateShopping) && player.CurrentShop() == shop {
-			//						player.SendPacket(ShopOpen(shop))
-			//					}
-			//				})
-			//			}
 		}
 		return false
 	})
-	return shop
+}
+
+//Restock moves every item in the shops inventory one step toward its stocked amount, as decided by its model.  Unstocked items are removed
+// once they run out.  Returns true if anything changed.
+func (s *Shop) Restock() bool {
+	changed := false
+	s.Inventory.Range(func(item *Item) bool {
+		amount := s.Model.Restock(item.ID, s.Stock.Count(item.ID), item.Amount)
+		if amount == item.Amount {
+			return false
+		}
+		changed = true
+		item.Amount = amount
+		return item.Amount <= 0 && !s.Stock.Contains(item.ID)
+	})
+	if changed {
+		s.changed.Store(true)
+	}
+	return changed
+}
+
+//Refresh sends the current state of the shop to every player browsing it.
+func (s *Shop) Refresh() {
+	s.Players.RangePlayers(func(player *Player) bool {
+		if s == player.CurrentShop() {
+			player.SendPacket(ShopOpen(s))
+		}
+		return false
+	})
 }
 
 func (s *ShopItems) Add(item *Item) {
-	if !s.Contains(item.ID) {
-		s.Lock()
-		defer s.Unlock()
-		s.set = append(s.set, item)
-	} else {
-		s.Get(item.ID).Amount += 1
-		//		s.RLock()
-		//		defer s.RUnlock()
-		//		for _, shopItem := range s.set {
-		//			if shopItem.ID == item.ID {
-		//				for i := 0; i < item.Amount; i++ {
-		//					shopItem.Amount += 1
-		//				}
-		//			}
-		//		}
+	s.Lock()
+	defer s.Unlock()
+	for _, shopItem := range s.set {
+		if shopItem.ID == item.ID {
+			shopItem.Amount += item.Amount
+			return
+		}
 	}
+	s.set = append(s.set, item)
 }
 
 func (s *ShopItems) Size() int {
@@ -323,7 +364,14 @@ func (s *ShopItems) Get(id int) *Item {
 //
 // Returns: true if this shop items collection has any items with the provided ID, otherwise returns false.
 func (s *ShopItems) Contains(id int) bool {
-	return s.Get(id) != nil
+	s.RLock()
+	defer s.RUnlock()
+	for _, item := range s.set {
+		if item.ID == id {
+			return true
+		}
+	}
+	return false
 }
 
 // Ensures safe access when requesting the current count of a specific item by ID in this shops inventory.
@@ -352,19 +400,20 @@ func (s *ShopItems) Clone() *ShopItems {
 
 //Clone makes a clone of the receiver shop and returns it.
 func (s *Shop) Clone() *Shop {
-	return &Shop{BuysUnstocked: s.BuysUnstocked, BasePurchasePercent: s.BasePurchasePercent, BaseSalePercent: s.BaseSalePercent, Stock: s.Stock.Clone(), Inventory: s.Inventory.Clone()}
+	return &Shop{BuysUnstocked: s.BuysUnstocked, BasePurchasePercent: s.BasePurchasePercent, BaseSalePercent: s.BaseSalePercent, Model: s.Model,
+		Stock: s.Stock.Clone(), Inventory: s.Inventory.Clone(), Players: NewMobList()}
 }
 
-//DeltaPercentMod calculates the percentage to scale the item's price up or down from its respective base percentage.
-// The formula simply subtracts the shop's base stocked amount of item from item's amount, and multiplies the difference
-// by ShopNormalDeltaRate.
+//DeltaPercentMod calculates the percentage to scale the item's price up or down from its respective base percentage,
+// as decided by the shop's model.
 func (s *Shop) DeltaPercentMod(item *Item) int {
-	return item.DeltaAmount(s.Stock.Get(item.ID)) * ShopNormalDeltaRate
+	return s.Model.PriceDelta(item.ID, s.Stock.Count(item.ID), item.Amount)
 }
 
+//DeltaPercentModID calculates the percentage to scale the price of the item with the provided ID up or down from its
+// respective base percentage, as decided by the shop's model.
 func (s *Shop) DeltaPercentModID(id int) int {
-	return s.Inventory.Get(id).DeltaAmount(s.Stock.Get(id)) * ShopNormalDeltaRate
-	//return (s.Stock.Count(id) - s.Inventory.Count(id)) * ShopNormalDeltaRate
+	return s.Model.PriceDelta(id, s.Stock.Count(id), s.Inventory.Count(id))
 }
 
 func (s *Shop) Remove(id int, amount int) bool {
@@ -372,5 +421,37 @@ func (s *Shop) Remove(id int, amount int) bool {
 		return false
 	}
 	s.Inventory.RemoveID(id, amount, !s.Stock.Contains(id))
+	s.changed.Store(true)
 	return true
 }
+
+//ResetStock puts the shops inventory back to exactly its stocked amounts.
+func (s *Shop) ResetStock() {
+	stock := s.Stock.Clone()
+	s.Inventory.Lock()
+	s.Inventory.set = stock.set
+	s.Inventory.Unlock()
+	s.changed.Store(true)
+}
+
+//Add puts amount of the item with the provided ID into the shops inventory.
+func (s *Shop) Add(id int, amount int) {
+	s.Inventory.Add(&Item{ID: id, Amount: amount})
+	s.changed.Store(true)
+}
+
+//SetAmount sets the shops live inventory amount of the item with the provided ID.  Items that the shop does not stock
+// are taken off the shelves when set to zero.
+func (s *Shop) SetAmount(id int, amount int) {
+	if !s.Inventory.Contains(id) {
+		s.Inventory.Add(&Item{ID: id})
+	}
+	s.Inventory.Range(func(item *Item) bool {
+		if item.ID == id {
+			item.Amount = amount
+			return amount <= 0 && !s.Stock.Contains(id)
+		}
+		return false
+	})
+	s.changed.Store(true)
+}
@@ -0,0 +1,219 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+package world
+
+import (
+	"strings"
+
+	"github.com/spkaeros/rscgo/pkg/rand"
+)
+
+//MaxFatigue The fatigue value that represents 100% fatigue.  The client expects this divided by 100.
+const MaxFatigue = 75000
+
+const (
+	//bedRestRate How much fatigue a player sleeping in a bed recovers each tick.
+	bedRestRate = 2500
+	//bagRestRate How much fatigue a player sleeping in a sleeping bag recovers each tick.
+	bagRestRate = 1000
+)
+
+const (
+	//sleepWordWidth The width of the sleep word image, in pixels
+	sleepWordWidth = 255
+	//sleepWordHeight The height of the sleep word image, in pixels
+	sleepWordHeight = 40
+	//glyphScale How many pixels in the sleep word image each pixel of a glyph is drawn as
+	glyphScale = 3
+)
+
+//sleepWords The words that players may be asked to type in order to wake up.
+var sleepWords = []string{
+	"apple", "bread", "chair", "dream", "eagle", "flame", "grape", "house", "juice", "knife", "lemon", "magic",
+	"night", "ocean", "piano", "queen", "river", "stone", "table", "tiger", "water", "zebra", "sword", "shield",
+	"dragon", "castle", "forest", "bucket", "candle", "garden", "hammer", "island", "jungle", "kettle", "ladder",
+	"market", "needle", "orange", "pillow", "rabbit", "silver", "tunnel", "velvet", "wizard", "yellow", "bronze",
+	"cheese", "goblin", "potato", "salmon", "anchor", "bridge", "cactus", "lantern", "monkey", "pepper", "wheat",
+}
+
+//glyphs 5x7 bitmaps of every letter that may appear in a sleep word.
+var glyphs = map[rune][7]string{
+	'a': {".....", ".....", ".###.", "....#", ".####", "#...#", ".####"},
+	'b': {"#....", "#....", "####.", "#...#", "#...#", "#...#", "####."},
+	'c': {".....", ".....", ".###.", "#....", "#....", "#...#", ".###."},
+	'd': {"....#", "....#", ".####", "#...#", "#...#", "#...#", ".####"},
+	'e': {".....", ".....", ".###.", "#...#", "#####", "#....", ".###."},
+	'f': {"..##.", ".#..#", ".#...", "###..", ".#...", ".#...", ".#..."},
+	'g': {".....", ".####", "#...#", "#...#", ".####", "....#", ".###."},
+	'h': {"#....", "#....", "#.##.", "##..#", "#...#", "#...#", "#...#"},
+	'i': {"..#..", ".....", ".##..", "..#..", "..#..", "..#..", ".###."},
+	'j': {"...#.", ".....", "..##.", "...#.", "...#.", "#..#.", ".##.."},
+	'k': {"#....", "#....", "#..#.", "#.#..", "##...", "#.#..", "#..#."},
+	'l': {".##..", "..#..", "..#..", "..#..", "..#..", "..#..", ".###."},
+	'm': {".....", ".....", "##.#.", "#.#.#", "#.#.#", "#.#.#", "#.#.#"},
+	'n': {".....", ".....", "#.##.", "##..#", "#...#", "#...#", "#...#"},
+	'o': {".....", ".....", ".###.", "#...#", "#...#", "#...#", ".###."},
+	'p': {".....", "####.", "#...#", "#...#", "####.", "#....", "#...."},
+	'q': {".....", ".####", "#...#", "#...#", ".####", "....#", "....#"},
+	'r': {".....", ".....", "#.##.", "##..#", "#....", "#....", "#...."},
+	's': {".....", ".....", ".####", "#....", ".###.", "....#", "####."},
+	't': {".#...", ".#...", "###..", ".#...", ".#...", ".#..#", "..##."},
+	'u': {".....", ".....", "#...#", "#...#", "#...#", "#..##", ".##.#"},
+	'v': {".....", ".....", "#...#", "#...#", "#...#", ".#.#.", "..#.."},
+	'w': {".....", ".....", "#...#", "#...#", "#.#.#", "#.#.#", ".#.#."},
+	'x': {".....", ".....", "#...#", ".#.#.", "..#..", ".#.#.", "#...#"},
+	'y': {".....", "#...#", "#...#", "#...#", ".####", "....#", ".###."},
+	'z': {".....", ".....", "#####", "...#.", "..#..", ".#...", "#####"},
+}
+
+//renderSleepWord Draws word into a new sleep word image, with some jitter and noise to keep it from being trivially
+// machine-readable, and returns it as rows of pixels.  A true pixel is part of the foreground.
+func renderSleepWord(word string) (image [sleepWordHeight][sleepWordWidth]bool) {
+	glyphWidth, glyphHeight := 5*glyphScale, 7*glyphScale
+	x := rand.Rng.Intn(sleepWordWidth - len(word)*(glyphWidth+6) + 1)
+	for _, c := range word {
+		glyph, ok := glyphs[c]
+		if !ok {
+			continue
+		}
+		top := 2 + rand.Rng.Intn(sleepWordHeight-glyphHeight-3)
+		for gy, row := range glyph {
+			for gx, pixel := range row {
+				if pixel != '#' {
+					continue
+				}
+				for dy := 0; dy < glyphScale; dy++ {
+					for dx := 0; dx < glyphScale; dx++ {
+						if px, py := x+gx*glyphScale+dx, top+gy*glyphScale+dy; px < sleepWordWidth {
+							image[py][px] = true
+						}
+					}
+				}
+			}
+		}
+		x += glyphWidth + 2 + rand.Rng.Intn(5)
+	}
+	// A stray line through the middle, and some specks across the whole image
+	y := sleepWordHeight/4 + rand.Rng.Intn(sleepWordHeight/2)
+	for x := 0; x < sleepWordWidth; x++ {
+		if rand.Rng.Intn(4) == 0 && y > 1 && y < sleepWordHeight-2 {
+			y += rand.Rng.Intn(3) - 1
+		}
+		if x%3 != 0 {
+			image[y][x] = !image[y][x]
+		}
+	}
+	for i := 0; i < 120; i++ {
+		image[rand.Rng.Intn(sleepWordHeight)][rand.Rng.Intn(sleepWordWidth)] = true
+	}
+	return
+}
+
+//encodeSleepWord Encodes a sleep word image into the format the client expects.
+//
+// The first row is sent as alternating run lengths of background and foreground pixels, starting with background.
+// Every following row is sent relative to the row above it: a run length of pixels that are unchanged from the row
+// above, followed implicitly by a single pixel that is flipped, repeated until the row is full.
+func encodeSleepWord(image [sleepWordHeight][sleepWordWidth]bool) (data []byte) {
+	colour, run := false, 0
+	for x := 0; x < sleepWordWidth; x++ {
+		if image[0][x] != colour {
+			data = append(data, byte(run))
+			colour, run = !colour, 0
+		}
+		run++
+	}
+	data = append(data, byte(run))
+	for y := 1; y < sleepWordHeight; y++ {
+		for x := 0; x < sleepWordWidth; {
+			run := 0
+			for x < sleepWordWidth && image[y][x] == image[y-1][x] {
+				run++
+				x++
+			}
+			data = append(data, byte(run))
+			// the pixel that ended the run is the flipped one, which the client fills in on its own
+			x++
+		}
+	}
+	return
+}
+
+//Tired Returns true if this player is too fatigued to gain any experience.
+func (p *Player) Tired() bool {
+	return p.Fatigue() >= MaxFatigue
+}
+
+//SendFatigue Sends this players current fatigue to their client.
+func (p *Player) SendFatigue() {
+	p.SendPacket(Fatigue(p))
+}
+
+//Sleep Sends this player to sleep, showing them the sleep screen with a new sleep word to solve.  Their fatigue
+// recovers each tick that they remain asleep, faster if bed is true than when using a sleeping bag, and is only
+// applied once they solve the sleep word.
+func (p *Player) Sleep(bed bool) {
+	if p.HasState(StateSleeping) || p.IsFighting() {
+		return
+	}
+	rate := bagRestRate
+	if bed {
+		rate = bedRestRate
+	}
+	p.AddState(StateSleeping)
+	p.SetVar("sleepFatigue", p.Fatigue())
+	p.NewSleepWord()
+	p.SendPacket(SleepFatigue(p))
+	p.Tickables.Add(func() bool {
+		if !p.HasState(StateSleeping) {
+			return true
+		}
+		if fatigue := p.VarInt("sleepFatigue", 0); fatigue > 0 {
+			fatigue -= rate
+			if fatigue < 0 {
+				fatigue = 0
+			}
+			p.SetVar("sleepFatigue", fatigue)
+			p.SendPacket(SleepFatigue(p))
+		}
+		return false
+	})
+}
+
+//NewSleepWord Picks a new sleep word for this player to solve, and sends it to their sleep screen.
+func (p *Player) NewSleepWord() {
+	p.SetVar("sleepWord", sleepWords[rand.Rng.Intn(len(sleepWords))])
+	p.SendPacket(SleepWord(p))
+}
+
+//AnswerSleepWord Checks guess against this players sleep word.  If it matches, the player wakes up with whatever
+// fatigue they have recovered to, otherwise they are told they got it wrong and are given a new word shortly after.
+func (p *Player) AnswerSleepWord(guess string) {
+	if !p.HasState(StateSleeping) {
+		return
+	}
+	if !strings.EqualFold(strings.TrimSpace(guess), p.VarString("sleepWord", "")) {
+		p.SendPacket(SleepWrong)
+		p.Tickables.Schedule(3, func() bool {
+			if p.HasState(StateSleeping) {
+				p.NewSleepWord()
+			}
+			return true
+		})
+		return
+	}
+	p.SetFatigue(p.VarInt("sleepFatigue", p.Fatigue()))
+	p.UnsetVar("sleepWord")
+	p.UnsetVar("sleepFatigue")
+	p.RemoveState(StateSleeping)
+	p.SendPacket(SleepClose)
+	p.SendFatigue()
+	p.Message("You wake up - feeling refreshed")
+}
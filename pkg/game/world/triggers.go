@@ -0,0 +1,276 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/spkaeros/rscgo/pkg/definitions"
+)
+
+//TriggerKeys The static ids and command strings that a trigger declares it handles, e.g objectPredicate(1, "open").
+// Triggers with keys are looked up in an index when their event happens, rather than each being asked in turn.
+type TriggerKeys struct {
+	IDs      []int
+	Commands []string
+}
+
+//newTriggerKeys Returns the keys in ids, which may be a mix of integer ids and command strings as passed from scripts.
+func newTriggerKeys(ids []interface{}) TriggerKeys {
+	var k TriggerKeys
+	for _, id := range ids {
+		if cmd, ok := id.(string); ok {
+			k.Commands = append(k.Commands, cmd)
+			continue
+		}
+		v := reflect.ValueOf(id)
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			k.IDs = append(k.IDs, int(v.Int()))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			k.IDs = append(k.IDs, int(v.Uint()))
+		}
+	}
+	return k
+}
+
+//matches Returns true if id or command is one of these keys.
+func (k *TriggerKeys) matches(id int, command string) bool {
+	for _, i := range k.IDs {
+		if i == id {
+			return true
+		}
+	}
+	for _, cmd := range k.Commands {
+		if cmd == command {
+			return true
+		}
+	}
+	return false
+}
+
+func (k *TriggerKeys) triggerKeys() *TriggerKeys {
+	return k
+}
+
+//keyedPredicate A trigger predicate that is built from static keys, and so can be indexed.
+type keyedPredicate interface {
+	triggerKeys() *TriggerKeys
+}
+
+//ObjectPredicate Matches objects by ID, or by the command of the option that was clicked.
+type ObjectPredicate struct {
+	TriggerKeys
+}
+
+//Check Returns true if object, clicked with the given option, matches this predicate.
+func (p *ObjectPredicate) Check(object *Object, click int) bool {
+	return p.matches(object.ID, objectCommand(object, click))
+}
+
+//NpcPredicate Matches NPCs by ID or by name.
+type NpcPredicate struct {
+	TriggerKeys
+}
+
+//Check Returns true if npc matches this predicate.
+func (p *NpcPredicate) Check(npc *NPC) bool {
+	return p.matches(npc.ID, npc.Name())
+}
+
+//NpcBlockingPredicate Matches NPCs that a player is acting upon by ID or by name.
+type NpcBlockingPredicate struct {
+	TriggerKeys
+}
+
+//Check Returns true if npc matches this predicate.
+func (p *NpcBlockingPredicate) Check(player *Player, npc *NPC) bool {
+	return p.matches(npc.ID, npc.Name())
+}
+
+//ItemPredicate Matches items by ID or by their inventory command.
+type ItemPredicate struct {
+	TriggerKeys
+}
+
+//Check Returns true if item matches this predicate.
+func (p *ItemPredicate) Check(item *Item) bool {
+	return p.matches(item.ID, item.Command())
+}
+
+//objectCommand Returns the command of the option at click on object, or an empty string if it has none.
+func objectCommand(object *Object, click int) string {
+	if click < 0 || click > 1 {
+		return ""
+	}
+	if object.Boundary {
+		if object.ID < 0 || object.ID >= len(definitions.BoundaryObjects) {
+			return ""
+		}
+		return definitions.BoundaryObjects[object.ID].Commands[click]
+	}
+	if object.ID < 0 || object.ID >= len(definitions.ScenaryObjects) {
+		return ""
+	}
+	return definitions.ScenaryObjects[object.ID].Commands[click]
+}
+
+//predicate Points check at pred.  If pred was built from static keys, e.g by objectPredicate, its keys are returned so
+// that the trigger can be indexed; otherwise pred is a script function, and is sandboxed into check.
+func predicate(pred interface{}, check interface{}) *TriggerKeys {
+	if keyed, ok := pred.(keyedPredicate); ok {
+		target := reflect.ValueOf(check).Elem()
+		method := reflect.ValueOf(pred).MethodByName("Check")
+		if method.Type() != target.Type() {
+			panic(fmt.Sprintf("can not use %T as a %v", pred, target.Type()))
+		}
+		target.Set(method)
+		return keyed.triggerKeys()
+	}
+	sandboxed(pred, check)
+	return nil
+}
+
+//keyedEvent An event about something that triggers can be indexed by.  kind tells apart things whose ids overlap, such
+// as scenery and boundaries.
+type keyedEvent interface {
+	Event
+	triggerKey() (kind string, id int, command string)
+}
+
+func (ev *ObjectActionEvent) triggerKey() (string, int, string) {
+	if ev.Object.Boundary {
+		return "boundary", ev.Object.ID, objectCommand(ev.Object, ev.Click)
+	}
+	return "object", ev.Object.ID, objectCommand(ev.Object, ev.Click)
+}
+
+func (ev *ItemActionEvent) triggerKey() (string, int, string) {
+	return "", ev.Item.ID, ev.Item.Command()
+}
+
+func (ev *InvOnPlayerEvent) triggerKey() (string, int, string) {
+	return "", ev.Item.ID, ev.Item.Command()
+}
+
+func (ev *TalkToNpcEvent) triggerKey() (string, int, string) {
+	return "", ev.Npc.ID, ev.Npc.Name()
+}
+
+func (ev *NpcAttackEvent) triggerKey() (string, int, string) {
+	return "", ev.Npc.ID, ev.Npc.Name()
+}
+
+func (ev *NpcKilledEvent) triggerKey() (string, int, string) {
+	return "", ev.Npc.ID, ev.Npc.Name()
+}
+
+//handlerKeys What an event handler is indexed by.  Exclusive handlers are those that stop their event once they have
+// handled it, so that two of them sharing a key is a conflict.
+type handlerKeys struct {
+	kind string
+	*TriggerKeys
+	exclusive bool
+}
+
+//indexKey A single entry in a trigger index: an id, or a command if id is -1.
+type indexKey struct {
+	kind    string
+	id      int
+	command string
+}
+
+func (k indexKey) String() string {
+	name := k.kind
+	if name == "" {
+		name = "id"
+	}
+	if k.id == -1 {
+		return "command '" + k.command + "'"
+	}
+	return name + " " + strconv.Itoa(k.id)
+}
+
+//triggerIndex The handlers for one event type, split into those that are found by key, and those that must be asked.
+type triggerIndex struct {
+	keyed map[indexKey][]eventHandler
+	scan  []eventHandler
+}
+
+//newTriggerIndex Indexes handlers, which must be in the order they are to run in.
+func newTriggerIndex(handlers []eventHandler) *triggerIndex {
+	idx := &triggerIndex{keyed: make(map[indexKey][]eventHandler)}
+	for _, h := range handlers {
+		if h.keys == nil {
+			idx.scan = append(idx.scan, h)
+			continue
+		}
+		for _, id := range h.keys.IDs {
+			key := indexKey{h.keys.kind, id, ""}
+			idx.keyed[key] = append(idx.keyed[key], h)
+		}
+		for _, cmd := range h.keys.Commands {
+			key := indexKey{h.keys.kind, -1, cmd}
+			idx.keyed[key] = append(idx.keyed[key], h)
+		}
+	}
+	return idx
+}
+
+//candidates Returns the handlers that may want ev, in the order they are to run in.
+func (idx *triggerIndex) candidates(ev keyedEvent) []eventHandler {
+	kind, id, command := ev.triggerKey()
+	byID := idx.keyed[indexKey{kind, id, ""}]
+	var byCommand []eventHandler
+	if command != "" {
+		byCommand = idx.keyed[indexKey{kind, -1, command}]
+	}
+	if len(byID)+len(byCommand) == 0 {
+		return idx.scan
+	}
+	if len(byCommand)+len(idx.scan) == 0 {
+		return byID
+	}
+	handlers := make([]eventHandler, 0, len(byID)+len(byCommand)+len(idx.scan))
+	handlers = append(handlers, byID...)
+	handlers = append(handlers, byCommand...)
+	handlers = append(handlers, idx.scan...)
+	sort.Slice(handlers, func(i, j int) bool {
+		return handlers[i].before(handlers[j])
+	})
+	// A handler keyed by both the id and the command would otherwise run twice.
+	unique := handlers[:1]
+	for _, h := range handlers[1:] {
+		if h.order != unique[len(unique)-1].order {
+			unique = append(unique, h)
+		}
+	}
+	return unique
+}
+
+//conflicts Describes every key that more than one exclusive handler of the same priority is indexed by.  Only the
+// first of them would ever run for that key.
+func (idx *triggerIndex) conflicts(event string) (found []string) {
+	for key, handlers := range idx.keyed {
+		for i := 1; i < len(handlers); i++ {
+			prev, h := handlers[i-1], handlers[i]
+			if prev.keys.exclusive && h.keys.exclusive && prev.priority == h.priority {
+				found = append(found, fmt.Sprintf("%s: %v is handled in %s, so the handler in %s never runs for it",
+					event, key, prev.origin, h.origin))
+			}
+		}
+	}
+	sort.Strings(found)
+	return
+}
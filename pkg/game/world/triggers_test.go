@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spkaeros/rscgo/pkg/definitions"
+)
+
+func TestIndexedTriggers(t *testing.T) {
+	defer func(old []definitions.ScenaryDefinition) {
+		definitions.ScenaryObjects = old
+	}(definitions.ScenaryObjects)
+	definitions.ScenaryObjects = make([]definitions.ScenaryDefinition, 10)
+	definitions.ScenaryObjects[7].Commands = [2]string{"search", "examine"}
+
+	set, err := buildScripts([]scriptFile{{"objects.ank", `
+bind.object(objectPredicate(1, 2), func(player, object, click) { player.SetVar("ran", "ids") })
+bind.object(func(object, click) { return object.ID == 2 || object.ID == 3 }, func(player, object, click) { player.SetVar("ran", "predicate") })
+bind.object(objectPredicate("search"), func(player, object, click) { player.SetVar("ran", "command") })
+bind.boundary(objectPredicate(1), func(player, object, click) { player.SetVar("ran", "boundary") })
+`}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conflicts := set.Conflicts(); len(conflicts) != 0 {
+		t.Errorf("objects and boundaries sharing an ID were reported as conflicting: %v", conflicts)
+	}
+	for _, test := range []struct {
+		object *Object
+		click  int
+		ran    string
+	}{
+		{NewObject(1, 0, 0, 0, false), 0, "ids"},
+		{NewObject(2, 0, 0, 0, false), 0, "ids"},
+		{NewObject(3, 0, 0, 0, false), 0, "predicate"},
+		{NewObject(7, 0, 0, 0, false), 0, "command"},
+		{NewObject(7, 0, 0, 0, false), 1, ""},
+		{NewObject(1, 0, 0, 0, true), 0, "boundary"},
+	} {
+		p := NewPlayer(nil)
+		handled := set.Events.Emit(&ObjectActionEvent{Player: p, Object: test.object, Click: test.click})
+		if ran := p.VarString("ran", ""); ran != test.ran || handled != (test.ran != "") {
+			t.Errorf("object %d click %d (boundary: %v) ran %q, wanted %q", test.object.ID, test.click, test.object.Boundary, ran, test.ran)
+		}
+	}
+}
+
+func TestTriggerConflicts(t *testing.T) {
+	set, err := buildScripts([]scriptFile{
+		{"a.ank", `bind.npc(npcPredicate(5, 6), func(player, npc) {})
+bind.npcKilled(npcBlockingPredicate(5), func(player, npc) {})
+bind.command("hi", func(player, args) {})`},
+		{"b.ank", `bind.npc(npcPredicate(6), func(player, npc) {})
+bind.npcKilled(npcBlockingPredicate(5), func(player, npc) {})
+bind.command("hi", func(player, args) {})`},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	conflicts := set.Conflicts()
+	if len(conflicts) != 2 {
+		t.Fatalf("wanted a conflict for NPC 6 and one for the command, got %v", conflicts)
+	}
+	joined := strings.Join(conflicts, "\n")
+	if !strings.Contains(joined, "talkToNpc: id 6 is handled in a.ank, so the handler in b.ank never runs for it") ||
+		!strings.Contains(joined, "command 'hi'") {
+		t.Errorf("conflicts do not say what clashed where: %v", conflicts)
+	}
+}
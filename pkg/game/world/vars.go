@@ -0,0 +1,505 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+package world
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spkaeros/rscgo/pkg/log"
+	"github.com/spkaeros/rscgo/pkg/tasks"
+)
+
+// Persistent variables.
+//
+// Scripts keep state that must outlive a login, such as minigame scores or world event timers, in persistent variables.
+// Variables live in one of three scopes: player variables belong to a single character, account variables are keyed
+// by username so they can be read and written whether or not the account is logged in, and world variables are shared
+// by the whole server.  A variable holds a bool, number, string, or a list or string keyed map of those, and may be
+// given a time to live, after which it reads as unset.
+//
+// Every variable in a scope is read from the variable service the first time that scope is used, and kept in memory
+// until the player it belongs to logs out; world variables are read while the server starts, and kept for good.
+// Changes are made in memory straight away, and written out to the variable service in batches from
+// their own goroutine, so that setting a variable never blocks the game engine on database I/O.
+
+const (
+	VarPlayer  = "player"
+	VarAccount = "account"
+	VarWorld   = "world"
+)
+
+// varsFlush is how often changed variables are written to the variable service.
+const varsFlush = time.Second
+
+//VarRecord A persistent variable as it is stored by the variable service.  Value holds the JSON encoded value, and is
+// empty when the variable has been deleted.  A zero Expires never expires.
+type VarRecord struct {
+	Scope, Owner, Name string
+	Value              string
+	Expires            time.Time
+}
+
+//VarService An interface for storing persistent variables.
+type VarService interface {
+	//VarsLoad returns every variable stored under the provided scope and owner.
+	VarsLoad(scope, owner string) []VarRecord
+	//VarsSave writes the provided variables, deleting those with an empty value.
+	VarsSave(records []VarRecord)
+}
+
+//DefaultVarService the service that persistent variables are stored in.  If nil, variables only last until the server
+// is restarted.
+var DefaultVarService VarService
+
+//Var A persistent variable.
+type Var struct {
+	Value   interface{}
+	Expires time.Time
+}
+
+//expired returns true if this variable has outlived its time to live.
+func (v *Var) expired() bool {
+	return !v.Expires.IsZero() && !time.Now().Before(v.Expires)
+}
+
+//VarStore The persistent variables of a single scope and owner.
+type VarStore struct {
+	scope, owner string
+	values       map[string]*Var
+	sync.RWMutex
+}
+
+var (
+	varStores     = make(map[string]*VarStore)
+	varStoresLock sync.Mutex
+	varsPending   = make(map[string]VarRecord)
+	varsLock      sync.Mutex
+	// varsFlushLock keeps flushes in order, so that an older value is never written over a newer one.
+	varsFlushLock sync.Mutex
+	varsStart     sync.Once
+)
+
+//vars returns the variables of the provided scope and owner, loading them from the variable service if they have not
+// been used before.
+func vars(scope, owner string) *VarStore {
+	key := scope + ":" + owner
+	varStoresLock.Lock()
+	defer varStoresLock.Unlock()
+	if s, ok := varStores[key]; ok {
+		return s
+	}
+	s := &VarStore{scope: scope, owner: owner, values: make(map[string]*Var)}
+	if DefaultVarService != nil {
+		for _, r := range DefaultVarService.VarsLoad(scope, owner) {
+			v, err := decodeVar(r.Value)
+			if err != nil {
+				log.Warn("Could not decode "+scope+" variable '"+r.Name+"' of '"+owner+"':", err)
+				continue
+			}
+			if variable := (&Var{Value: v, Expires: r.Expires}); !variable.expired() {
+				s.values[r.Name] = variable
+			}
+		}
+	}
+	// changes that have not been written out yet are newer than anything the service holds, e.g when a player logs
+	// back in before the variables they changed before logging out are flushed
+	varsLock.Lock()
+	for _, r := range varsPending {
+		if r.Scope != scope || r.Owner != owner {
+			continue
+		}
+		if len(r.Value) == 0 {
+			delete(s.values, r.Name)
+			continue
+		}
+		if v, err := decodeVar(r.Value); err == nil {
+			s.values[r.Name] = &Var{Value: v, Expires: r.Expires}
+		}
+	}
+	varsLock.Unlock()
+	varStores[key] = s
+	return s
+}
+
+//PlayerVars returns the persistent variables of the provided player.
+func PlayerVars(p *Player) *VarStore {
+	return vars(VarPlayer, strconv.Itoa(p.DatabaseIndex))
+}
+
+//AccountVars returns the persistent variables of the account with the provided username.
+func AccountVars(username string) *VarStore {
+	return vars(VarAccount, strings.ToLower(strings.TrimSpace(username)))
+}
+
+//WorldVars returns the persistent variables shared by the whole world.
+func WorldVars() *VarStore {
+	return vars(VarWorld, "")
+}
+
+//LoadVars reads the player and account variables of the provided player, so that they are in memory before the player
+// is in the world.  This talks to the database, so it should be called while logging in rather than from the engine.
+func LoadVars(p *Player) {
+	PlayerVars(p)
+	AccountVars(p.Username())
+}
+
+//UnloadVars writes out the player and account variables of the provided player, and lets go of them.  They are read
+// back in from the variable service the next time they are used.  This talks to the database, so it should be called
+// while logging out rather than from the engine.
+func UnloadVars(p *Player) {
+	varStoresLock.Lock()
+	delete(varStores, VarPlayer+":"+strconv.Itoa(p.DatabaseIndex))
+	delete(varStores, VarAccount+":"+strings.ToLower(strings.TrimSpace(p.Username())))
+	varStoresLock.Unlock()
+	FlushVars()
+}
+
+//LoadWorldVars reads the world variables, so that the game engine never has to wait on the database for them.  This
+// should be called while the server starts, once the variable service is set up.
+func LoadWorldVars() {
+	WorldVars()
+}
+
+//FlushVars writes every changed variable to the variable service straight away.
+func FlushVars() {
+	varsFlushLock.Lock()
+	defer varsFlushLock.Unlock()
+	varsLock.Lock()
+	pending := varsPending
+	varsPending = make(map[string]VarRecord)
+	varsLock.Unlock()
+	if len(pending) == 0 || DefaultVarService == nil {
+		return
+	}
+	records := make([]VarRecord, 0, len(pending))
+	for _, r := range pending {
+		records = append(records, r)
+	}
+	DefaultVarService.VarsSave(records)
+}
+
+//varsWriter flushes changed variables to the variable service every varsFlush.
+func varsWriter() {
+	ticker := time.NewTicker(varsFlush)
+	defer ticker.Stop()
+	for range ticker.C {
+		FlushVars()
+	}
+}
+
+//changed queues the named variable to be written out.  A nil variable is deleted.
+func (s *VarStore) changed(name string, v *Var) {
+	varsStart.Do(func() {
+		go varsWriter()
+	})
+	r := VarRecord{Scope: s.scope, Owner: s.owner, Name: name}
+	if v != nil {
+		value, err := json.Marshal(v.Value)
+		if err != nil {
+			log.Warn("Could not encode "+s.scope+" variable '"+name+"' of '"+s.owner+"':", err)
+			return
+		}
+		r.Value, r.Expires = string(value), v.Expires
+	}
+	varsLock.Lock()
+	varsPending[s.scope+":"+s.owner+":"+name] = r
+	varsLock.Unlock()
+}
+
+//lookup returns the named variable, or nil if it is unset or has expired.  Expired variables are deleted.
+func (s *VarStore) lookup(name string) *Var {
+	s.RLock()
+	v, ok := s.values[name]
+	s.RUnlock()
+	if !ok {
+		return nil
+	}
+	if v.expired() {
+		s.Lock()
+		if s.values[name] == v {
+			delete(s.values, name)
+			s.changed(name, nil)
+		}
+		s.Unlock()
+		return nil
+	}
+	return v
+}
+
+//Has returns true if the named variable is set.
+func (s *VarStore) Has(name string) bool {
+	return s.lookup(name) != nil
+}
+
+//Get returns the value of the named variable, or def if it is unset.
+func (s *VarStore) Get(name string, def interface{}) interface{} {
+	if v := s.lookup(name); v != nil {
+		return v.Value
+	}
+	return def
+}
+
+//Int returns the named variable as an int, or def if it is unset or not a number.
+func (s *VarStore) Int(name string, def int) int {
+	switch v := s.Get(name, def).(type) {
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	case int:
+		return v
+	}
+	return def
+}
+
+//Float returns the named variable as a float64, or def if it is unset or not a number.
+func (s *VarStore) Float(name string, def float64) float64 {
+	switch v := s.Get(name, def).(type) {
+	case int64:
+		return float64(v)
+	case float64:
+		return v
+	}
+	return def
+}
+
+//Bool returns the named variable as a bool, or def if it is unset or not a bool.
+func (s *VarStore) Bool(name string, def bool) bool {
+	if v, ok := s.Get(name, def).(bool); ok {
+		return v
+	}
+	return def
+}
+
+//String returns the named variable as a string, or def if it is unset or not a string.
+func (s *VarStore) String(name string, def string) string {
+	if v, ok := s.Get(name, def).(string); ok {
+		return v
+	}
+	return def
+}
+
+//List returns a copy of the named variable as a list, or an empty list if it is unset or not a list.
+func (s *VarStore) List(name string) []interface{} {
+	if v, ok := s.Get(name, nil).([]interface{}); ok {
+		return copyVar(v).([]interface{})
+	}
+	return []interface{}{}
+}
+
+//Map returns a copy of the named variable as a map, or an empty map if it is unset or not a map.
+func (s *VarStore) Map(name string) map[string]interface{} {
+	if v, ok := s.Get(name, nil).(map[string]interface{}); ok {
+		return copyVar(v).(map[string]interface{})
+	}
+	return map[string]interface{}{}
+}
+
+//Names returns the sorted names of every variable that is set.
+func (s *VarStore) Names() (names []string) {
+	s.RLock()
+	for name, v := range s.values {
+		if !v.expired() {
+			names = append(names, name)
+		}
+	}
+	s.RUnlock()
+	sort.Strings(names)
+	return
+}
+
+//Set sets the named variable to value, with no time to live.  Setting nil unsets it.
+func (s *VarStore) Set(name string, value interface{}) error {
+	return s.SetFor(name, value, 0)
+}
+
+//SetFor sets the named variable to value until ttl has passed.  A ttl of zero or less never expires, and setting nil
+// unsets it.
+func (s *VarStore) SetFor(name string, value interface{}, ttl time.Duration) error {
+	if value == nil {
+		s.Unset(name)
+		return nil
+	}
+	value, err := normalizeVar(value)
+	if err != nil {
+		return fmt.Errorf("%s variable '%s': %v", s.scope, name, err)
+	}
+	v := &Var{Value: value}
+	if ttl > 0 {
+		v.Expires = time.Now().Add(ttl)
+	}
+	s.Lock()
+	s.values[name] = v
+	s.changed(name, v)
+	s.Unlock()
+	return nil
+}
+
+//Add adds delta to the named number variable, treating it as zero if it is unset, and returns the result.  Any time
+// to live it had is kept.
+func (s *VarStore) Add(name string, delta int) int {
+	s.Lock()
+	defer s.Unlock()
+	v, ok := s.values[name]
+	if !ok || v.expired() {
+		v = &Var{Value: int64(0)}
+	}
+	next := &Var{Value: int64(delta), Expires: v.Expires}
+	switch value := v.Value.(type) {
+	case int64:
+		next.Value = value + int64(delta)
+	case float64:
+		next.Value = int64(value) + int64(delta)
+	}
+	s.values[name] = next
+	s.changed(name, next)
+	return int(next.Value.(int64))
+}
+
+//Unset deletes the named variable.
+func (s *VarStore) Unset(name string) {
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.values[name]; ok {
+		delete(s.values, name)
+		s.changed(name, nil)
+	}
+}
+
+//cronVars Remembers when scheduled jobs last ran in world variables, so that restarting the server does not run them
+// twice.
+type cronVars struct{}
+
+func (cronVars) CronLastRun(name string) time.Time {
+	if ran, ok := WorldVars().Get("cron:"+name, nil).(int64); ok {
+		return time.Unix(0, ran)
+	}
+	return time.Time{}
+}
+
+func (cronVars) CronRan(name string, t time.Time) {
+	WorldVars().Set("cron:"+name, t.UnixNano())
+}
+
+func init() {
+	tasks.Crontab.Store = cronVars{}
+}
+
+//normalizeVar returns value converted into the types that variables are stored as: bool, int64, float64, string,
+// []interface{} and map[string]interface{}.
+func normalizeVar(value interface{}) (interface{}, error) {
+	rv := reflect.ValueOf(value)
+	for rv.IsValid() && (rv.Kind() == reflect.Interface || rv.Kind() == reflect.Ptr) {
+		if rv.IsNil() {
+			return nil, errors.New("can not store nil inside of a list or map")
+		}
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Bool:
+		return rv.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Slice, reflect.Array:
+		list := make([]interface{}, rv.Len())
+		for i := range list {
+			v, err := normalizeVar(rv.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			list[i] = v
+		}
+		return list, nil
+	case reflect.Map:
+		m := make(map[string]interface{}, rv.Len())
+		for _, k := range rv.MapKeys() {
+			key, ok := k.Interface().(string)
+			if !ok {
+				return nil, fmt.Errorf("map keys must be strings, not %T", k.Interface())
+			}
+			v, err := normalizeVar(rv.MapIndex(k).Interface())
+			if err != nil {
+				return nil, err
+			}
+			m[key] = v
+		}
+		return m, nil
+	}
+	if !rv.IsValid() {
+		return nil, errors.New("can not store nil inside of a list or map")
+	}
+	return nil, fmt.Errorf("can not store a %v", rv.Type())
+}
+
+//decodeVar returns the variable value encoded in the provided JSON.  Whole numbers are decoded as int64, and other
+// numbers as float64.
+func decodeVar(s string) (interface{}, error) {
+	d := json.NewDecoder(strings.NewReader(s))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	return numbers(v), nil
+}
+
+//numbers replaces the json.Numbers within v with int64 or float64 values.
+func numbers(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case []interface{}:
+		for i := range v {
+			v[i] = numbers(v[i])
+		}
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = numbers(v[k])
+		}
+	}
+	return v
+}
+
+//copyVar returns a deep copy of a list or map variable, so that changing it does not change the stored variable.
+func copyVar(v interface{}) interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i := range v {
+			list[i] = copyVar(v[i])
+		}
+		return list
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k := range v {
+			m[k] = copyVar(v[k])
+		}
+		return m
+	}
+	return v
+}
@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package world
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+//memoryVars A VarService that stores variables in memory, for testing.
+type memoryVars struct {
+	sync.Mutex
+	records map[string]VarRecord
+}
+
+func (m *memoryVars) VarsLoad(scope, owner string) (records []VarRecord) {
+	m.Lock()
+	defer m.Unlock()
+	for _, r := range m.records {
+		if r.Scope == scope && r.Owner == owner {
+			records = append(records, r)
+		}
+	}
+	return
+}
+
+func (m *memoryVars) VarsSave(records []VarRecord) {
+	m.Lock()
+	defer m.Unlock()
+	for _, r := range records {
+		key := r.Scope + ":" + r.Owner + ":" + r.Name
+		if len(r.Value) == 0 {
+			delete(m.records, key)
+			continue
+		}
+		m.records[key] = r
+	}
+}
+
+//restartVars writes out every changed variable and forgets the ones in memory, as though the server had restarted.
+func restartVars() {
+	FlushVars()
+	varStoresLock.Lock()
+	varStores = make(map[string]*VarStore)
+	varStoresLock.Unlock()
+}
+
+func useMemoryVars(t *testing.T) *memoryVars {
+	service := &memoryVars{records: make(map[string]VarRecord)}
+	restartVars()
+	DefaultVarService = service
+	t.Cleanup(func() {
+		restartVars()
+		DefaultVarService = nil
+	})
+	return service
+}
+
+func TestVarsPersist(t *testing.T) {
+	service := useMemoryVars(t)
+	p := newMailTester("vars tester")
+	p.DatabaseIndex = 7
+	PlayerVars(p).Set("kills", 12)
+	PlayerVars(p).Set("ratio", 0.5)
+	AccountVars("Vars Tester").Set("donor", true)
+	WorldVars().Set("event", "drop party")
+	WorldVars().Set("winners", []string{"bob", "alice"})
+	WorldVars().Set("scores", map[interface{}]interface{}{"bob": 3, "alice": []interface{}{1, 2}})
+	WorldVars().Set("gone", 1)
+	WorldVars().Unset("gone")
+	restartVars()
+	if len(service.records) != 6 {
+		t.Errorf("stored %d variables, want 6", len(service.records))
+	}
+
+	if v := PlayerVars(p).Int("kills", 0); v != 12 {
+		t.Errorf("kills = %d, want 12", v)
+	}
+	if v := PlayerVars(p).Float("ratio", 0); v != 0.5 {
+		t.Errorf("ratio = %v, want 0.5", v)
+	}
+	if v := PlayerVars(p).String("kills", "none"); v != "none" {
+		t.Errorf("kills read as a string = %q, want the default", v)
+	}
+	if !AccountVars(p.Username()).Bool("donor", false) {
+		t.Error("account variable was not kept under the username")
+	}
+	if v := WorldVars().String("event", ""); v != "drop party" {
+		t.Errorf("event = %q, want drop party", v)
+	}
+	if v, want := WorldVars().List("winners"), []interface{}{"bob", "alice"}; !reflect.DeepEqual(v, want) {
+		t.Errorf("winners = %v, want %v", v, want)
+	}
+	if v, want := WorldVars().Map("scores"), map[string]interface{}{"bob": int64(3), "alice": []interface{}{int64(1), int64(2)}}; !reflect.DeepEqual(v, want) {
+		t.Errorf("scores = %v, want %v", v, want)
+	}
+	if WorldVars().Has("gone") || WorldVars().Get("gone", "default") != "default" {
+		t.Error("unset variable was persisted")
+	}
+	if err := WorldVars().Set("bad", map[int]int{1: 1}); err == nil {
+		t.Error("stored a map without string keys")
+	}
+}
+
+func TestVarsUnload(t *testing.T) {
+	service := useMemoryVars(t)
+	p := newMailTester("unload tester")
+	p.DatabaseIndex = 8
+	PlayerVars(p).Set("kills", 3)
+	AccountVars(p.Username()).Set("donor", true)
+	UnloadVars(p)
+	varStoresLock.Lock()
+	loaded := len(varStores)
+	varStoresLock.Unlock()
+	if loaded != 0 {
+		t.Errorf("%d variable stores are still loaded after logging out, want 0", loaded)
+	}
+	if len(service.records) != 2 {
+		t.Errorf("stored %d variables when logging out, want 2", len(service.records))
+	}
+
+	// a change that is still waiting to be written out must win over what the service holds
+	PlayerVars(p).Set("kills", 4)
+	UnloadVars(p)
+	PlayerVars(p).Set("kills", 5)
+	varStoresLock.Lock()
+	delete(varStores, VarPlayer+":8")
+	varStoresLock.Unlock()
+	if v := PlayerVars(p).Int("kills", 0); v != 5 {
+		t.Errorf("kills = %d after loading it back in before it was written out, want 5", v)
+	}
+	if !AccountVars(p.Username()).Bool("donor", false) {
+		t.Error("account variable was lost when it was unloaded")
+	}
+}
+
+func TestVarsExpire(t *testing.T) {
+	service := useMemoryVars(t)
+	WorldVars().SetFor("bonus", 2, 50*time.Millisecond)
+	if WorldVars().Add("bonus", 3) != 5 || WorldVars().Int("bonus", 0) != 5 {
+		t.Error("bonus was not added to")
+	}
+	time.Sleep(50 * time.Millisecond)
+	if WorldVars().Has("bonus") || WorldVars().Int("bonus", -1) != -1 {
+		t.Error("bonus did not expire")
+	}
+	FlushVars()
+	if len(service.records) != 0 {
+		t.Error("expired variable was not deleted:", service.records)
+	}
+}
+
+func TestScriptVars(t *testing.T) {
+	useMemoryVars(t)
+	_, err := Sandbox.Execute(ScriptEnv(), "vars.ank", `vars = import("vars")
+time = import("time")
+vars.world().SetFor("event", {"boss": "king black dragon", "hp": 240}, time.Minute)
+vars.world().Set("rounds", [1, 2, 3])
+vars.world().Add("entrants", 1)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := WorldVars().Map("event")["hp"]; v != int64(240) {
+		t.Errorf("event hp = %v, want 240", v)
+	}
+	if v := WorldVars().List("rounds"); len(v) != 3 {
+		t.Errorf("rounds = %v, want 3 rounds", v)
+	}
+	if v := WorldVars().Int("entrants", 0); v != 1 {
+		t.Errorf("entrants = %d, want 1", v)
+	}
+}
@@ -142,6 +142,19 @@ func (m *PlayerList) Range(action func(*Player)) {
 	}
 }
 
+//List Returns a slice containing every active client in the collection, ordered by server index.
+// Unlike Range, the collection is not locked while the caller works with the result.
+func (m *PlayerList) List() (players []*Player) {
+	m.RLock()
+	defer m.RUnlock()
+	for _, p := range m.players {
+		if p != nil {
+			players = append(players, p)
+		}
+	}
+	return
+}
+
 //Size Returns the size of the active client collection.
 func (m *PlayerList) Size() int {
 	m.RLock()
@@ -176,10 +189,10 @@ func (m *PlayerList) AsyncRange(fn func(*Player)) {
 		}
 		if p != nil {
 			w.Add(1)
-			go func() {
+			go func(p *Player) {
 				fn(p)
 				w.Done()
-			}()
+			}(p)
 		}
 	}
 	w.Wait()
@@ -193,6 +206,12 @@ type region struct {
 	NPCs    *MobList
 	Objects *entityList
 	Items   *entityList
+	revisions [viewKinds]atomic.Uint64
+}
+
+//newRegion Returns a new empty region, identified by the tile at x,y
+func newRegion(x, y int) *region {
+	return &region{x: x, y: y, Players: &MobList{}, NPCs: &MobList{}, Objects: &entityList{}, Items: &entityList{}}
 }
 
 var regions [HorizontalPlanes][VerticalPlanes]*region
@@ -205,6 +224,7 @@ func WithinWorld(x, y int) bool {
 //AddPlayer Add a player to a region of the game world.
 func AddPlayer(p *Player) {
 	Region(p.X(), p.Y()).Players.Add(p)
+	Region(p.X(), p.Y()).touch(viewPlayers)
 	Players.Put(p)
 	Players.Range(func(player *Player) {
 		if player.FriendList.Contains(p.Username()) && (!p.FriendBlocked() || p.FriendList.Contains(player.Username())) {
@@ -223,6 +243,7 @@ func RemovePlayer(p *Player) {
 	p.SetRegionRemoved()
 	Players.Remove(p)
 	Region(p.X(), p.Y()).Players.Remove(p)
+	Region(p.X(), p.Y()).touch(viewPlayers)
 	Players.Range(func(player *Player) {
 		if player.FriendList.Contains(p.Username()) && (!p.FriendBlocked() || p.FriendList.Contains(player.Username())) {
 			player.FriendList.Set(p.Username(), false)
@@ -234,16 +255,25 @@ func RemovePlayer(p *Player) {
 //AddNpc Add a NPC to the region.
 func AddNpc(n *NPC) {
 	Region(n.X(), n.Y()).NPCs.Add(n)
+	Region(n.X(), n.Y()).touch(viewNpcs)
 }
 
 //RemoveNpc SetRegionRemoved a NPC from the region.
 func RemoveNpc(n *NPC) {
 	Region(n.X(), n.Y()).NPCs.Remove(n)
+	Region(n.X(), n.Y()).touch(viewNpcs)
+}
+
+//AddItem Add a ground item to the world, and start its timers.  Returns nil if there was no room for it.  See
+// GroundItemManager.Spawn.
+func AddItem(i *GroundItem) *GroundItem {
+	return GroundItems.Spawn(i)
 }
 
-//AddItem Add a ground item to the region.
-func AddItem(i *GroundItem) {
-	Region(i.X(), i.Y()).Items.Add(i)
+//PlaceItem Add a ground item to the world, and start its timers, going over the tile cap if there is no room for it.
+// See GroundItemManager.Place.
+func PlaceItem(i *GroundItem) *GroundItem {
+	return GroundItems.Place(i)
 }
 
 //GetItem Returns the item at x,y with the specified id.  Returns nil if it can not find the item.
@@ -263,14 +293,15 @@ func GetItem(x, y, id int) *GroundItem {
 	return nil
 }
 
-//RemoveItem SetRegionRemoved a ground item to the region.
-func RemoveItem(i *GroundItem) {
-	Region(i.X(), i.Y()).Items.Remove(i)
+//RemoveItem Remove a ground item from the world.  Returns true if it was in the world to be removed.
+func RemoveItem(i *GroundItem) bool {
+	return GroundItems.Despawn(i)
 }
 
 //AddObject Add an object to the region.
 func AddObject(o *Object) {
 	Region(o.X(), o.Y()).Objects.Add(o)
+	Region(o.X(), o.Y()).touch(viewObjects, viewBoundarys)
 	if !o.Boundary {
 		scenary := definitions.ScenaryObjects[o.ID]
 		// type 0 is used when the object causes no collisions of any sort.
@@ -368,6 +399,7 @@ func AddObject(o *Object) {
 //RemoveObject SetRegionRemoved an object from the region.
 func RemoveObject(o *Object) {
 	Region(o.X(), o.Y()).Objects.Remove(o)
+	Region(o.X(), o.Y()).touch(viewObjects, viewBoundarys)
 	if !o.Boundary {
 		scenary := definitions.ScenaryObjects[o.ID]
 		// type 0 is used when the object causes no collisions of any sort.
@@ -571,7 +603,7 @@ func get(x, y int) *region {
 	regionLock.Lock()
 	defer regionLock.Unlock()
 	if regions[x][y] == nil {
-		regions[x][y] = &region{x, y, &MobList{}, &MobList{}, &entityList{}, &entityList{}}
+		regions[x][y] = newRegion(x, y)
 	}
 	return regions[x][y]
 }
@@ -582,7 +614,7 @@ func Region(x, y int) *region {
 	regionLock.Lock()
 	defer regionLock.Unlock()
 	if regions[x/RegionSize][y/RegionSize] == nil {
-		regions[x/RegionSize][y/RegionSize] = &region{x, y, &MobList{}, &MobList{}, &entityList{}, &entityList{}}
+		regions[x/RegionSize][y/RegionSize] = newRegion(x, y)
 	}
 	return regions[x/RegionSize][y/RegionSize]}
 
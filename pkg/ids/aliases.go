@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package ids
+
+//ItemAliases Maps the short names that scripts have always used for some items to their generated constants.  These
+// are kept by hand, since the definitions have no way to name them.
+var ItemAliases = map[string]int{
+	"ADAM_AXE":            ADAMANTITE_AXE,
+	"ADAM_BAR":            ADAMANTITE_BAR,
+	"ADAM_ORE":            ADAMANTITE_ORE,
+	"ADAM_PICKAXE":        ADAMANTITE_PICKAXE,
+	"AIR_BATTLESTAFF":     BATTLESTAFF_OF_AIR,
+	"AIR_STAFF":           STAFF_OF_AIR,
+	"BLUE_MASK":           HALLOWEEN_MASK_832,
+	"BRONZE_LSWORD":       BRONZE_LONG_SWORD,
+	"BURNT_BREAD":         BURNTBREAD,
+	"DRAGON_HELMET":       DRAGON_MEDIUM_HELMET,
+	"DRAGON_SHIELD":       DRAGON_SQUARE_SHIELD,
+	"DSTONE_AMULET":       DRAGONSTONE_AMULET,
+	"DSTONE_AMULET_C":     CHARGED_DRAGONSTONE_AMULET,
+	"DSTONE_AMULET_U":     DRAGONSTONE_AMULET,
+	"E_AIR_BATTLESTAFF":   ENCHANTED_BATTLESTAFF_OF_AIR,
+	"E_EARTH_BATTLESTAFF": ENCHANTED_BATTLESTAFF_OF_EARTH,
+	"E_FIRE_BATTLESTAFF":  ENCHANTED_BATTLESTAFF_OF_FIRE,
+	"E_WATER_BATTLESTAFF": ENCHANTED_BATTLESTAFF_OF_WATER,
+	"EARTH_BATTLESTAFF":   BATTLESTAFF_OF_EARTH,
+	"EARTH_STAFF":         STAFF_OF_EARTH,
+	"FIRE_BATTLESTAFF":    BATTLESTAFF_OF_FIRE,
+	"FIRE_STAFF":          STAFF_OF_FIRE,
+	"FLYFISHING_ROD":      FLY_FISHING_ROD,
+	"GOLD2":               GOLD_690,
+	"GREEN_MASK":          HALLOWEEN_MASK,
+	"PARTYHAT_BLUE":       PARTY_HAT_578,
+	"PARTYHAT_GREEN":      PARTY_HAT_579,
+	"PARTYHAT_PINK":       PARTY_HAT_580,
+	"PARTYHAT_RED":        PARTY_HAT,
+	"PARTYHAT_WHITE":      PARTY_HAT_581,
+	"PARTYHAT_YELLOW":     PARTY_HAT_577,
+	"POT_OF_FLOUR":        FLOUR_136,
+	"RED_MASK":            HALLOWEEN_MASK_831,
+	"RUNE_2H":             RUNE_2_HANDED_SWORD,
+	"RUNE_CHAIN":          RUNE_CHAIN_MAIL_BODY,
+	"RUNE_PLATEBODY":      RUNE_PLATE_MAIL_BODY,
+	"RUNE_PLATETOP":       RUNE_PLATE_MAIL_TOP,
+	"SANTA_HAT":           SANTA_S_HAT,
+	"WATER_BATTLESTAFF":   BATTLESTAFF_OF_WATER,
+	"WATER_STAFF":         STAFF_OF_WATER,
+}
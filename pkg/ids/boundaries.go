@@ -0,0 +1,439 @@
+// Code generated by idgen from the entity database. DO NOT EDIT.
+
+package ids
+
+// The ids of every boundary definition.
+const (
+	BOUNDARY_WALL                     = 0   // Wall
+	BOUNDARY_DOORFRAME                = 1   // Doorframe
+	BOUNDARY_DOOR                     = 2   // Door
+	BOUNDARY_WINDOW                   = 3   // Window
+	BOUNDARY_FENCE                    = 4   // Fence
+	BOUNDARY_RAILINGS                 = 5   // railings
+	BOUNDARY_STAINED_GLASS_WINDOW     = 6   // Stained glass window
+	BOUNDARY_HIGHWALL                 = 7   // Highwall
+	BOUNDARY_DOOR_8                   = 8   // Door
+	BOUNDARY_DOORFRAME_9              = 9   // Doorframe
+	BOUNDARY_BATTLEMENT               = 10  // battlement
+	BOUNDARY_DOORFRAME_11             = 11  // Doorframe
+	BOUNDARY_SNOWWALL                 = 12  // snowwall
+	BOUNDARY_ARROWSLIT                = 13  // arrowslit
+	BOUNDARY_TIMBERWALL               = 14  // timberwall
+	BOUNDARY_TIMBERWINDOW             = 15  // timberwindow
+	BOUNDARY_BLANK                    = 16  // blank
+	BOUNDARY_HIGHBLANK                = 17  // highblank
+	BOUNDARY_MOSSYBRICKS              = 18  // mossybricks
+	BOUNDARY_DOOR_19                  = 19  // Door
+	BOUNDARY_DOOR_20                  = 20  // Door
+	BOUNDARY_DOOR_21                  = 21  // Door
+	BOUNDARY_ODD_LOOKING_WALL         = 22  // Odd looking wall
+	BOUNDARY_DOOR_23                  = 23  // Door
+	BOUNDARY_WEB                      = 24  // web
+	BOUNDARY_DOOR_25                  = 25  // Door
+	BOUNDARY_DOOR_26                  = 26  // Door
+	BOUNDARY_DOOR_27                  = 27  // Door
+	BOUNDARY_DOOR_28                  = 28  // Door
+	BOUNDARY_DOOR_29                  = 29  // Door
+	BOUNDARY_DOOR_30                  = 30  // Door
+	BOUNDARY_DOOR_31                  = 31  // Door
+	BOUNDARY_DOOR_32                  = 32  // Door
+	BOUNDARY_DOOR_33                  = 33  // Door
+	BOUNDARY_WINDOW_34                = 34  // Window
+	BOUNDARY_DOOR_35                  = 35  // Door
+	BOUNDARY_DOOR_36                  = 36  // Door
+	BOUNDARY_DOOR_37                  = 37  // Door
+	BOUNDARY_DOOR_38                  = 38  // Door
+	BOUNDARY_DOOR_39                  = 39  // Door
+	BOUNDARY_DOOR_40                  = 40  // Door
+	BOUNDARY_CRUMBLED                 = 41  // Crumbled
+	BOUNDARY_CAVERN                   = 42  // Cavern
+	BOUNDARY_DOOR_43                  = 43  // Door
+	BOUNDARY_DOOR_44                  = 44  // Door
+	BOUNDARY_DOOR_45                  = 45  // Door
+	BOUNDARY_CAVERN2                  = 46  // cavern2
+	BOUNDARY_DOOR_47                  = 47  // Door
+	BOUNDARY_DOOR_48                  = 48  // Door
+	BOUNDARY_DOOR_49                  = 49  // Door
+	BOUNDARY_DOOR_50                  = 50  // Door
+	BOUNDARY_DOOR_51                  = 51  // Door
+	BOUNDARY_DOOR_52                  = 52  // Door
+	BOUNDARY_DOOR_53                  = 53  // Door
+	BOUNDARY_DOOR_54                  = 54  // Door
+	BOUNDARY_DOOR_55                  = 55  // Door
+	BOUNDARY_WALL_56                  = 56  // Wall
+	BOUNDARY_DOOR_57                  = 57  // Door
+	BOUNDARY_STRANGE_LOOKING_WALL     = 58  // Strange looking wall
+	BOUNDARY_DOOR_59                  = 59  // Door
+	BOUNDARY_DOOR_60                  = 60  // Door
+	BOUNDARY_DOOR_61                  = 61  // Door
+	BOUNDARY_MEMBERRAILINGS           = 62  // memberrailings
+	BOUNDARY_DOOR_63                  = 63  // Door
+	BOUNDARY_DOOR_64                  = 64  // Door
+	BOUNDARY_MAGIC_DOOR               = 65  // Magic Door
+	BOUNDARY_DOOR_66                  = 66  // Door
+	BOUNDARY_DOOR_67                  = 67  // Door
+	BOUNDARY_DOOR_68                  = 68  // Door
+	BOUNDARY_DOOR_69                  = 69  // Door
+	BOUNDARY_DOOR_70                  = 70  // Door
+	BOUNDARY_DOOR_71                  = 71  // Door
+	BOUNDARY_DOOR_72                  = 72  // Door
+	BOUNDARY_DOOR_73                  = 73  // Door
+	BOUNDARY_DOOR_74                  = 74  // Door
+	BOUNDARY_DOOR_75                  = 75  // Door
+	BOUNDARY_DOOR_76                  = 76  // Door
+	BOUNDARY_DOOR_77                  = 77  // Door
+	BOUNDARY_DOOR_78                  = 78  // Door
+	BOUNDARY_STRANGE_PANEL            = 79  // Strange Panel
+	BOUNDARY_DOOR_80                  = 80  // Door
+	BOUNDARY_DOOR_81                  = 81  // Door
+	BOUNDARY_DOOR_82                  = 82  // Door
+	BOUNDARY_DOOR_83                  = 83  // Door
+	BOUNDARY_DOOR_84                  = 84  // Door
+	BOUNDARY_DOOR_85                  = 85  // Door
+	BOUNDARY_BLOCKBLANK               = 86  // blockblank
+	BOUNDARY_UNUSUAL_LOOKING_WALL     = 87  // unusual looking wall
+	BOUNDARY_DOOR_88                  = 88  // Door
+	BOUNDARY_DOOR_89                  = 89  // Door
+	BOUNDARY_DOOR_90                  = 90  // Door
+	BOUNDARY_DOOR_91                  = 91  // Door
+	BOUNDARY_DOOR_92                  = 92  // Door
+	BOUNDARY_DOOR_93                  = 93  // Door
+	BOUNDARY_DOOR_94                  = 94  // Door
+	BOUNDARY_DOOR_95                  = 95  // Door
+	BOUNDARY_DOOR_96                  = 96  // Door
+	BOUNDARY_DOOR_97                  = 97  // Door
+	BOUNDARY_DOOR_98                  = 98  // Door
+	BOUNDARY_DOOR_99                  = 99  // Door
+	BOUNDARY_DOOR_100                 = 100 // Door
+	BOUNDARY_FENCE_WITH_LOOSE_PANNELS = 101 // Fence with loose pannels
+	BOUNDARY_DOOR_102                 = 102 // Door
+	BOUNDARY_DOOR_103                 = 103 // Door
+	BOUNDARY_DOOR_104                 = 104 // Door
+	BOUNDARY_DOOR_105                 = 105 // Door
+	BOUNDARY_DOOR_106                 = 106 // Door
+	BOUNDARY_DOOR_107                 = 107 // Door
+	BOUNDARY_DOOR_108                 = 108 // Door
+	BOUNDARY_DOOR_109                 = 109 // Door
+	BOUNDARY_DOOR_110                 = 110 // Door
+	BOUNDARY_RAT_CAGE                 = 111 // rat cage
+	BOUNDARY_DOOR_112                 = 112 // Door
+	BOUNDARY_DOOR_113                 = 113 // Door
+	BOUNDARY_DOOR_114                 = 114 // Door
+	BOUNDARY_DOOR_115                 = 115 // Door
+	BOUNDARY_DOOR_116                 = 116 // Door
+	BOUNDARY_DOOR_117                 = 117 // Door
+	BOUNDARY_ARROWSLIT_118            = 118 // arrowslit
+	BOUNDARY_SOLIDBLANK               = 119 // solidblank
+	BOUNDARY_DOOR_120                 = 120 // Door
+	BOUNDARY_DOOR_121                 = 121 // Door
+	BOUNDARY_DOOR_122                 = 122 // Door
+	BOUNDARY_DOOR_123                 = 123 // Door
+	BOUNDARY_LOOSE_PANEL              = 124 // loose panel
+	BOUNDARY_DOOR_125                 = 125 // Door
+	BOUNDARY_PLANKSWINDOW             = 126 // plankswindow
+	BOUNDARY_LOW_FENCE                = 127 // Low Fence
+	BOUNDARY_DOOR_128                 = 128 // Door
+	BOUNDARY_DOOR_129                 = 129 // Door
+	BOUNDARY_DOOR_130                 = 130 // Door
+	BOUNDARY_DOOR_131                 = 131 // Door
+	BOUNDARY_DOOR_132                 = 132 // Door
+	BOUNDARY_DOOR_133                 = 133 // Door
+	BOUNDARY_DOOR_134                 = 134 // Door
+	BOUNDARY_DOOR_135                 = 135 // Door
+	BOUNDARY_DOOR_136                 = 136 // Door
+	BOUNDARY_COOKING_POT              = 137 // Cooking pot
+	BOUNDARY_DOOR_138                 = 138 // Door
+	BOUNDARY_DOOR_139                 = 139 // Door
+	BOUNDARY_DOOR_140                 = 140 // Door
+	BOUNDARY_DOOR_141                 = 141 // Door
+	BOUNDARY_DOOR_142                 = 142 // Door
+	BOUNDARY_DOOR_143                 = 143 // Door
+	BOUNDARY_PLANKSTIMBER             = 144 // plankstimber
+	BOUNDARY_DOOR_145                 = 145 // Door
+	BOUNDARY_DOOR_146                 = 146 // Door
+	BOUNDARY_MAGIC_PORTAL             = 147 // magic portal
+	BOUNDARY_MAGIC_PORTAL_148         = 148 // magic portal
+	BOUNDARY_MAGIC_PORTAL_149         = 149 // magic portal
+	BOUNDARY_DOOR_150                 = 150 // Door
+	BOUNDARY_CAVERN_WALL              = 151 // Cavern wall
+	BOUNDARY_DOOR_152                 = 152 // Door
+	BOUNDARY_DOOR_153                 = 153 // Door
+	BOUNDARY_DOOR_154                 = 154 // Door
+	BOUNDARY_DOOR_155                 = 155 // Door
+	BOUNDARY_DOOR_156                 = 156 // Door
+	BOUNDARY_DOOR_157                 = 157 // Door
+	BOUNDARY_DOOR_158                 = 158 // Door
+	BOUNDARY_DOOR_159                 = 159 // Door
+	BOUNDARY_DOOR_160                 = 160 // Door
+	BOUNDARY_DOOR_161                 = 161 // Door
+	BOUNDARY_DOOR_162                 = 162 // Door
+	BOUNDARY_LOW_WALL                 = 163 // Low wall
+	BOUNDARY_LOW_WALL_164             = 164 // Low wall
+	BOUNDARY_BLACKSMITHS_DOOR         = 165 // Blacksmiths Door
+	BOUNDARY_RAILINGS_166             = 166 // railings
+	BOUNDARY_RAILINGS_167             = 167 // railings
+	BOUNDARY_RAILINGS_168             = 168 // railings
+	BOUNDARY_RAILINGS_169             = 169 // railings
+	BOUNDARY_RAILINGS_170             = 170 // railings
+	BOUNDARY_RAILINGS_171             = 171 // railings
+	BOUNDARY_RAILINGS_172             = 172 // railings
+	BOUNDARY_DOOR_173                 = 173 // Door
+	BOUNDARY_DOORFRAME_174            = 174 // Doorframe
+	BOUNDARY_TENT                     = 175 // Tent
+	BOUNDARY_JAIL_DOOR                = 176 // Jail Door
+	BOUNDARY_JAIL_DOOR_177            = 177 // Jail Door
+	BOUNDARY_WINDOW_178               = 178 // Window
+	BOUNDARY_MAGIC_PORTAL_179         = 179 // magic portal
+	BOUNDARY_JAIL_DOOR_180            = 180 // Jail Door
+	BOUNDARY_RAILINGS_181             = 181 // railings
+	BOUNDARY_RAILINGS_182             = 182 // railings
+	BOUNDARY_RAILINGS_183             = 183 // railings
+	BOUNDARY_RAILINGS_184             = 184 // railings
+	BOUNDARY_RAILINGS_185             = 185 // railings
+	BOUNDARY_RAILINGS_186             = 186 // railings
+	BOUNDARY_CAVE_EXIT                = 187 // Cave exit
+	BOUNDARY_CAVE_EXIT_188            = 188 // Cave exit
+	BOUNDARY_CAVE_EXIT_189            = 189 // Cave exit
+	BOUNDARY_CAVE_EXIT_190            = 190 // Cave exit
+	BOUNDARY_CAVE_EXIT_191            = 191 // Cave exit
+	BOUNDARY_CAVE_EXIT_192            = 192 // Cave exit
+	BOUNDARY_RAILINGS_193             = 193 // railings
+	BOUNDARY_DOOR_194                 = 194 // Door
+	BOUNDARY_BATTLEMENT_195           = 195 // battlement
+	BOUNDARY_TENT_DOOR                = 196 // Tent Door
+	BOUNDARY_DOOR_197                 = 197 // Door
+	BOUNDARY_TENT_DOOR_198            = 198 // Tent Door
+	BOUNDARY_LOW_FENCE_199            = 199 // Low Fence
+	BOUNDARY_STURDY_IRON_GATE         = 200 // Sturdy Iron Gate
+	BOUNDARY_BATTLEMENT_201           = 201 // battlement
+	BOUNDARY_WATER                    = 202 // Water
+	BOUNDARY_WHEAT                    = 203 // Wheat
+	BOUNDARY_JUNGLE                   = 204 // Jungle
+	BOUNDARY_WINDOW_205               = 205 // Window
+	BOUNDARY_RUT                      = 206 // Rut
+	BOUNDARY_CRUMBLED_CAVERN_1        = 207 // Crumbled Cavern 1
+	BOUNDARY_CRUMBLED_CAVERN_2        = 208 // Crumbled Cavern 2
+	BOUNDARY_CAVERNHOLE               = 209 // cavernhole
+	BOUNDARY_FLAMEWALL                = 210 // flamewall
+	BOUNDARY_RUINED_WALL              = 211 // Ruined wall
+	BOUNDARY_ANCIENT_WALL             = 212 // Ancient Wall
+	BOUNDARY_DOOR_213                 = 213 // Door
+)
+
+// Boundaries Maps the name of every boundary constant, without any prefix, to its id.
+var Boundaries = map[string]int{
+	"WALL":                     BOUNDARY_WALL,
+	"DOORFRAME":                BOUNDARY_DOORFRAME,
+	"DOOR":                     BOUNDARY_DOOR,
+	"WINDOW":                   BOUNDARY_WINDOW,
+	"FENCE":                    BOUNDARY_FENCE,
+	"RAILINGS":                 BOUNDARY_RAILINGS,
+	"STAINED_GLASS_WINDOW":     BOUNDARY_STAINED_GLASS_WINDOW,
+	"HIGHWALL":                 BOUNDARY_HIGHWALL,
+	"DOOR_8":                   BOUNDARY_DOOR_8,
+	"DOORFRAME_9":              BOUNDARY_DOORFRAME_9,
+	"BATTLEMENT":               BOUNDARY_BATTLEMENT,
+	"DOORFRAME_11":             BOUNDARY_DOORFRAME_11,
+	"SNOWWALL":                 BOUNDARY_SNOWWALL,
+	"ARROWSLIT":                BOUNDARY_ARROWSLIT,
+	"TIMBERWALL":               BOUNDARY_TIMBERWALL,
+	"TIMBERWINDOW":             BOUNDARY_TIMBERWINDOW,
+	"BLANK":                    BOUNDARY_BLANK,
+	"HIGHBLANK":                BOUNDARY_HIGHBLANK,
+	"MOSSYBRICKS":              BOUNDARY_MOSSYBRICKS,
+	"DOOR_19":                  BOUNDARY_DOOR_19,
+	"DOOR_20":                  BOUNDARY_DOOR_20,
+	"DOOR_21":                  BOUNDARY_DOOR_21,
+	"ODD_LOOKING_WALL":         BOUNDARY_ODD_LOOKING_WALL,
+	"DOOR_23":                  BOUNDARY_DOOR_23,
+	"WEB":                      BOUNDARY_WEB,
+	"DOOR_25":                  BOUNDARY_DOOR_25,
+	"DOOR_26":                  BOUNDARY_DOOR_26,
+	"DOOR_27":                  BOUNDARY_DOOR_27,
+	"DOOR_28":                  BOUNDARY_DOOR_28,
+	"DOOR_29":                  BOUNDARY_DOOR_29,
+	"DOOR_30":                  BOUNDARY_DOOR_30,
+	"DOOR_31":                  BOUNDARY_DOOR_31,
+	"DOOR_32":                  BOUNDARY_DOOR_32,
+	"DOOR_33":                  BOUNDARY_DOOR_33,
+	"WINDOW_34":                BOUNDARY_WINDOW_34,
+	"DOOR_35":                  BOUNDARY_DOOR_35,
+	"DOOR_36":                  BOUNDARY_DOOR_36,
+	"DOOR_37":                  BOUNDARY_DOOR_37,
+	"DOOR_38":                  BOUNDARY_DOOR_38,
+	"DOOR_39":                  BOUNDARY_DOOR_39,
+	"DOOR_40":                  BOUNDARY_DOOR_40,
+	"CRUMBLED":                 BOUNDARY_CRUMBLED,
+	"CAVERN":                   BOUNDARY_CAVERN,
+	"DOOR_43":                  BOUNDARY_DOOR_43,
+	"DOOR_44":                  BOUNDARY_DOOR_44,
+	"DOOR_45":                  BOUNDARY_DOOR_45,
+	"CAVERN2":                  BOUNDARY_CAVERN2,
+	"DOOR_47":                  BOUNDARY_DOOR_47,
+	"DOOR_48":                  BOUNDARY_DOOR_48,
+	"DOOR_49":                  BOUNDARY_DOOR_49,
+	"DOOR_50":                  BOUNDARY_DOOR_50,
+	"DOOR_51":                  BOUNDARY_DOOR_51,
+	"DOOR_52":                  BOUNDARY_DOOR_52,
+	"DOOR_53":                  BOUNDARY_DOOR_53,
+	"DOOR_54":                  BOUNDARY_DOOR_54,
+	"DOOR_55":                  BOUNDARY_DOOR_55,
+	"WALL_56":                  BOUNDARY_WALL_56,
+	"DOOR_57":                  BOUNDARY_DOOR_57,
+	"STRANGE_LOOKING_WALL":     BOUNDARY_STRANGE_LOOKING_WALL,
+	"DOOR_59":                  BOUNDARY_DOOR_59,
+	"DOOR_60":                  BOUNDARY_DOOR_60,
+	"DOOR_61":                  BOUNDARY_DOOR_61,
+	"MEMBERRAILINGS":           BOUNDARY_MEMBERRAILINGS,
+	"DOOR_63":                  BOUNDARY_DOOR_63,
+	"DOOR_64":                  BOUNDARY_DOOR_64,
+	"MAGIC_DOOR":               BOUNDARY_MAGIC_DOOR,
+	"DOOR_66":                  BOUNDARY_DOOR_66,
+	"DOOR_67":                  BOUNDARY_DOOR_67,
+	"DOOR_68":                  BOUNDARY_DOOR_68,
+	"DOOR_69":                  BOUNDARY_DOOR_69,
+	"DOOR_70":                  BOUNDARY_DOOR_70,
+	"DOOR_71":                  BOUNDARY_DOOR_71,
+	"DOOR_72":                  BOUNDARY_DOOR_72,
+	"DOOR_73":                  BOUNDARY_DOOR_73,
+	"DOOR_74":                  BOUNDARY_DOOR_74,
+	"DOOR_75":                  BOUNDARY_DOOR_75,
+	"DOOR_76":                  BOUNDARY_DOOR_76,
+	"DOOR_77":                  BOUNDARY_DOOR_77,
+	"DOOR_78":                  BOUNDARY_DOOR_78,
+	"STRANGE_PANEL":            BOUNDARY_STRANGE_PANEL,
+	"DOOR_80":                  BOUNDARY_DOOR_80,
+	"DOOR_81":                  BOUNDARY_DOOR_81,
+	"DOOR_82":                  BOUNDARY_DOOR_82,
+	"DOOR_83":                  BOUNDARY_DOOR_83,
+	"DOOR_84":                  BOUNDARY_DOOR_84,
+	"DOOR_85":                  BOUNDARY_DOOR_85,
+	"BLOCKBLANK":               BOUNDARY_BLOCKBLANK,
+	"UNUSUAL_LOOKING_WALL":     BOUNDARY_UNUSUAL_LOOKING_WALL,
+	"DOOR_88":                  BOUNDARY_DOOR_88,
+	"DOOR_89":                  BOUNDARY_DOOR_89,
+	"DOOR_90":                  BOUNDARY_DOOR_90,
+	"DOOR_91":                  BOUNDARY_DOOR_91,
+	"DOOR_92":                  BOUNDARY_DOOR_92,
+	"DOOR_93":                  BOUNDARY_DOOR_93,
+	"DOOR_94":                  BOUNDARY_DOOR_94,
+	"DOOR_95":                  BOUNDARY_DOOR_95,
+	"DOOR_96":                  BOUNDARY_DOOR_96,
+	"DOOR_97":                  BOUNDARY_DOOR_97,
+	"DOOR_98":                  BOUNDARY_DOOR_98,
+	"DOOR_99":                  BOUNDARY_DOOR_99,
+	"DOOR_100":                 BOUNDARY_DOOR_100,
+	"FENCE_WITH_LOOSE_PANNELS": BOUNDARY_FENCE_WITH_LOOSE_PANNELS,
+	"DOOR_102":                 BOUNDARY_DOOR_102,
+	"DOOR_103":                 BOUNDARY_DOOR_103,
+	"DOOR_104":                 BOUNDARY_DOOR_104,
+	"DOOR_105":                 BOUNDARY_DOOR_105,
+	"DOOR_106":                 BOUNDARY_DOOR_106,
+	"DOOR_107":                 BOUNDARY_DOOR_107,
+	"DOOR_108":                 BOUNDARY_DOOR_108,
+	"DOOR_109":                 BOUNDARY_DOOR_109,
+	"DOOR_110":                 BOUNDARY_DOOR_110,
+	"RAT_CAGE":                 BOUNDARY_RAT_CAGE,
+	"DOOR_112":                 BOUNDARY_DOOR_112,
+	"DOOR_113":                 BOUNDARY_DOOR_113,
+	"DOOR_114":                 BOUNDARY_DOOR_114,
+	"DOOR_115":                 BOUNDARY_DOOR_115,
+	"DOOR_116":                 BOUNDARY_DOOR_116,
+	"DOOR_117":                 BOUNDARY_DOOR_117,
+	"ARROWSLIT_118":            BOUNDARY_ARROWSLIT_118,
+	"SOLIDBLANK":               BOUNDARY_SOLIDBLANK,
+	"DOOR_120":                 BOUNDARY_DOOR_120,
+	"DOOR_121":                 BOUNDARY_DOOR_121,
+	"DOOR_122":                 BOUNDARY_DOOR_122,
+	"DOOR_123":                 BOUNDARY_DOOR_123,
+	"LOOSE_PANEL":              BOUNDARY_LOOSE_PANEL,
+	"DOOR_125":                 BOUNDARY_DOOR_125,
+	"PLANKSWINDOW":             BOUNDARY_PLANKSWINDOW,
+	"LOW_FENCE":                BOUNDARY_LOW_FENCE,
+	"DOOR_128":                 BOUNDARY_DOOR_128,
+	"DOOR_129":                 BOUNDARY_DOOR_129,
+	"DOOR_130":                 BOUNDARY_DOOR_130,
+	"DOOR_131":                 BOUNDARY_DOOR_131,
+	"DOOR_132":                 BOUNDARY_DOOR_132,
+	"DOOR_133":                 BOUNDARY_DOOR_133,
+	"DOOR_134":                 BOUNDARY_DOOR_134,
+	"DOOR_135":                 BOUNDARY_DOOR_135,
+	"DOOR_136":                 BOUNDARY_DOOR_136,
+	"COOKING_POT":              BOUNDARY_COOKING_POT,
+	"DOOR_138":                 BOUNDARY_DOOR_138,
+	"DOOR_139":                 BOUNDARY_DOOR_139,
+	"DOOR_140":                 BOUNDARY_DOOR_140,
+	"DOOR_141":                 BOUNDARY_DOOR_141,
+	"DOOR_142":                 BOUNDARY_DOOR_142,
+	"DOOR_143":                 BOUNDARY_DOOR_143,
+	"PLANKSTIMBER":             BOUNDARY_PLANKSTIMBER,
+	"DOOR_145":                 BOUNDARY_DOOR_145,
+	"DOOR_146":                 BOUNDARY_DOOR_146,
+	"MAGIC_PORTAL":             BOUNDARY_MAGIC_PORTAL,
+	"MAGIC_PORTAL_148":         BOUNDARY_MAGIC_PORTAL_148,
+	"MAGIC_PORTAL_149":         BOUNDARY_MAGIC_PORTAL_149,
+	"DOOR_150":                 BOUNDARY_DOOR_150,
+	"CAVERN_WALL":              BOUNDARY_CAVERN_WALL,
+	"DOOR_152":                 BOUNDARY_DOOR_152,
+	"DOOR_153":                 BOUNDARY_DOOR_153,
+	"DOOR_154":                 BOUNDARY_DOOR_154,
+	"DOOR_155":                 BOUNDARY_DOOR_155,
+	"DOOR_156":                 BOUNDARY_DOOR_156,
+	"DOOR_157":                 BOUNDARY_DOOR_157,
+	"DOOR_158":                 BOUNDARY_DOOR_158,
+	"DOOR_159":                 BOUNDARY_DOOR_159,
+	"DOOR_160":                 BOUNDARY_DOOR_160,
+	"DOOR_161":                 BOUNDARY_DOOR_161,
+	"DOOR_162":                 BOUNDARY_DOOR_162,
+	"LOW_WALL":                 BOUNDARY_LOW_WALL,
+	"LOW_WALL_164":             BOUNDARY_LOW_WALL_164,
+	"BLACKSMITHS_DOOR":         BOUNDARY_BLACKSMITHS_DOOR,
+	"RAILINGS_166":             BOUNDARY_RAILINGS_166,
+	"RAILINGS_167":             BOUNDARY_RAILINGS_167,
+	"RAILINGS_168":             BOUNDARY_RAILINGS_168,
+	"RAILINGS_169":             BOUNDARY_RAILINGS_169,
+	"RAILINGS_170":             BOUNDARY_RAILINGS_170,
+	"RAILINGS_171":             BOUNDARY_RAILINGS_171,
+	"RAILINGS_172":             BOUNDARY_RAILINGS_172,
+	"DOOR_173":                 BOUNDARY_DOOR_173,
+	"DOORFRAME_174":            BOUNDARY_DOORFRAME_174,
+	"TENT":                     BOUNDARY_TENT,
+	"JAIL_DOOR":                BOUNDARY_JAIL_DOOR,
+	"JAIL_DOOR_177":            BOUNDARY_JAIL_DOOR_177,
+	"WINDOW_178":               BOUNDARY_WINDOW_178,
+	"MAGIC_PORTAL_179":         BOUNDARY_MAGIC_PORTAL_179,
+	"JAIL_DOOR_180":            BOUNDARY_JAIL_DOOR_180,
+	"RAILINGS_181":             BOUNDARY_RAILINGS_181,
+	"RAILINGS_182":             BOUNDARY_RAILINGS_182,
+	"RAILINGS_183":             BOUNDARY_RAILINGS_183,
+	"RAILINGS_184":             BOUNDARY_RAILINGS_184,
+	"RAILINGS_185":             BOUNDARY_RAILINGS_185,
+	"RAILINGS_186":             BOUNDARY_RAILINGS_186,
+	"CAVE_EXIT":                BOUNDARY_CAVE_EXIT,
+	"CAVE_EXIT_188":            BOUNDARY_CAVE_EXIT_188,
+	"CAVE_EXIT_189":            BOUNDARY_CAVE_EXIT_189,
+	"CAVE_EXIT_190":            BOUNDARY_CAVE_EXIT_190,
+	"CAVE_EXIT_191":            BOUNDARY_CAVE_EXIT_191,
+	"CAVE_EXIT_192":            BOUNDARY_CAVE_EXIT_192,
+	"RAILINGS_193":             BOUNDARY_RAILINGS_193,
+	"DOOR_194":                 BOUNDARY_DOOR_194,
+	"BATTLEMENT_195":           BOUNDARY_BATTLEMENT_195,
+	"TENT_DOOR":                BOUNDARY_TENT_DOOR,
+	"DOOR_197":                 BOUNDARY_DOOR_197,
+	"TENT_DOOR_198":            BOUNDARY_TENT_DOOR_198,
+	"LOW_FENCE_199":            BOUNDARY_LOW_FENCE_199,
+	"STURDY_IRON_GATE":         BOUNDARY_STURDY_IRON_GATE,
+	"BATTLEMENT_201":           BOUNDARY_BATTLEMENT_201,
+	"WATER":                    BOUNDARY_WATER,
+	"WHEAT":                    BOUNDARY_WHEAT,
+	"JUNGLE":                   BOUNDARY_JUNGLE,
+	"WINDOW_205":               BOUNDARY_WINDOW_205,
+	"RUT":                      BOUNDARY_RUT,
+	"CRUMBLED_CAVERN_1":        BOUNDARY_CRUMBLED_CAVERN_1,
+	"CRUMBLED_CAVERN_2":        BOUNDARY_CRUMBLED_CAVERN_2,
+	"CAVERNHOLE":               BOUNDARY_CAVERNHOLE,
+	"FLAMEWALL":                BOUNDARY_FLAMEWALL,
+	"RUINED_WALL":              BOUNDARY_RUINED_WALL,
+	"ANCIENT_WALL":             BOUNDARY_ANCIENT_WALL,
+	"DOOR_213":                 BOUNDARY_DOOR_213,
+}
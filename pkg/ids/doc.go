@@ -0,0 +1,21 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+//Package ids holds constants for the ids of every item, NPC, scenery object and boundary in the entity database.
+//
+// Items are named after themselves, e.g ids.AIR_RUNE, while NPCs, scenery objects and boundaries are prefixed with
+// NPC_, OBJECT_ and BOUNDARY_ respectively.  Where names collide, the lowest id keeps the plain name, and the rest have
+// their id appended, e.g PARTY_HAT and PARTY_HAT_577.  Every table except the aliases is generated, so after changing
+// the definitions in the entity database, regenerate them with:
+//
+//	go generate ./pkg/ids
+package ids
+
+//go:generate go run ../../cmd/idgen --db file:../../data/world.db --out .
@@ -0,0 +1,2591 @@
+// Code generated by idgen from the entity database. DO NOT EDIT.
+
+package ids
+
+// The ids of every item definition.
+const (
+	IRON_MACE                            = 0    // Iron Mace
+	IRON_SHORT_SWORD                     = 1    // Iron Short Sword
+	IRON_KITE_SHIELD                     = 2    // Iron Kite Shield
+	IRON_SQUARE_SHIELD                   = 3    // Iron Square Shield
+	WOODEN_SHIELD                        = 4    // Wooden Shield
+	MEDIUM_IRON_HELMET                   = 5    // Medium Iron Helmet
+	LARGE_IRON_HELMET                    = 6    // Large Iron Helmet
+	IRON_CHAIN_MAIL_BODY                 = 7    // Iron Chain Mail Body
+	IRON_PLATE_MAIL_BODY                 = 8    // Iron Plate Mail Body
+	IRON_PLATE_MAIL_LEGS                 = 9    // Iron Plate Mail Legs
+	COINS                                = 10   // Coins
+	BRONZE_ARROWS                        = 11   // Bronze Arrows
+	IRON_AXE                             = 12   // Iron Axe
+	KNIFE                                = 13   // Knife
+	LOGS                                 = 14   // Logs
+	LEATHER_ARMOUR                       = 15   // Leather Armour
+	LEATHER_GLOVES                       = 16   // Leather Gloves
+	BOOTS                                = 17   // Boots
+	CABBAGE                              = 18   // Cabbage
+	EGG                                  = 19   // Egg
+	BONES                                = 20   // Bones
+	BUCKET                               = 21   // Bucket
+	MILK                                 = 22   // Milk
+	FLOUR                                = 23   // Flour
+	AMULET_OF_GHOSTSPEAK                 = 24   // Amulet of GhostSpeak
+	SILVERLIGHT_KEY_1                    = 25   // Silverlight key 1
+	SILVERLIGHT_KEY_2                    = 26   // Silverlight key 2
+	SKULL                                = 27   // skull
+	IRON_DAGGER                          = 28   // Iron dagger
+	GRAIN                                = 29   // grain
+	BOOK                                 = 30   // Book
+	FIRE_RUNE                            = 31   // Fire-Rune
+	WATER_RUNE                           = 32   // Water-Rune
+	AIR_RUNE                             = 33   // Air-Rune
+	EARTH_RUNE                           = 34   // Earth-Rune
+	MIND_RUNE                            = 35   // Mind-Rune
+	BODY_RUNE                            = 36   // Body-Rune
+	LIFE_RUNE                            = 37   // Life-Rune
+	DEATH_RUNE                           = 38   // Death-Rune
+	NEEDLE                               = 39   // Needle
+	NATURE_RUNE                          = 40   // Nature-Rune
+	CHAOS_RUNE                           = 41   // Chaos-Rune
+	LAW_RUNE                             = 42   // Law-Rune
+	THREAD                               = 43   // Thread
+	HOLY_SYMBOL_OF_SARADOMIN             = 44   // Holy Symbol of saradomin
+	UNBLESSED_HOLY_SYMBOL                = 45   // Unblessed Holy Symbol
+	COSMIC_RUNE                          = 46   // Cosmic-Rune
+	KEY                                  = 47   // key
+	KEY_48                               = 48   // key
+	SCROLL                               = 49   // scroll
+	WATER                                = 50   // Water
+	SILVERLIGHT_KEY_3                    = 51   // Silverlight key 3
+	SILVERLIGHT                          = 52   // Silverlight
+	BROKEN_SHIELD                        = 53   // Broken shield
+	BROKEN_SHIELD_54                     = 54   // Broken shield
+	CADAVABERRIES                        = 55   // Cadavaberries
+	MESSAGE                              = 56   // message
+	CADAVA                               = 57   // Cadava
+	POTION                               = 58   // potion
+	PHOENIX_CROSSBOW                     = 59   // Phoenix Crossbow
+	CROSSBOW                             = 60   // Crossbow
+	CERTIFICATE                          = 61   // Certificate
+	BRONZE_DAGGER                        = 62   // bronze dagger
+	STEEL_DAGGER                         = 63   // Steel dagger
+	MITHRIL_DAGGER                       = 64   // Mithril dagger
+	ADAMANTITE_DAGGER                    = 65   // Adamantite dagger
+	BRONZE_SHORT_SWORD                   = 66   // Bronze Short Sword
+	STEEL_SHORT_SWORD                    = 67   // Steel Short Sword
+	MITHRIL_SHORT_SWORD                  = 68   // Mithril Short Sword
+	ADAMANTITE_SHORT_SWORD               = 69   // Adamantite Short Sword
+	BRONZE_LONG_SWORD                    = 70   // Bronze Long Sword
+	IRON_LONG_SWORD                      = 71   // Iron Long Sword
+	STEEL_LONG_SWORD                     = 72   // Steel Long Sword
+	MITHRIL_LONG_SWORD                   = 73   // Mithril Long Sword
+	ADAMANTITE_LONG_SWORD                = 74   // Adamantite Long Sword
+	RUNE_LONG_SWORD                      = 75   // Rune long sword
+	BRONZE_2_HANDED_SWORD                = 76   // Bronze 2-handed Sword
+	IRON_2_HANDED_SWORD                  = 77   // Iron 2-handed Sword
+	STEEL_2_HANDED_SWORD                 = 78   // Steel 2-handed Sword
+	MITHRIL_2_HANDED_SWORD               = 79   // Mithril 2-handed Sword
+	ADAMANTITE_2_HANDED_SWORD            = 80   // Adamantite 2-handed Sword
+	RUNE_2_HANDED_SWORD                  = 81   // rune 2-handed Sword
+	BRONZE_SCIMITAR                      = 82   // Bronze Scimitar
+	IRON_SCIMITAR                        = 83   // Iron Scimitar
+	STEEL_SCIMITAR                       = 84   // Steel Scimitar
+	MITHRIL_SCIMITAR                     = 85   // Mithril Scimitar
+	ADAMANTITE_SCIMITAR                  = 86   // Adamantite Scimitar
+	BRONZE_AXE                           = 87   // bronze Axe
+	STEEL_AXE                            = 88   // Steel Axe
+	IRON_BATTLE_AXE                      = 89   // Iron battle Axe
+	STEEL_BATTLE_AXE                     = 90   // Steel battle Axe
+	MITHRIL_BATTLE_AXE                   = 91   // Mithril battle Axe
+	ADAMANTITE_BATTLE_AXE                = 92   // Adamantite battle Axe
+	RUNE_BATTLE_AXE                      = 93   // Rune battle Axe
+	BRONZE_MACE                          = 94   // Bronze Mace
+	STEEL_MACE                           = 95   // Steel Mace
+	MITHRIL_MACE                         = 96   // Mithril Mace
+	ADAMANTITE_MACE                      = 97   // Adamantite Mace
+	RUNE_MACE                            = 98   // Rune Mace
+	BRASS_KEY                            = 99   // Brass key
+	STAFF                                = 100  // staff
+	STAFF_OF_AIR                         = 101  // Staff of Air
+	STAFF_OF_WATER                       = 102  // Staff of water
+	STAFF_OF_EARTH                       = 103  // Staff of earth
+	MEDIUM_BRONZE_HELMET                 = 104  // Medium Bronze Helmet
+	MEDIUM_STEEL_HELMET                  = 105  // Medium Steel Helmet
+	MEDIUM_MITHRIL_HELMET                = 106  // Medium Mithril Helmet
+	MEDIUM_ADAMANTITE_HELMET             = 107  // Medium Adamantite Helmet
+	LARGE_BRONZE_HELMET                  = 108  // Large Bronze Helmet
+	LARGE_STEEL_HELMET                   = 109  // Large Steel Helmet
+	LARGE_MITHRIL_HELMET                 = 110  // Large Mithril Helmet
+	LARGE_ADAMANTITE_HELMET              = 111  // Large Adamantite Helmet
+	LARGE_RUNE_HELMET                    = 112  // Large Rune Helmet
+	BRONZE_CHAIN_MAIL_BODY               = 113  // Bronze Chain Mail Body
+	STEEL_CHAIN_MAIL_BODY                = 114  // Steel Chain Mail Body
+	MITHRIL_CHAIN_MAIL_BODY              = 115  // Mithril Chain Mail Body
+	ADAMANTITE_CHAIN_MAIL_BODY           = 116  // Adamantite Chain Mail Body
+	BRONZE_PLATE_MAIL_BODY               = 117  // Bronze Plate Mail Body
+	STEEL_PLATE_MAIL_BODY                = 118  // Steel Plate Mail Body
+	MITHRIL_PLATE_MAIL_BODY              = 119  // Mithril Plate Mail Body
+	ADAMANTITE_PLATE_MAIL_BODY           = 120  // Adamantite Plate Mail Body
+	STEEL_PLATE_MAIL_LEGS                = 121  // Steel Plate Mail Legs
+	MITHRIL_PLATE_MAIL_LEGS              = 122  // Mithril Plate Mail Legs
+	ADAMANTITE_PLATE_MAIL_LEGS           = 123  // Adamantite Plate Mail Legs
+	BRONZE_SQUARE_SHIELD                 = 124  // Bronze Square Shield
+	STEEL_SQUARE_SHIELD                  = 125  // Steel Square Shield
+	MITHRIL_SQUARE_SHIELD                = 126  // Mithril Square Shield
+	ADAMANTITE_SQUARE_SHIELD             = 127  // Adamantite Square Shield
+	BRONZE_KITE_SHIELD                   = 128  // Bronze Kite Shield
+	STEEL_KITE_SHIELD                    = 129  // Steel Kite Shield
+	MITHRIL_KITE_SHIELD                  = 130  // Mithril Kite Shield
+	ADAMANTITE_KITE_SHIELD               = 131  // Adamantite Kite Shield
+	COOKEDMEAT                           = 132  // cookedmeat
+	RAW_CHICKEN                          = 133  // raw chicken
+	BURNTMEAT                            = 134  // burntmeat
+	POT                                  = 135  // pot
+	FLOUR_136                            = 136  // flour
+	BREAD_DOUGH                          = 137  // bread dough
+	BREAD                                = 138  // bread
+	BURNTBREAD                           = 139  // burntbread
+	JUG                                  = 140  // jug
+	WATER_141                            = 141  // water
+	WINE                                 = 142  // wine
+	GRAPES                               = 143  // grapes
+	SHEARS                               = 144  // shears
+	WOOL                                 = 145  // wool
+	FUR                                  = 146  // fur
+	COW_HIDE                             = 147  // cow hide
+	LEATHER                              = 148  // leather
+	CLAY                                 = 149  // clay
+	COPPER_ORE                           = 150  // copper ore
+	IRON_ORE                             = 151  // iron ore
+	GOLD                                 = 152  // gold
+	MITHRIL_ORE                          = 153  // mithril ore
+	ADAMANTITE_ORE                       = 154  // adamantite ore
+	COAL                                 = 155  // coal
+	BRONZE_PICKAXE                       = 156  // Bronze Pickaxe
+	UNCUT_DIAMOND                        = 157  // uncut diamond
+	UNCUT_RUBY                           = 158  // uncut ruby
+	UNCUT_EMERALD                        = 159  // uncut emerald
+	UNCUT_SAPPHIRE                       = 160  // uncut sapphire
+	DIAMOND                              = 161  // diamond
+	RUBY                                 = 162  // ruby
+	EMERALD                              = 163  // emerald
+	SAPPHIRE                             = 164  // sapphire
+	HERB                                 = 165  // Herb
+	TINDERBOX                            = 166  // tinderbox
+	CHISEL                               = 167  // chisel
+	HAMMER                               = 168  // hammer
+	BRONZE_BAR                           = 169  // bronze bar
+	IRON_BAR                             = 170  // iron bar
+	STEEL_BAR                            = 171  // steel bar
+	GOLD_BAR                             = 172  // gold bar
+	MITHRIL_BAR                          = 173  // mithril bar
+	ADAMANTITE_BAR                       = 174  // adamantite bar
+	PRESSURE_GAUGE                       = 175  // Pressure gauge
+	FISH_FOOD                            = 176  // Fish Food
+	POISON                               = 177  // Poison
+	POISONED_FISH_FOOD                   = 178  // Poisoned fish food
+	SPINACH_ROLL                         = 179  // spinach roll
+	BAD_WINE                             = 180  // Bad wine
+	ASHES                                = 181  // Ashes
+	APRON                                = 182  // Apron
+	CAPE                                 = 183  // Cape
+	WIZARDS_ROBE                         = 184  // Wizards robe
+	WIZARDSHAT                           = 185  // wizardshat
+	BRASS_NECKLACE                       = 186  // Brass necklace
+	SKIRT                                = 187  // skirt
+	LONGBOW                              = 188  // Longbow
+	SHORTBOW                             = 189  // Shortbow
+	CROSSBOW_BOLTS                       = 190  // Crossbow bolts
+	APRON_191                            = 191  // Apron
+	CHEF_S_HAT                           = 192  // Chef's hat
+	BEER                                 = 193  // Beer
+	SKIRT_194                            = 194  // skirt
+	SKIRT_195                            = 195  // skirt
+	BLACK_PLATE_MAIL_BODY                = 196  // Black Plate Mail Body
+	STAFF_OF_FIRE                        = 197  // Staff of fire
+	MAGIC_STAFF                          = 198  // Magic Staff
+	WIZARDSHAT_199                       = 199  // wizardshat
+	SILK                                 = 200  // silk
+	FLIER                                = 201  // flier
+	TIN_ORE                              = 202  // tin ore
+	MITHRIL_AXE                          = 203  // Mithril Axe
+	ADAMANTITE_AXE                       = 204  // Adamantite Axe
+	BRONZE_BATTLE_AXE                    = 205  // bronze battle Axe
+	BRONZE_PLATE_MAIL_LEGS               = 206  // Bronze Plate Mail Legs
+	BALL_OF_WOOL                         = 207  // Ball of wool
+	OIL_CAN                              = 208  // Oil can
+	CAPE_209                             = 209  // Cape
+	KEBAB                                = 210  // Kebab
+	SPADE                                = 211  // Spade
+	CLOSET_KEY                           = 212  // Closet Key
+	RUBBER_TUBE                          = 213  // rubber tube
+	BRONZE_PLATED_SKIRT                  = 214  // Bronze Plated Skirt
+	IRON_PLATED_SKIRT                    = 215  // Iron Plated Skirt
+	BLACK_ROBE                           = 216  // Black robe
+	STAKE                                = 217  // stake
+	GARLIC                               = 218  // Garlic
+	RED_SPIDERS_EGGS                     = 219  // Red spiders eggs
+	LIMPWURT_ROOT                        = 220  // Limpwurt root
+	STRENGTH_POTION                      = 221  // Strength Potion
+	STRENGTH_POTION_222                  = 222  // Strength Potion
+	STRENGTH_POTION_223                  = 223  // Strength Potion
+	STRENGTH_POTION_224                  = 224  // Strength Potion
+	STEEL_PLATED_SKIRT                   = 225  // Steel Plated skirt
+	MITHRIL_PLATED_SKIRT                 = 226  // Mithril Plated skirt
+	ADAMANTITE_PLATED_SKIRT              = 227  // Adamantite Plated skirt
+	CABBAGE_228                          = 228  // Cabbage
+	CAPE_229                             = 229  // Cape
+	LARGE_BLACK_HELMET                   = 230  // Large Black Helmet
+	RED_BEAD                             = 231  // Red Bead
+	YELLOW_BEAD                          = 232  // Yellow Bead
+	BLACK_BEAD                           = 233  // Black Bead
+	WHITE_BEAD                           = 234  // White Bead
+	AMULET_OF_ACCURACY                   = 235  // Amulet of accuracy
+	REDBERRIES                           = 236  // Redberries
+	ROPE                                 = 237  // Rope
+	REDDYE                               = 238  // Reddye
+	YELLOWDYE                            = 239  // Yellowdye
+	PASTE                                = 240  // Paste
+	ONION                                = 241  // Onion
+	BRONZE_KEY                           = 242  // Bronze key
+	SOFT_CLAY                            = 243  // Soft Clay
+	WIG                                  = 244  // wig
+	WIG_245                              = 245  // wig
+	HALF_FULL_WINE_JUG                   = 246  // Half full wine jug
+	KEYPRINT                             = 247  // Keyprint
+	BLACK_PLATE_MAIL_LEGS                = 248  // Black Plate Mail Legs
+	BANANA                               = 249  // banana
+	PASTRY_DOUGH                         = 250  // pastry dough
+	PIE_DISH                             = 251  // Pie dish
+	COOKING_APPLE                        = 252  // cooking apple
+	PIE_SHELL                            = 253  // pie shell
+	UNCOOKED_APPLE_PIE                   = 254  // Uncooked apple pie
+	UNCOOKED_MEAT_PIE                    = 255  // Uncooked meat pie
+	UNCOOKED_REDBERRY_PIE                = 256  // Uncooked redberry pie
+	APPLE_PIE                            = 257  // apple pie
+	REDBERRY_PIE                         = 258  // Redberry pie
+	MEAT_PIE                             = 259  // meat pie
+	BURNTPIE                             = 260  // burntpie
+	HALF_A_MEAT_PIE                      = 261  // Half a meat pie
+	HALF_A_REDBERRY_PIE                  = 262  // Half a Redberry pie
+	HALF_AN_APPLE_PIE                    = 263  // Half an apple pie
+	PORTRAIT                             = 264  // Portrait
+	FALADIAN_KNIGHT_S_SWORD              = 265  // Faladian Knight's sword
+	BLURITE_ORE                          = 266  // blurite ore
+	ASGARNIAN_ALE                        = 267  // Asgarnian Ale
+	WIZARD_S_MIND_BOMB                   = 268  // Wizard's Mind Bomb
+	DWARVEN_STOUT                        = 269  // Dwarven Stout
+	EYE_OF_NEWT                          = 270  // Eye of newt
+	RAT_S_TAIL                           = 271  // Rat's tail
+	BLUEDYE                              = 272  // Bluedye
+	GOBLIN_ARMOUR                        = 273  // Goblin Armour
+	GOBLIN_ARMOUR_274                    = 274  // Goblin Armour
+	GOBLIN_ARMOUR_275                    = 275  // Goblin Armour
+	UNSTRUNG_LONGBOW                     = 276  // unstrung Longbow
+	UNSTRUNG_SHORTBOW                    = 277  // unstrung shortbow
+	UNFIRED_PIE_DISH                     = 278  // Unfired Pie dish
+	UNFIRED_POT                          = 279  // unfired pot
+	ARROW_SHAFTS                         = 280  // arrow shafts
+	WOAD_LEAF                            = 281  // Woad Leaf
+	ORANGEDYE                            = 282  // Orangedye
+	GOLD_RING                            = 283  // Gold ring
+	SAPPHIRE_RING                        = 284  // Sapphire ring
+	EMERALD_RING                         = 285  // Emerald ring
+	RUBY_RING                            = 286  // Ruby ring
+	DIAMOND_RING                         = 287  // Diamond ring
+	GOLD_NECKLACE                        = 288  // Gold necklace
+	SAPPHIRE_NECKLACE                    = 289  // Sapphire necklace
+	EMERALD_NECKLACE                     = 290  // Emerald necklace
+	RUBY_NECKLACE                        = 291  // Ruby necklace
+	DIAMOND_NECKLACE                     = 292  // Diamond necklace
+	RING_MOULD                           = 293  // ring mould
+	AMULET_MOULD                         = 294  // Amulet mould
+	NECKLACE_MOULD                       = 295  // Necklace mould
+	GOLD_AMULET                          = 296  // Gold Amulet
+	SAPPHIRE_AMULET                      = 297  // Sapphire Amulet
+	EMERALD_AMULET                       = 298  // Emerald Amulet
+	RUBY_AMULET                          = 299  // Ruby Amulet
+	DIAMOND_AMULET                       = 300  // Diamond Amulet
+	GOLD_AMULET_301                      = 301  // Gold Amulet
+	SAPPHIRE_AMULET_302                  = 302  // Sapphire Amulet
+	EMERALD_AMULET_303                   = 303  // Emerald Amulet
+	RUBY_AMULET_304                      = 304  // Ruby Amulet
+	DIAMOND_AMULET_305                   = 305  // Diamond Amulet
+	SUPERCHISEL                          = 306  // superchisel
+	MACE_OF_ZAMORAK                      = 307  // Mace of Zamorak
+	BRONZE_PLATE_MAIL_TOP                = 308  // Bronze Plate Mail top
+	STEEL_PLATE_MAIL_TOP                 = 309  // Steel Plate Mail top
+	MITHRIL_PLATE_MAIL_TOP               = 310  // Mithril Plate Mail top
+	ADAMANTITE_PLATE_MAIL_TOP            = 311  // Adamantite Plate Mail top
+	IRON_PLATE_MAIL_TOP                  = 312  // Iron Plate Mail top
+	BLACK_PLATE_MAIL_TOP                 = 313  // Black Plate Mail top
+	SAPPHIRE_AMULET_OF_MAGIC             = 314  // Sapphire Amulet of magic
+	EMERALD_AMULET_OF_PROTECTION         = 315  // Emerald Amulet of protection
+	RUBY_AMULET_OF_STRENGTH              = 316  // Ruby Amulet of strength
+	DIAMOND_AMULET_OF_POWER              = 317  // Diamond Amulet of power
+	KARAMJA_RUM                          = 318  // Karamja Rum
+	CHEESE                               = 319  // Cheese
+	TOMATO                               = 320  // Tomato
+	PIZZA_BASE                           = 321  // Pizza Base
+	BURNT_PIZZA                          = 322  // Burnt Pizza
+	INCOMPLETE_PIZZA                     = 323  // Incomplete Pizza
+	UNCOOKED_PIZZA                       = 324  // Uncooked Pizza
+	PLAIN_PIZZA                          = 325  // Plain Pizza
+	MEAT_PIZZA                           = 326  // Meat Pizza
+	ANCHOVIE_PIZZA                       = 327  // Anchovie Pizza
+	HALF_MEAT_PIZZA                      = 328  // Half Meat Pizza
+	HALF_ANCHOVIE_PIZZA                  = 329  // Half Anchovie Pizza
+	CAKE                                 = 330  // Cake
+	BURNT_CAKE                           = 331  // Burnt Cake
+	CHOCOLATE_CAKE                       = 332  // Chocolate Cake
+	PARTIAL_CAKE                         = 333  // Partial Cake
+	PARTIAL_CHOCOLATE_CAKE               = 334  // Partial Chocolate Cake
+	SLICE_OF_CAKE                        = 335  // Slice of Cake
+	CHOCOLATE_SLICE                      = 336  // Chocolate Slice
+	CHOCOLATE_BAR                        = 337  // Chocolate Bar
+	CAKE_TIN                             = 338  // Cake Tin
+	UNCOOKED_CAKE                        = 339  // Uncooked cake
+	UNFIRED_BOWL                         = 340  // Unfired bowl
+	BOWL                                 = 341  // Bowl
+	BOWL_OF_WATER                        = 342  // Bowl of water
+	INCOMPLETE_STEW                      = 343  // Incomplete stew
+	INCOMPLETE_STEW_344                  = 344  // Incomplete stew
+	UNCOOKED_STEW                        = 345  // Uncooked stew
+	STEW                                 = 346  // Stew
+	BURNT_STEW                           = 347  // Burnt Stew
+	POTATO                               = 348  // Potato
+	RAW_SHRIMP                           = 349  // Raw Shrimp
+	SHRIMP                               = 350  // Shrimp
+	RAW_ANCHOVIES                        = 351  // Raw Anchovies
+	ANCHOVIES                            = 352  // Anchovies
+	BURNT_FISH                           = 353  // Burnt fish
+	RAW_SARDINE                          = 354  // Raw Sardine
+	SARDINE                              = 355  // Sardine
+	RAW_SALMON                           = 356  // Raw Salmon
+	SALMON                               = 357  // Salmon
+	RAW_TROUT                            = 358  // Raw Trout
+	TROUT                                = 359  // Trout
+	BURNT_FISH_360                       = 360  // Burnt fish
+	RAW_HERRING                          = 361  // Raw Herring
+	HERRING                              = 362  // Herring
+	RAW_PIKE                             = 363  // Raw Pike
+	PIKE                                 = 364  // Pike
+	BURNT_FISH_365                       = 365  // Burnt fish
+	RAW_TUNA                             = 366  // Raw Tuna
+	TUNA                                 = 367  // Tuna
+	BURNT_FISH_368                       = 368  // Burnt fish
+	RAW_SWORDFISH                        = 369  // Raw Swordfish
+	SWORDFISH                            = 370  // Swordfish
+	BURNT_SWORDFISH                      = 371  // Burnt Swordfish
+	RAW_LOBSTER                          = 372  // Raw Lobster
+	LOBSTER                              = 373  // Lobster
+	BURNT_LOBSTER                        = 374  // Burnt Lobster
+	LOBSTER_POT                          = 375  // Lobster Pot
+	NET                                  = 376  // Net
+	FISHING_ROD                          = 377  // Fishing Rod
+	FLY_FISHING_ROD                      = 378  // Fly Fishing Rod
+	HARPOON                              = 379  // Harpoon
+	FISHING_BAIT                         = 380  // Fishing Bait
+	FEATHER                              = 381  // Feather
+	CHEST_KEY                            = 382  // Chest key
+	SILVER                               = 383  // Silver
+	SILVER_BAR                           = 384  // silver bar
+	HOLY_SYMBOL_OF_SARADOMIN_385         = 385  // Holy Symbol of saradomin
+	HOLY_SYMBOL_MOULD                    = 386  // Holy symbol mould
+	DISK_OF_RETURNING                    = 387  // Disk of Returning
+	MONKS_ROBE                           = 388  // Monks robe
+	MONKS_ROBE_389                       = 389  // Monks robe
+	RED_KEY                              = 390  // Red key
+	ORANGE_KEY                           = 391  // Orange Key
+	YELLOW_KEY                           = 392  // yellow key
+	BLUE_KEY                             = 393  // Blue key
+	MAGENTA_KEY                          = 394  // Magenta key
+	BLACK_KEY                            = 395  // black key
+	RUNE_DAGGER                          = 396  // rune dagger
+	RUNE_SHORT_SWORD                     = 397  // Rune short sword
+	RUNE_SCIMITAR                        = 398  // rune Scimitar
+	MEDIUM_RUNE_HELMET                   = 399  // Medium Rune Helmet
+	RUNE_CHAIN_MAIL_BODY                 = 400  // Rune Chain Mail Body
+	RUNE_PLATE_MAIL_BODY                 = 401  // Rune Plate Mail Body
+	RUNE_PLATE_MAIL_LEGS                 = 402  // Rune Plate Mail Legs
+	RUNE_SQUARE_SHIELD                   = 403  // Rune Square Shield
+	RUNE_KITE_SHIELD                     = 404  // Rune Kite Shield
+	RUNE_AXE                             = 405  // rune Axe
+	RUNE_SKIRT                           = 406  // Rune skirt
+	RUNE_PLATE_MAIL_TOP                  = 407  // Rune Plate Mail top
+	RUNITE_BAR                           = 408  // Runite bar
+	RUNITE_ORE                           = 409  // runite ore
+	PLANK                                = 410  // Plank
+	TILE                                 = 411  // Tile
+	SKULL_412                            = 412  // skull
+	BIG_BONES                            = 413  // Big Bones
+	MUDDY_KEY                            = 414  // Muddy key
+	MAP                                  = 415  // Map
+	MAP_PIECE                            = 416  // Map Piece
+	MAP_PIECE_417                        = 417  // Map Piece
+	MAP_PIECE_418                        = 418  // Map Piece
+	NAILS                                = 419  // Nails
+	ANTI_DRAGON_BREATH_SHIELD            = 420  // Anti dragon breath Shield
+	MAZE_KEY                             = 421  // Maze key
+	PUMPKIN                              = 422  // Pumpkin
+	BLACK_DAGGER                         = 423  // Black dagger
+	BLACK_SHORT_SWORD                    = 424  // Black Short Sword
+	BLACK_LONG_SWORD                     = 425  // Black Long Sword
+	BLACK_2_HANDED_SWORD                 = 426  // Black 2-handed Sword
+	BLACK_SCIMITAR                       = 427  // Black Scimitar
+	BLACK_AXE                            = 428  // Black Axe
+	BLACK_BATTLE_AXE                     = 429  // Black battle Axe
+	BLACK_MACE                           = 430  // Black Mace
+	BLACK_CHAIN_MAIL_BODY                = 431  // Black Chain Mail Body
+	BLACK_SQUARE_SHIELD                  = 432  // Black Square Shield
+	BLACK_KITE_SHIELD                    = 433  // Black Kite Shield
+	BLACK_PLATED_SKIRT                   = 434  // Black Plated skirt
+	HERB_435                             = 435  // Herb
+	HERB_436                             = 436  // Herb
+	HERB_437                             = 437  // Herb
+	HERB_438                             = 438  // Herb
+	HERB_439                             = 439  // Herb
+	HERB_440                             = 440  // Herb
+	HERB_441                             = 441  // Herb
+	HERB_442                             = 442  // Herb
+	HERB_443                             = 443  // Herb
+	GUAM_LEAF                            = 444  // Guam leaf
+	MARRENTILL                           = 445  // Marrentill
+	TARROMIN                             = 446  // Tarromin
+	HARRALANDER                          = 447  // Harralander
+	RANARR_WEED                          = 448  // Ranarr Weed
+	IRIT_LEAF                            = 449  // Irit Leaf
+	AVANTOE                              = 450  // Avantoe
+	KWUARM                               = 451  // Kwuarm
+	CADANTINE                            = 452  // Cadantine
+	DWARF_WEED                           = 453  // Dwarf Weed
+	UNFINISHED_POTION                    = 454  // Unfinished potion
+	UNFINISHED_POTION_455                = 455  // Unfinished potion
+	UNFINISHED_POTION_456                = 456  // Unfinished potion
+	UNFINISHED_POTION_457                = 457  // Unfinished potion
+	UNFINISHED_POTION_458                = 458  // Unfinished potion
+	UNFINISHED_POTION_459                = 459  // Unfinished potion
+	UNFINISHED_POTION_460                = 460  // Unfinished potion
+	UNFINISHED_POTION_461                = 461  // Unfinished potion
+	UNFINISHED_POTION_462                = 462  // Unfinished potion
+	UNFINISHED_POTION_463                = 463  // Unfinished potion
+	VIAL                                 = 464  // Vial
+	VIAL_465                             = 465  // Vial
+	UNICORN_HORN                         = 466  // Unicorn horn
+	BLUE_DRAGON_SCALE                    = 467  // Blue dragon scale
+	PESTLE_AND_MORTAR                    = 468  // Pestle and mortar
+	SNAPE_GRASS                          = 469  // Snape grass
+	MEDIUM_BLACK_HELMET                  = 470  // Medium black Helmet
+	WHITE_BERRIES                        = 471  // White berries
+	GROUND_BLUE_DRAGON_SCALE             = 472  // Ground blue dragon scale
+	GROUND_UNICORN_HORN                  = 473  // Ground unicorn horn
+	ATTACK_POTION                        = 474  // attack Potion
+	ATTACK_POTION_475                    = 475  // attack Potion
+	ATTACK_POTION_476                    = 476  // attack Potion
+	STAT_RESTORATION_POTION              = 477  // stat restoration Potion
+	STAT_RESTORATION_POTION_478          = 478  // stat restoration Potion
+	STAT_RESTORATION_POTION_479          = 479  // stat restoration Potion
+	DEFENSE_POTION                       = 480  // defense Potion
+	DEFENSE_POTION_481                   = 481  // defense Potion
+	DEFENSE_POTION_482                   = 482  // defense Potion
+	RESTORE_PRAYER_POTION                = 483  // restore prayer Potion
+	RESTORE_PRAYER_POTION_484            = 484  // restore prayer Potion
+	RESTORE_PRAYER_POTION_485            = 485  // restore prayer Potion
+	SUPER_ATTACK_POTION                  = 486  // Super attack Potion
+	SUPER_ATTACK_POTION_487              = 487  // Super attack Potion
+	SUPER_ATTACK_POTION_488              = 488  // Super attack Potion
+	FISHING_POTION                       = 489  // fishing Potion
+	FISHING_POTION_490                   = 490  // fishing Potion
+	FISHING_POTION_491                   = 491  // fishing Potion
+	SUPER_STRENGTH_POTION                = 492  // Super strength Potion
+	SUPER_STRENGTH_POTION_493            = 493  // Super strength Potion
+	SUPER_STRENGTH_POTION_494            = 494  // Super strength Potion
+	SUPER_DEFENSE_POTION                 = 495  // Super defense Potion
+	SUPER_DEFENSE_POTION_496             = 496  // Super defense Potion
+	SUPER_DEFENSE_POTION_497             = 497  // Super defense Potion
+	RANGING_POTION                       = 498  // ranging Potion
+	RANGING_POTION_499                   = 499  // ranging Potion
+	RANGING_POTION_500                   = 500  // ranging Potion
+	WINE_OF_ZAMORAK                      = 501  // wine of Zamorak
+	RAW_BEAR_MEAT                        = 502  // raw bear meat
+	RAW_RAT_MEAT                         = 503  // raw rat meat
+	RAW_BEEF                             = 504  // raw beef
+	ENCHANTED_BEAR_MEAT                  = 505  // enchanted bear meat
+	ENCHANTED_RAT_MEAT                   = 506  // enchanted rat meat
+	ENCHANTED_BEEF                       = 507  // enchanted beef
+	ENCHANTED_CHICKEN_MEAT               = 508  // enchanted chicken meat
+	DRAMEN_STAFF                         = 509  // Dramen Staff
+	DRAMEN_BRANCH                        = 510  // Dramen Branch
+	CAPE_511                             = 511  // Cape
+	CAPE_512                             = 512  // Cape
+	CAPE_513                             = 513  // Cape
+	CAPE_514                             = 514  // Cape
+	GREENDYE                             = 515  // Greendye
+	PURPLEDYE                            = 516  // Purpledye
+	IRON_ORE_CERTIFICATE                 = 517  // Iron ore certificate
+	COAL_CERTIFICATE                     = 518  // Coal certificate
+	MITHRIL_ORE_CERTIFICATE              = 519  // Mithril ore certificate
+	SILVER_CERTIFICATE                   = 520  // silver certificate
+	GOLD_CERTIFICATE                     = 521  // Gold certificate
+	DRAGONSTONE_AMULET                   = 522  // Dragonstone Amulet
+	DRAGONSTONE                          = 523  // Dragonstone
+	DRAGONSTONE_AMULET_524               = 524  // Dragonstone Amulet
+	CRYSTAL_KEY                          = 525  // Crystal key
+	HALF_OF_A_KEY                        = 526  // Half of a key
+	HALF_OF_A_KEY_527                    = 527  // Half of a key
+	IRON_BAR_CERTIFICATE                 = 528  // Iron bar certificate
+	STEEL_BAR_CERTIFICATE                = 529  // steel bar certificate
+	MITHRIL_BAR_CERTIFICATE              = 530  // Mithril bar certificate
+	SILVER_BAR_CERTIFICATE               = 531  // silver bar certificate
+	GOLD_BAR_CERTIFICATE                 = 532  // Gold bar certificate
+	LOBSTER_CERTIFICATE                  = 533  // Lobster certificate
+	RAW_LOBSTER_CERTIFICATE              = 534  // Raw lobster certificate
+	SWORDFISH_CERTIFICATE                = 535  // Swordfish certificate
+	RAW_SWORDFISH_CERTIFICATE            = 536  // Raw swordfish certificate
+	DIARY                                = 537  // Diary
+	FRONT_DOOR_KEY                       = 538  // Front door key
+	BALL                                 = 539  // Ball
+	MAGNET                               = 540  // magnet
+	GREY_WOLF_FUR                        = 541  // Grey wolf fur
+	UNCUT_DRAGONSTONE                    = 542  // uncut dragonstone
+	DRAGONSTONE_RING                     = 543  // Dragonstone ring
+	DRAGONSTONE_NECKLACE                 = 544  // Dragonstone necklace
+	RAW_SHARK                            = 545  // Raw Shark
+	SHARK                                = 546  // Shark
+	BURNT_SHARK                          = 547  // Burnt Shark
+	BIG_NET                              = 548  // Big Net
+	CASKET                               = 549  // Casket
+	RAW_COD                              = 550  // Raw cod
+	COD                                  = 551  // Cod
+	RAW_MACKEREL                         = 552  // Raw Mackerel
+	MACKEREL                             = 553  // Mackerel
+	RAW_BASS                             = 554  // Raw Bass
+	BASS                                 = 555  // Bass
+	ICE_GLOVES                           = 556  // Ice Gloves
+	FIREBIRD_FEATHER                     = 557  // Firebird Feather
+	FIREBIRD_FEATHER_558                 = 558  // Firebird Feather
+	POISONED_IRON_DAGGER                 = 559  // Poisoned Iron dagger
+	POISONED_BRONZE_DAGGER               = 560  // Poisoned bronze dagger
+	POISONED_STEEL_DAGGER                = 561  // Poisoned Steel dagger
+	POISONED_MITHRIL_DAGGER              = 562  // Poisoned Mithril dagger
+	POISONED_RUNE_DAGGER                 = 563  // Poisoned Rune dagger
+	POISONED_ADAMANTITE_DAGGER           = 564  // Poisoned Adamantite dagger
+	POISONED_BLACK_DAGGER                = 565  // Poisoned Black dagger
+	CURE_POISON_POTION                   = 566  // Cure poison Potion
+	CURE_POISON_POTION_567               = 567  // Cure poison Potion
+	CURE_POISON_POTION_568               = 568  // Cure poison Potion
+	POISON_ANTIDOTE                      = 569  // Poison antidote
+	POISON_ANTIDOTE_570                  = 570  // Poison antidote
+	POISON_ANTIDOTE_571                  = 571  // Poison antidote
+	WEAPON_POISON                        = 572  // weapon poison
+	ID_PAPER                             = 573  // ID Paper
+	POISON_BRONZE_ARROWS                 = 574  // Poison Bronze Arrows
+	CHRISTMAS_CRACKER                    = 575  // Christmas cracker
+	PARTY_HAT                            = 576  // Party Hat
+	PARTY_HAT_577                        = 577  // Party Hat
+	PARTY_HAT_578                        = 578  // Party Hat
+	PARTY_HAT_579                        = 579  // Party Hat
+	PARTY_HAT_580                        = 580  // Party Hat
+	PARTY_HAT_581                        = 581  // Party Hat
+	MISCELLANEOUS_KEY                    = 582  // Miscellaneous key
+	BUNCH_OF_KEYS                        = 583  // Bunch of keys
+	WHISKY                               = 584  // Whisky
+	CANDLESTICK                          = 585  // Candlestick
+	MASTER_THIEF_ARMBAND                 = 586  // Master thief armband
+	BLAMISH_SNAIL_SLIME                  = 587  // Blamish snail slime
+	BLAMISH_OIL                          = 588  // Blamish oil
+	OILY_FISHING_ROD                     = 589  // Oily Fishing Rod
+	LAVA_EEL                             = 590  // lava eel
+	RAW_LAVA_EEL                         = 591  // Raw lava eel
+	POISON_CROSSBOW_BOLTS                = 592  // Poison Crossbow bolts
+	DRAGON_SWORD                         = 593  // Dragon sword
+	DRAGON_AXE                           = 594  // Dragon axe
+	JAIL_KEYS                            = 595  // Jail keys
+	DUSTY_KEY                            = 596  // Dusty Key
+	CHARGED_DRAGONSTONE_AMULET           = 597  // Charged Dragonstone Amulet
+	GROG                                 = 598  // Grog
+	CANDLE                               = 599  // Candle
+	BLACK_CANDLE                         = 600  // black Candle
+	CANDLE_601                           = 601  // Candle
+	BLACK_CANDLE_602                     = 602  // black Candle
+	INSECT_REPELLANT                     = 603  // insect repellant
+	BAT_BONES                            = 604  // Bat bones
+	WAX_BUCKET                           = 605  // wax Bucket
+	EXCALIBUR                            = 606  // Excalibur
+	DRUIDS_ROBE                          = 607  // Druids robe
+	DRUIDS_ROBE_608                      = 608  // Druids robe
+	EYE_PATCH                            = 609  // Eye patch
+	UNENCHANTED_DRAGONSTONE_AMULET       = 610  // Unenchanted Dragonstone Amulet
+	UNPOWERED_ORB                        = 611  // Unpowered orb
+	FIRE_ORB                             = 612  // Fire orb
+	WATER_ORB                            = 613  // Water orb
+	BATTLESTAFF                          = 614  // Battlestaff
+	BATTLESTAFF_OF_FIRE                  = 615  // Battlestaff of fire
+	BATTLESTAFF_OF_WATER                 = 616  // Battlestaff of water
+	BATTLESTAFF_OF_AIR                   = 617  // Battlestaff of air
+	BATTLESTAFF_OF_EARTH                 = 618  // Battlestaff of earth
+	BLOOD_RUNE                           = 619  // Blood-Rune
+	BEER_GLASS                           = 620  // Beer glass
+	GLASSBLOWING_PIPE                    = 621  // glassblowing pipe
+	SEAWEED                              = 622  // seaweed
+	MOLTEN_GLASS                         = 623  // molten glass
+	SODA_ASH                             = 624  // soda ash
+	SAND                                 = 625  // sand
+	AIR_ORB                              = 626  // air orb
+	EARTH_ORB                            = 627  // earth orb
+	BASS_CERTIFICATE                     = 628  // bass certificate
+	RAW_BASS_CERTIFICATE                 = 629  // Raw bass certificate
+	SHARK_CERTIFICATE                    = 630  // shark certificate
+	RAW_SHARK_CERTIFICATE                = 631  // Raw shark certificate
+	OAK_LOGS                             = 632  // Oak Logs
+	WILLOW_LOGS                          = 633  // Willow Logs
+	MAPLE_LOGS                           = 634  // Maple Logs
+	YEW_LOGS                             = 635  // Yew Logs
+	MAGIC_LOGS                           = 636  // Magic Logs
+	HEADLESS_ARROWS                      = 637  // Headless Arrows
+	IRON_ARROWS                          = 638  // Iron Arrows
+	POISON_IRON_ARROWS                   = 639  // Poison Iron Arrows
+	STEEL_ARROWS                         = 640  // Steel Arrows
+	POISON_STEEL_ARROWS                  = 641  // Poison Steel Arrows
+	MITHRIL_ARROWS                       = 642  // Mithril Arrows
+	POISON_MITHRIL_ARROWS                = 643  // Poison Mithril Arrows
+	ADAMANTITE_ARROWS                    = 644  // Adamantite Arrows
+	POISON_ADAMANTITE_ARROWS             = 645  // Poison Adamantite Arrows
+	RUNE_ARROWS                          = 646  // Rune Arrows
+	POISON_RUNE_ARROWS                   = 647  // Poison Rune Arrows
+	OAK_LONGBOW                          = 648  // Oak Longbow
+	OAK_SHORTBOW                         = 649  // Oak Shortbow
+	WILLOW_LONGBOW                       = 650  // Willow Longbow
+	WILLOW_SHORTBOW                      = 651  // Willow Shortbow
+	MAPLE_LONGBOW                        = 652  // Maple Longbow
+	MAPLE_SHORTBOW                       = 653  // Maple Shortbow
+	YEW_LONGBOW                          = 654  // Yew Longbow
+	YEW_SHORTBOW                         = 655  // Yew Shortbow
+	MAGIC_LONGBOW                        = 656  // Magic Longbow
+	MAGIC_SHORTBOW                       = 657  // Magic Shortbow
+	UNSTRUNG_OAK_LONGBOW                 = 658  // unstrung Oak Longbow
+	UNSTRUNG_OAK_SHORTBOW                = 659  // unstrung Oak Shortbow
+	UNSTRUNG_WILLOW_LONGBOW              = 660  // unstrung Willow Longbow
+	UNSTRUNG_WILLOW_SHORTBOW             = 661  // unstrung Willow Shortbow
+	UNSTRUNG_MAPLE_LONGBOW               = 662  // unstrung Maple Longbow
+	UNSTRUNG_MAPLE_SHORTBOW              = 663  // unstrung Maple Shortbow
+	UNSTRUNG_YEW_LONGBOW                 = 664  // unstrung Yew Longbow
+	UNSTRUNG_YEW_SHORTBOW                = 665  // unstrung Yew Shortbow
+	UNSTRUNG_MAGIC_LONGBOW               = 666  // unstrung Magic Longbow
+	UNSTRUNG_MAGIC_SHORTBOW              = 667  // unstrung Magic Shortbow
+	BARCRAWL_CARD                        = 668  // barcrawl card
+	BRONZE_ARROW_HEADS                   = 669  // bronze arrow heads
+	IRON_ARROW_HEADS                     = 670  // iron arrow heads
+	STEEL_ARROW_HEADS                    = 671  // steel arrow heads
+	MITHRIL_ARROW_HEADS                  = 672  // mithril arrow heads
+	ADAMANTITE_ARROW_HEADS               = 673  // adamantite arrow heads
+	RUNE_ARROW_HEADS                     = 674  // rune arrow heads
+	FLAX                                 = 675  // flax
+	BOW_STRING                           = 676  // bow string
+	EASTER_EGG                           = 677  // Easter egg
+	SCORPION_CAGE                        = 678  // scorpion cage
+	SCORPION_CAGE_679                    = 679  // scorpion cage
+	SCORPION_CAGE_680                    = 680  // scorpion cage
+	SCORPION_CAGE_681                    = 681  // scorpion cage
+	ENCHANTED_BATTLESTAFF_OF_FIRE        = 682  // Enchanted Battlestaff of fire
+	ENCHANTED_BATTLESTAFF_OF_WATER       = 683  // Enchanted Battlestaff of water
+	ENCHANTED_BATTLESTAFF_OF_AIR         = 684  // Enchanted Battlestaff of air
+	ENCHANTED_BATTLESTAFF_OF_EARTH       = 685  // Enchanted Battlestaff of earth
+	SCORPION_CAGE_686                    = 686  // scorpion cage
+	SCORPION_CAGE_687                    = 687  // scorpion cage
+	SCORPION_CAGE_688                    = 688  // scorpion cage
+	SCORPION_CAGE_689                    = 689  // scorpion cage
+	GOLD_690                             = 690  // gold
+	GOLD_BAR_691                         = 691  // gold bar
+	RUBY_RING_692                        = 692  // Ruby ring
+	RUBY_NECKLACE_693                    = 693  // Ruby necklace
+	FAMILY_CREST                         = 694  // Family crest
+	CREST_FRAGMENT                       = 695  // Crest fragment
+	CREST_FRAGMENT_696                   = 696  // Crest fragment
+	CREST_FRAGMENT_697                   = 697  // Crest fragment
+	STEEL_GAUNTLETS                      = 698  // Steel gauntlets
+	GAUNTLETS_OF_GOLDSMITHING            = 699  // gauntlets of goldsmithing
+	GAUNTLETS_OF_COOKING                 = 700  // gauntlets of cooking
+	GAUNTLETS_OF_CHAOS                   = 701  // gauntlets of chaos
+	ROBE_OF_ZAMORAK                      = 702  // robe of Zamorak
+	ROBE_OF_ZAMORAK_703                  = 703  // robe of Zamorak
+	ADDRESS_LABEL                        = 704  // Address Label
+	TRIBAL_TOTEM                         = 705  // Tribal totem
+	TOURIST_GUIDE                        = 706  // tourist guide
+	SPICE                                = 707  // spice
+	UNCOOKED_CURRY                       = 708  // Uncooked curry
+	CURRY                                = 709  // curry
+	BURNT_CURRY                          = 710  // Burnt curry
+	YEW_LOGS_CERTIFICATE                 = 711  // yew logs certificate
+	MAPLE_LOGS_CERTIFICATE               = 712  // maple logs certificate
+	WILLOW_LOGS_CERTIFICATE              = 713  // willow logs certificate
+	LOCKPICK                             = 714  // lockpick
+	RED_VINE_WORMS                       = 715  // Red vine worms
+	BLANKET                              = 716  // Blanket
+	RAW_GIANT_CARP                       = 717  // Raw giant carp
+	GIANT_CARP                           = 718  // giant Carp
+	FISHING_COMPETITION_PASS             = 719  // Fishing competition Pass
+	HEMENSTER_FISHING_TROPHY             = 720  // Hemenster fishing trophy
+	PENDANT_OF_LUCIEN                    = 721  // Pendant of Lucien
+	BOOTS_OF_LIGHTFOOTEDNESS             = 722  // Boots of lightfootedness
+	ICE_ARROWS                           = 723  // Ice Arrows
+	LEVER                                = 724  // Lever
+	STAFF_OF_ARMADYL                     = 725  // Staff of Armadyl
+	PENDANT_OF_ARMADYL                   = 726  // Pendant of Armadyl
+	LARGE_COG                            = 727  // Large cog
+	LARGE_COG_728                        = 728  // Large cog
+	LARGE_COG_729                        = 729  // Large cog
+	LARGE_COG_730                        = 730  // Large cog
+	RAT_POISON                           = 731  // Rat Poison
+	SHINY_KEY                            = 732  // shiny Key
+	KHAZARD_HELMET                       = 733  // khazard Helmet
+	KHAZARD_CHAINMAIL                    = 734  // khazard chainmail
+	KHALI_BREW                           = 735  // khali brew
+	KHAZARD_CELL_KEYS                    = 736  // khazard cell keys
+	POISON_CHALICE                       = 737  // Poison chalice
+	MAGIC_WHISTLE                        = 738  // magic whistle
+	CUP_OF_TEA                           = 739  // Cup of tea
+	ORB_OF_PROTECTION                    = 740  // orb of protection
+	ORBS_OF_PROTECTION                   = 741  // orbs of protection
+	HOLY_TABLE_NAPKIN                    = 742  // Holy table napkin
+	BELL                                 = 743  // bell
+	GNOME_EMERALD_AMULET_OF_PROTECTION   = 744  // Gnome Emerald Amulet of protection
+	MAGIC_GOLDEN_FEATHER                 = 745  // magic golden feather
+	HOLY_GRAIL                           = 746  // Holy grail
+	SCRIPT_OF_HAZEEL                     = 747  // Script of Hazeel
+	PINEAPPLE                            = 748  // Pineapple
+	PINEAPPLE_RING                       = 749  // Pineapple ring
+	PINEAPPLE_PIZZA                      = 750  // Pineapple Pizza
+	HALF_PINEAPPLE_PIZZA                 = 751  // Half pineapple Pizza
+	MAGIC_SCROLL                         = 752  // Magic scroll
+	MARK_OF_HAZEEL                       = 753  // Mark of Hazeel
+	BLOODY_AXE_OF_ZAMORAK                = 754  // bloody axe of zamorak
+	CARNILLEAN_ARMOUR                    = 755  // carnillean armour
+	CARNILLEAN_KEY                       = 756  // Carnillean Key
+	CATTLE_PROD                          = 757  // Cattle prod
+	PLAGUED_SHEEP_REMAINS                = 758  // Plagued sheep remains
+	POISONED_ANIMAL_FEED                 = 759  // Poisoned animal feed
+	PROTECTIVE_JACKET                    = 760  // Protective jacket
+	PROTECTIVE_TROUSERS                  = 761  // Protective trousers
+	PLAGUED_SHEEP_REMAINS_762            = 762  // Plagued sheep remains
+	PLAGUED_SHEEP_REMAINS_763            = 763  // Plagued sheep remains
+	PLAGUED_SHEEP_REMAINS_764            = 764  // Plagued sheep remains
+	DWELLBERRIES                         = 765  // dwellberries
+	GASMASK                              = 766  // Gasmask
+	PICTURE                              = 767  // picture
+	BOOK_768                             = 768  // Book
+	SEASLUG                              = 769  // Seaslug
+	CHOCOLATY_MILK                       = 770  // chocolaty milk
+	HANGOVER_CURE                        = 771  // Hangover cure
+	CHOCOLATE_DUST                       = 772  // Chocolate dust
+	TORCH                                = 773  // Torch
+	TORCH_774                            = 774  // Torch
+	WARRANT                              = 775  // warrant
+	DAMP_STICKS                          = 776  // Damp sticks
+	DRY_STICKS                           = 777  // Dry sticks
+	BROKEN_GLASS                         = 778  // Broken glass
+	OYSTER_PEARLS                        = 779  // oyster pearls
+	LITTLE_KEY                           = 780  // little key
+	SCRUFFY_NOTE                         = 781  // Scruffy note
+	GLARIAL_S_AMULET                     = 782  // Glarial's amulet
+	SWAMP_TAR                            = 783  // Swamp tar
+	UNCOOKED_SWAMP_PASTE                 = 784  // Uncooked Swamp paste
+	SWAMP_PASTE                          = 785  // Swamp paste
+	OYSTER_PEARL_BOLTS                   = 786  // Oyster pearl bolts
+	GLARIALS_PEBBLE                      = 787  // Glarials pebble
+	BOOK_ON_BAXTORIAN                    = 788  // book on baxtorian
+	LARGE_KEY                            = 789  // large key
+	OYSTER_PEARL_BOLT_TIPS               = 790  // Oyster pearl bolt tips
+	OYSTER                               = 791  // oyster
+	OYSTER_PEARLS_792                    = 792  // oyster pearls
+	OYSTER_793                           = 793  // oyster
+	SOIL                                 = 794  // Soil
+	DRAGON_MEDIUM_HELMET                 = 795  // Dragon medium Helmet
+	MITHRIL_SEED                         = 796  // Mithril seed
+	AN_OLD_KEY                           = 797  // An old key
+	PIGEON_CAGE                          = 798  // pigeon cage
+	MESSENGER_PIGEONS                    = 799  // Messenger pigeons
+	BIRD_FEED                            = 800  // Bird feed
+	ROTTEN_APPLES                        = 801  // Rotten apples
+	DOCTORS_GOWN                         = 802  // Doctors gown
+	BRONZE_KEY_803                       = 803  // Bronze key
+	DISTILLATOR                          = 804  // Distillator
+	GLARIAL_S_URN                        = 805  // Glarial's urn
+	GLARIAL_S_URN_806                    = 806  // Glarial's urn
+	PRIEST_ROBE                          = 807  // Priest robe
+	PRIEST_GOWN                          = 808  // Priest gown
+	LIQUID_HONEY                         = 809  // Liquid Honey
+	ETHENEA                              = 810  // Ethenea
+	SULPHURIC_BROLINE                    = 811  // Sulphuric Broline
+	PLAGUE_SAMPLE                        = 812  // Plague sample
+	TOUCH_PAPER                          = 813  // Touch paper
+	DRAGON_BONES                         = 814  // Dragon Bones
+	HERB_815                             = 815  // Herb
+	SNAKE_WEED                           = 816  // Snake Weed
+	HERB_817                             = 817  // Herb
+	ARDRIGAL                             = 818  // Ardrigal
+	HERB_819                             = 819  // Herb
+	SITO_FOIL                            = 820  // Sito Foil
+	HERB_821                             = 821  // Herb
+	VOLENCIA_MOSS                        = 822  // Volencia Moss
+	HERB_823                             = 823  // Herb
+	ROGUES_PURSE                         = 824  // Rogues Purse
+	SOUL_RUNE                            = 825  // Soul-Rune
+	KING_LATHAS_AMULET                   = 826  // king lathas Amulet
+	BRONZE_SPEAR                         = 827  // Bronze Spear
+	HALLOWEEN_MASK                       = 828  // halloween mask
+	DRAGON_BITTER                        = 829  // Dragon bitter
+	GREENMANS_ALE                        = 830  // Greenmans ale
+	HALLOWEEN_MASK_831                   = 831  // halloween mask
+	HALLOWEEN_MASK_832                   = 832  // halloween mask
+	COCKTAIL_GLASS                       = 833  // cocktail glass
+	COCKTAIL_SHAKER                      = 834  // cocktail shaker
+	BONE_KEY                             = 835  // Bone Key
+	GNOME_ROBE                           = 836  // gnome robe
+	GNOME_ROBE_837                       = 837  // gnome robe
+	GNOME_ROBE_838                       = 838  // gnome robe
+	GNOME_ROBE_839                       = 839  // gnome robe
+	GNOME_ROBE_840                       = 840  // gnome robe
+	GNOMESHAT                            = 841  // gnomeshat
+	GNOMESHAT_842                        = 842  // gnomeshat
+	GNOMESHAT_843                        = 843  // gnomeshat
+	GNOMESHAT_844                        = 844  // gnomeshat
+	GNOMESHAT_845                        = 845  // gnomeshat
+	GNOME_TOP                            = 846  // gnome top
+	GNOME_TOP_847                        = 847  // gnome top
+	GNOME_TOP_848                        = 848  // gnome top
+	GNOME_TOP_849                        = 849  // gnome top
+	GNOME_TOP_850                        = 850  // gnome top
+	GNOME_COCKTAIL_GUIDE                 = 851  // gnome cocktail guide
+	BEADS_OF_THE_DEAD                    = 852  // Beads of the dead
+	COCKTAIL_GLASS_853                   = 853  // cocktail glass
+	COCKTAIL_GLASS_854                   = 854  // cocktail glass
+	LEMON                                = 855  // lemon
+	LEMON_SLICES                         = 856  // lemon slices
+	ORANGE                               = 857  // orange
+	ORANGE_SLICES                        = 858  // orange slices
+	DICED_ORANGE                         = 859  // Diced orange
+	DICED_LEMON                          = 860  // Diced lemon
+	FRESH_PINEAPPLE                      = 861  // Fresh Pineapple
+	PINEAPPLE_CHUNKS                     = 862  // Pineapple chunks
+	LIME                                 = 863  // lime
+	LIME_CHUNKS                          = 864  // lime chunks
+	LIME_SLICES                          = 865  // lime slices
+	FRUIT_BLAST                          = 866  // fruit blast
+	ODD_LOOKING_COCKTAIL                 = 867  // odd looking cocktail
+	WHISKY_868                           = 868  // Whisky
+	VODKA                                = 869  // vodka
+	GIN                                  = 870  // gin
+	CREAM                                = 871  // cream
+	DRUNK_DRAGON                         = 872  // Drunk dragon
+	EQUA_LEAVES                          = 873  // Equa leaves
+	SGG                                  = 874  // SGG
+	CHOCOLATE_SATURDAY                   = 875  // Chocolate saturday
+	BRANDY                               = 876  // brandy
+	BLURBERRY_SPECIAL                    = 877  // blurberry special
+	WIZARD_BLIZZARD                      = 878  // wizard blizzard
+	PINEAPPLE_PUNCH                      = 879  // pineapple punch
+	GNOMEBATTA_DOUGH                     = 880  // gnomebatta dough
+	GIANNE_DOUGH                         = 881  // gianne dough
+	GNOMEBOWL_DOUGH                      = 882  // gnomebowl dough
+	GNOMECRUNCHIE_DOUGH                  = 883  // gnomecrunchie dough
+	GNOMEBATTA                           = 884  // gnomebatta
+	GNOMEBOWL                            = 885  // gnomebowl
+	GNOMEBATTA_886                       = 886  // gnomebatta
+	GNOMECRUNCHIE                        = 887  // gnomecrunchie
+	GNOMEBOWL_888                        = 888  // gnomebowl
+	UNCUT_RED_TOPAZ                      = 889  // Uncut Red Topaz
+	UNCUT_JADE                           = 890  // Uncut Jade
+	UNCUT_OPAL                           = 891  // Uncut Opal
+	RED_TOPAZ                            = 892  // Red Topaz
+	JADE                                 = 893  // Jade
+	OPAL                                 = 894  // Opal
+	SWAMP_TOAD                           = 895  // Swamp Toad
+	TOAD_LEGS                            = 896  // Toad legs
+	KING_WORM                            = 897  // King worm
+	GNOME_SPICE                          = 898  // Gnome spice
+	GIANNE_COOK_BOOK                     = 899  // gianne cook book
+	GNOMECRUNCHIE_900                    = 900  // gnomecrunchie
+	CHEESE_AND_TOMATO_BATTA              = 901  // cheese and tomato batta
+	TOAD_BATTA                           = 902  // toad batta
+	GNOME_BATTA                          = 903  // gnome batta
+	WORM_BATTA                           = 904  // worm batta
+	FRUIT_BATTA                          = 905  // fruit batta
+	VEG_BATTA                            = 906  // Veg batta
+	CHOCOLATE_BOMB                       = 907  // Chocolate bomb
+	VEGBALL                              = 908  // Vegball
+	WORM_HOLE                            = 909  // worm hole
+	TANGLED_TOADS_LEGS                   = 910  // Tangled toads legs
+	CHOC_CRUNCHIES                       = 911  // Choc crunchies
+	WORM_CRUNCHIES                       = 912  // Worm crunchies
+	TOAD_CRUNCHIES                       = 913  // Toad crunchies
+	SPICE_CRUNCHIES                      = 914  // Spice crunchies
+	CRUSHED_GEMSTONE                     = 915  // Crushed Gemstone
+	BLURBERRY_BADGE                      = 916  // Blurberry badge
+	GIANNE_BADGE                         = 917  // Gianne badge
+	TREE_GNOME_TRANSLATION               = 918  // tree gnome translation
+	BARK_SAMPLE                          = 919  // Bark sample
+	WAR_SHIP                             = 920  // War ship
+	GLOUGHS_JOURNAL                      = 921  // gloughs journal
+	INVOICE                              = 922  // invoice
+	UGTHANKI_KEBAB                       = 923  // Ugthanki Kebab
+	SPECIAL_CURRY                        = 924  // special curry
+	GLOUGH_S_KEY                         = 925  // glough's key
+	GLOUGH_S_NOTES                       = 926  // glough's notes
+	PEBBLE                               = 927  // Pebble
+	PEBBLE_928                           = 928  // Pebble
+	PEBBLE_929                           = 929  // Pebble
+	PEBBLE_930                           = 930  // Pebble
+	DACONIA_ROCK                         = 931  // Daconia rock
+	SINISTER_KEY                         = 932  // Sinister key
+	HERB_933                             = 933  // Herb
+	TORSTOL                              = 934  // Torstol
+	UNFINISHED_POTION_935                = 935  // Unfinished potion
+	JANGERBERRIES                        = 936  // Jangerberries
+	FRUIT_BLAST_937                      = 937  // fruit blast
+	BLURBERRY_SPECIAL_938                = 938  // blurberry special
+	WIZARD_BLIZZARD_939                  = 939  // wizard blizzard
+	PINEAPPLE_PUNCH_940                  = 940  // pineapple punch
+	SGG_941                              = 941  // SGG
+	CHOCOLATE_SATURDAY_942               = 942  // Chocolate saturday
+	DRUNK_DRAGON_943                     = 943  // Drunk dragon
+	CHEESE_AND_TOMATO_BATTA_944          = 944  // cheese and tomato batta
+	TOAD_BATTA_945                       = 945  // toad batta
+	GNOME_BATTA_946                      = 946  // gnome batta
+	WORM_BATTA_947                       = 947  // worm batta
+	FRUIT_BATTA_948                      = 948  // fruit batta
+	VEG_BATTA_949                        = 949  // Veg batta
+	CHOCOLATE_BOMB_950                   = 950  // Chocolate bomb
+	VEGBALL_951                          = 951  // Vegball
+	WORM_HOLE_952                        = 952  // worm hole
+	TANGLED_TOADS_LEGS_953               = 953  // Tangled toads legs
+	CHOC_CRUNCHIES_954                   = 954  // Choc crunchies
+	WORM_CRUNCHIES_955                   = 955  // Worm crunchies
+	TOAD_CRUNCHIES_956                   = 956  // Toad crunchies
+	SPICE_CRUNCHIES_957                  = 957  // Spice crunchies
+	STONE_PLAQUE                         = 958  // Stone-Plaque
+	TATTERED_SCROLL                      = 959  // Tattered Scroll
+	CRUMPLED_SCROLL                      = 960  // Crumpled Scroll
+	BERVIRIUS_TOMB_NOTES                 = 961  // Bervirius Tomb Notes
+	ZADIMUS_CORPSE                       = 962  // Zadimus Corpse
+	POTION_OF_ZAMORAK                    = 963  // Potion of Zamorak
+	POTION_OF_ZAMORAK_964                = 964  // Potion of Zamorak
+	POTION_OF_ZAMORAK_965                = 965  // Potion of Zamorak
+	BOOTS_966                            = 966  // Boots
+	BOOTS_967                            = 967  // Boots
+	BOOTS_968                            = 968  // Boots
+	BOOTS_969                            = 969  // Boots
+	BOOTS_970                            = 970  // Boots
+	SANTA_S_HAT                          = 971  // Santa's hat
+	LOCATING_CRYSTAL                     = 972  // Locating Crystal
+	SWORD_POMMEL                         = 973  // Sword Pommel
+	BONE_SHARD                           = 974  // Bone Shard
+	STEEL_WIRE                           = 975  // Steel Wire
+	BONE_BEADS                           = 976  // Bone Beads
+	RASHILIYA_CORPSE                     = 977  // Rashiliya Corpse
+	RESETCRYSTAL                         = 978  // ResetCrystal
+	BRONZE_WIRE                          = 979  // Bronze Wire
+	PRESENT                              = 980  // Present
+	GNOME_BALL                           = 981  // Gnome Ball
+	PAPYRUS                              = 982  // Papyrus
+	A_LUMP_OF_CHARCOAL                   = 983  // A lump of Charcoal
+	ARROW                                = 984  // Arrow
+	LIT_ARROW                            = 985  // Lit Arrow
+	ROCKS                                = 986  // Rocks
+	PARAMAYA_REST_TICKET                 = 987  // Paramaya Rest Ticket
+	SHIP_TICKET                          = 988  // Ship Ticket
+	DAMP_CLOTH                           = 989  // Damp cloth
+	DESERT_BOOTS                         = 990  // Desert Boots
+	ORB_OF_LIGHT                         = 991  // Orb of light
+	ORB_OF_LIGHT_992                     = 992  // Orb of light
+	ORB_OF_LIGHT_993                     = 993  // Orb of light
+	ORB_OF_LIGHT_994                     = 994  // Orb of light
+	RAILING                              = 995  // Railing
+	RANDAS_S_JOURNAL                     = 996  // Randas's journal
+	UNICORN_HORN_997                     = 997  // Unicorn horn
+	COAT_OF_ARMS                         = 998  // Coat of Arms
+	COAT_OF_ARMS_999                     = 999  // Coat of Arms
+	STAFF_OF_IBAN                        = 1000 // Staff of Iban
+	DWARF_BREW                           = 1001 // Dwarf brew
+	IBANS_ASHES                          = 1002 // Ibans Ashes
+	CAT                                  = 1003 // Cat
+	A_DOLL_OF_IBAN                       = 1004 // A Doll of Iban
+	OLD_JOURNAL                          = 1005 // Old Journal
+	KLANK_S_GAUNTLETS                    = 1006 // Klank's gauntlets
+	IBAN_S_SHADOW                        = 1007 // Iban's shadow
+	IBAN_S_CONSCIENCE                    = 1008 // Iban's conscience
+	AMULET_OF_OTHAINIAN                  = 1009 // Amulet of Othainian
+	AMULET_OF_DOOMION                    = 1010 // Amulet of Doomion
+	AMULET_OF_HOLTHION                   = 1011 // Amulet of Holthion
+	KEEP_KEY                             = 1012 // keep key
+	BRONZE_THROWING_DART                 = 1013 // Bronze Throwing Dart
+	PROTOTYPE_THROWING_DART              = 1014 // Prototype Throwing Dart
+	IRON_THROWING_DART                   = 1015 // Iron Throwing Dart
+	FULL_WATER_SKIN                      = 1016 // Full Water Skin
+	LENS_MOULD                           = 1017 // Lens mould
+	LENS                                 = 1018 // Lens
+	DESERT_ROBE                          = 1019 // Desert Robe
+	DESERT_SHIRT                         = 1020 // Desert Shirt
+	METAL_KEY                            = 1021 // Metal Key
+	SLAVES_ROBE_BOTTOM                   = 1022 // Slaves Robe Bottom
+	SLAVES_ROBE_TOP                      = 1023 // Slaves Robe Top
+	STEEL_THROWING_DART                  = 1024 // Steel Throwing Dart
+	ASTROLOGY_BOOK                       = 1025 // Astrology Book
+	UNHOLY_SYMBOL_MOULD                  = 1026 // Unholy Symbol mould
+	UNHOLY_SYMBOL_OF_ZAMORAK             = 1027 // Unholy Symbol of Zamorak
+	UNBLESSED_UNHOLY_SYMBOL_OF_ZAMORAK   = 1028 // Unblessed Unholy Symbol of Zamorak
+	UNHOLY_SYMBOL_OF_ZAMORAK_1029        = 1029 // Unholy Symbol of Zamorak
+	SHANTAY_DESERT_PASS                  = 1030 // Shantay Desert Pass
+	STAFF_OF_IBAN_1031                   = 1031 // Staff of Iban
+	DWARF_CANNON_BASE                    = 1032 // Dwarf cannon base
+	DWARF_CANNON_STAND                   = 1033 // Dwarf cannon stand
+	DWARF_CANNON_BARRELS                 = 1034 // Dwarf cannon barrels
+	DWARF_CANNON_FURNACE                 = 1035 // Dwarf cannon furnace
+	FINGERNAILS                          = 1036 // Fingernails
+	POWERING_CRYSTAL1                    = 1037 // Powering crystal1
+	MINING_BARREL                        = 1038 // Mining Barrel
+	ANA_IN_A_BARREL                      = 1039 // Ana in a Barrel
+	STOLEN_GOLD                          = 1040 // Stolen gold
+	MULTI_CANNON_BALL                    = 1041 // multi cannon ball
+	RAILING_1042                         = 1042 // Railing
+	OGRE_TOOTH                           = 1043 // Ogre tooth
+	OGRE_RELIC                           = 1044 // Ogre relic
+	SKAVID_MAP                           = 1045 // Skavid map
+	DWARF_REMAINS                        = 1046 // dwarf remains
+	KEY_1047                             = 1047 // Key
+	OGRE_RELIC_PART                      = 1048 // Ogre relic part
+	OGRE_RELIC_PART_1049                 = 1049 // Ogre relic part
+	OGRE_RELIC_PART_1050                 = 1050 // Ogre relic part
+	GROUND_BAT_BONES                     = 1051 // Ground bat bones
+	UNFINISHED_POTION_1052               = 1052 // Unfinished potion
+	OGRE_POTION                          = 1053 // Ogre potion
+	MAGIC_OGRE_POTION                    = 1054 // Magic ogre potion
+	TOOL_KIT                             = 1055 // Tool kit
+	NULODION_S_NOTES                     = 1056 // Nulodion's notes
+	CANNON_AMMO_MOULD                    = 1057 // cannon ammo mould
+	TENTI_PINEAPPLE                      = 1058 // Tenti Pineapple
+	BEDOBIN_COPY_KEY                     = 1059 // Bedobin Copy Key
+	TECHNICAL_PLANS                      = 1060 // Technical Plans
+	ROCK_CAKE                            = 1061 // Rock cake
+	BRONZE_DART_TIPS                     = 1062 // Bronze dart tips
+	IRON_DART_TIPS                       = 1063 // Iron dart tips
+	STEEL_DART_TIPS                      = 1064 // Steel dart tips
+	MITHRIL_DART_TIPS                    = 1065 // Mithril dart tips
+	ADAMANTITE_DART_TIPS                 = 1066 // Adamantite dart tips
+	RUNE_DART_TIPS                       = 1067 // Rune dart tips
+	MITHRIL_THROWING_DART                = 1068 // Mithril Throwing Dart
+	ADAMANTITE_THROWING_DART             = 1069 // Adamantite Throwing Dart
+	RUNE_THROWING_DART                   = 1070 // Rune Throwing Dart
+	PROTOTYPE_DART_TIP                   = 1071 // Prototype dart tip
+	INFO_DOCUMENT                        = 1072 // info document
+	INSTRUCTION_MANUAL                   = 1073 // Instruction manual
+	UNFINISHED_POTION_1074               = 1074 // Unfinished potion
+	IRON_THROWING_KNIFE                  = 1075 // Iron throwing knife
+	BRONZE_THROWING_KNIFE                = 1076 // Bronze throwing knife
+	STEEL_THROWING_KNIFE                 = 1077 // Steel throwing knife
+	MITHRIL_THROWING_KNIFE               = 1078 // Mithril throwing knife
+	ADAMANTITE_THROWING_KNIFE            = 1079 // Adamantite throwing knife
+	RUNE_THROWING_KNIFE                  = 1080 // Rune throwing knife
+	BLACK_THROWING_KNIFE                 = 1081 // Black throwing knife
+	WATER_SKIN_MOSTLY_FULL               = 1082 // Water Skin mostly full
+	WATER_SKIN_MOSTLY_EMPTY              = 1083 // Water Skin mostly empty
+	WATER_SKIN_MOUTHFUL_LEFT             = 1084 // Water Skin mouthful left
+	EMPTY_WATER_SKIN                     = 1085 // Empty Water Skin
+	NIGHTSHADE                           = 1086 // nightshade
+	SHAMAN_ROBE                          = 1087 // Shaman robe
+	IRON_SPEAR                           = 1088 // Iron Spear
+	STEEL_SPEAR                          = 1089 // Steel Spear
+	MITHRIL_SPEAR                        = 1090 // Mithril Spear
+	ADAMANTITE_SPEAR                     = 1091 // Adamantite Spear
+	RUNE_SPEAR                           = 1092 // Rune Spear
+	CAT_1093                             = 1093 // Cat
+	SEASONED_SARDINE                     = 1094 // Seasoned Sardine
+	KITTENS                              = 1095 // Kittens
+	KITTEN                               = 1096 // Kitten
+	WROUGHT_IRON_KEY                     = 1097 // Wrought iron key
+	CELL_DOOR_KEY                        = 1098 // Cell Door Key
+	A_FREE_SHANTAY_DISCLAIMER            = 1099 // A free Shantay Disclaimer
+	DOOGLE_LEAVES                        = 1100 // Doogle leaves
+	RAW_UGTHANKI_MEAT                    = 1101 // Raw Ugthanki Meat
+	TASTY_UGTHANKI_KEBAB                 = 1102 // Tasty Ugthanki Kebab
+	COOKED_UGTHANKI_MEAT                 = 1103 // Cooked Ugthanki Meat
+	UNCOOKED_PITTA_BREAD                 = 1104 // Uncooked Pitta Bread
+	PITTA_BREAD                          = 1105 // Pitta Bread
+	TOMATO_MIXTURE                       = 1106 // Tomato Mixture
+	ONION_MIXTURE                        = 1107 // Onion Mixture
+	ONION_AND_TOMATO_MIXTURE             = 1108 // Onion and Tomato Mixture
+	ONION_AND_TOMATO_AND_UGTHANKI_MIX    = 1109 // Onion and Tomato and Ugthanki Mix
+	BURNT_PITTA_BREAD                    = 1110 // Burnt Pitta Bread
+	PANNING_TRAY                         = 1111 // Panning tray
+	PANNING_TRAY_1112                    = 1112 // Panning tray
+	PANNING_TRAY_1113                    = 1113 // Panning tray
+	ROCK_PICK                            = 1114 // Rock pick
+	SPECIMEN_BRUSH                       = 1115 // Specimen brush
+	SPECIMEN_JAR                         = 1116 // Specimen jar
+	ROCK_SAMPLE                          = 1117 // Rock Sample
+	GOLD_NUGGETS                         = 1118 // gold Nuggets
+	CAT_1119                             = 1119 // cat
+	SCRUMPLED_PIECE_OF_PAPER             = 1120 // Scrumpled piece of paper
+	DIGSITE_INFO                         = 1121 // Digsite info
+	POISONED_BRONZE_THROWING_DART        = 1122 // Poisoned Bronze Throwing Dart
+	POISONED_IRON_THROWING_DART          = 1123 // Poisoned Iron Throwing Dart
+	POISONED_STEEL_THROWING_DART         = 1124 // Poisoned Steel Throwing Dart
+	POISONED_MITHRIL_THROWING_DART       = 1125 // Poisoned Mithril Throwing Dart
+	POISONED_ADAMANTITE_THROWING_DART    = 1126 // Poisoned Adamantite Throwing Dart
+	POISONED_RUNE_THROWING_DART          = 1127 // Poisoned Rune Throwing Dart
+	POISONED_BRONZE_THROWING_KNIFE       = 1128 // Poisoned Bronze throwing knife
+	POISONED_IRON_THROWING_KNIFE         = 1129 // Poisoned Iron throwing knife
+	POISONED_STEEL_THROWING_KNIFE        = 1130 // Poisoned Steel throwing knife
+	POISONED_MITHRIL_THROWING_KNIFE      = 1131 // Poisoned Mithril throwing knife
+	POISONED_BLACK_THROWING_KNIFE        = 1132 // Poisoned Black throwing knife
+	POISONED_ADAMANTITE_THROWING_KNIFE   = 1133 // Poisoned Adamantite throwing knife
+	POISONED_RUNE_THROWING_KNIFE         = 1134 // Poisoned Rune throwing knife
+	POISONED_BRONZE_SPEAR                = 1135 // Poisoned Bronze Spear
+	POISONED_IRON_SPEAR                  = 1136 // Poisoned Iron Spear
+	POISONED_STEEL_SPEAR                 = 1137 // Poisoned Steel Spear
+	POISONED_MITHRIL_SPEAR               = 1138 // Poisoned Mithril Spear
+	POISONED_ADAMANTITE_SPEAR            = 1139 // Poisoned Adamantite Spear
+	POISONED_RUNE_SPEAR                  = 1140 // Poisoned Rune Spear
+	BOOK_OF_EXPERIMENTAL_CHEMISTRY       = 1141 // Book of experimental chemistry
+	LEVEL_1_CERTIFICATE                  = 1142 // Level 1 Certificate
+	LEVEL_2_CERTIFICATE                  = 1143 // Level 2 Certificate
+	LEVEL_3_CERTIFICATE                  = 1144 // Level 3 Certificate
+	TROWEL                               = 1145 // Trowel
+	STAMPED_LETTER_OF_RECOMMENDATION     = 1146 // Stamped letter of recommendation
+	UNSTAMPED_LETTER_OF_RECOMMENDATION   = 1147 // Unstamped letter of recommendation
+	ROCK_SAMPLE_1148                     = 1148 // Rock Sample
+	ROCK_SAMPLE_1149                     = 1149 // Rock Sample
+	CRACKED_ROCK_SAMPLE                  = 1150 // Cracked rock Sample
+	BELT_BUCKLE                          = 1151 // Belt buckle
+	POWERING_CRYSTAL2                    = 1152 // Powering crystal2
+	POWERING_CRYSTAL3                    = 1153 // Powering crystal3
+	POWERING_CRYSTAL4                    = 1154 // Powering crystal4
+	OLD_BOOT                             = 1155 // Old boot
+	BUNNY_EARS                           = 1156 // Bunny ears
+	DAMAGED_ARMOUR                       = 1157 // Damaged armour
+	DAMAGED_ARMOUR_1158                  = 1158 // Damaged armour
+	RUSTY_SWORD                          = 1159 // Rusty sword
+	AMMONIUM_NITRATE                     = 1160 // Ammonium Nitrate
+	NITROGLYCERIN                        = 1161 // Nitroglycerin
+	OLD_TOOTH                            = 1162 // Old tooth
+	RADIMUS_SCROLLS                      = 1163 // Radimus Scrolls
+	CHEST_KEY_1164                       = 1164 // chest key
+	BROKEN_ARROW                         = 1165 // broken arrow
+	BUTTONS                              = 1166 // buttons
+	BROKEN_STAFF                         = 1167 // broken staff
+	VASE                                 = 1168 // vase
+	CERAMIC_REMAINS                      = 1169 // ceramic remains
+	BROKEN_GLASS_1170                    = 1170 // Broken glass
+	UNIDENTIFIED_POWDER                  = 1171 // Unidentified powder
+	MACHETTE                             = 1172 // Machette
+	SCROLL_1173                          = 1173 // Scroll
+	STONE_TABLET                         = 1174 // stone tablet
+	TALISMAN_OF_ZAROS                    = 1175 // Talisman of Zaros
+	EXPLOSIVE_COMPOUND                   = 1176 // Explosive compound
+	BULL_ROARER                          = 1177 // Bull Roarer
+	MIXED_CHEMICALS                      = 1178 // Mixed chemicals
+	GROUND_CHARCOAL                      = 1179 // Ground charcoal
+	MIXED_CHEMICALS_1180                 = 1180 // Mixed chemicals
+	SPELL_SCROLL                         = 1181 // Spell scroll
+	YOMMI_TREE_SEED                      = 1182 // Yommi tree seed
+	TOTEM_POLE                           = 1183 // Totem Pole
+	DWARF_CANNON_BASE_1184               = 1184 // Dwarf cannon base
+	DWARF_CANNON_STAND_1185              = 1185 // Dwarf cannon stand
+	DWARF_CANNON_BARRELS_1186            = 1186 // Dwarf cannon barrels
+	DWARF_CANNON_FURNACE_1187            = 1187 // Dwarf cannon furnace
+	GOLDEN_BOWL                          = 1188 // Golden Bowl
+	GOLDEN_BOWL_WITH_PURE_WATER          = 1189 // Golden Bowl with pure water
+	RAW_MANTA_RAY                        = 1190 // Raw Manta ray
+	MANTA_RAY                            = 1191 // Manta ray
+	RAW_SEA_TURTLE                       = 1192 // Raw Sea turtle
+	SEA_TURTLE                           = 1193 // Sea turtle
+	ANNAS_SILVER_NECKLACE                = 1194 // Annas Silver Necklace
+	BOBS_SILVER_TEACUP                   = 1195 // Bobs Silver Teacup
+	CAROLS_SILVER_BOTTLE                 = 1196 // Carols Silver Bottle
+	DAVIDS_SILVER_BOOK                   = 1197 // Davids Silver Book
+	ELIZABETHS_SILVER_NEEDLE             = 1198 // Elizabeths Silver Needle
+	FRANKS_SILVER_POT                    = 1199 // Franks Silver Pot
+	THREAD_1200                          = 1200 // Thread
+	THREAD_1201                          = 1201 // Thread
+	THREAD_1202                          = 1202 // Thread
+	FLYPAPER                             = 1203 // Flypaper
+	MURDER_SCENE_POT                     = 1204 // Murder Scene Pot
+	A_SILVER_DAGGER                      = 1205 // A Silver Dagger
+	MURDERERS_FINGERPRINT                = 1206 // Murderers fingerprint
+	ANNAS_FINGERPRINT                    = 1207 // Annas fingerprint
+	BOBS_FINGERPRINT                     = 1208 // Bobs fingerprint
+	CAROLS_FINGERPRINT                   = 1209 // Carols fingerprint
+	DAVIDS_FINGERPRINT                   = 1210 // Davids fingerprint
+	ELIZABETHS_FINGERPRINT               = 1211 // Elizabeths fingerprint
+	FRANKS_FINGERPRINT                   = 1212 // Franks fingerprint
+	ZAMORAK_CAPE                         = 1213 // Zamorak Cape
+	SARADOMIN_CAPE                       = 1214 // Saradomin Cape
+	GUTHIX_CAPE                          = 1215 // Guthix Cape
+	STAFF_OF_ZAMORAK                     = 1216 // Staff of zamorak
+	STAFF_OF_GUTHIX                      = 1217 // Staff of guthix
+	STAFF_OF_SARADOMIN                   = 1218 // Staff of Saradomin
+	A_CHUNK_OF_CRYSTAL                   = 1219 // A chunk of crystal
+	A_LUMP_OF_CRYSTAL                    = 1220 // A lump of crystal
+	A_HUNK_OF_CRYSTAL                    = 1221 // A hunk of crystal
+	A_RED_CRYSTAL                        = 1222 // A red crystal
+	UNIDENTIFIED_FINGERPRINT             = 1223 // Unidentified fingerprint
+	ANNAS_SILVER_NECKLACE_1224           = 1224 // Annas Silver Necklace
+	BOBS_SILVER_TEACUP_1225              = 1225 // Bobs Silver Teacup
+	CAROLS_SILVER_BOTTLE_1226            = 1226 // Carols Silver Bottle
+	DAVIDS_SILVER_BOOK_1227              = 1227 // Davids Silver Book
+	ELIZABETHS_SILVER_NEEDLE_1228        = 1228 // Elizabeths Silver Needle
+	FRANKS_SILVER_POT_1229               = 1229 // Franks Silver Pot
+	A_SILVER_DAGGER_1230                 = 1230 // A Silver Dagger
+	A_GLOWING_RED_CRYSTAL                = 1231 // A glowing red crystal
+	UNIDENTIFIED_LIQUID                  = 1232 // Unidentified liquid
+	RADIMUS_SCROLLS_1233                 = 1233 // Radimus Scrolls
+	ROBE                                 = 1234 // Robe
+	ARMOUR                               = 1235 // Armour
+	DAGGER                               = 1236 // Dagger
+	EYE_PATCH_1237                       = 1237 // eye patch
+	BOOKING_OF_BINDING                   = 1238 // Booking of Binding
+	HOLY_WATER_VIAL                      = 1239 // Holy Water Vial
+	ENCHANTED_VIAL                       = 1240 // Enchanted Vial
+	SCRIBBLED_NOTES                      = 1241 // Scribbled notes
+	SCRAWLED_NOTES                       = 1242 // Scrawled notes
+	SCATCHED_NOTES                       = 1243 // Scatched notes
+	SHAMANS_TOME                         = 1244 // Shamans Tome
+	EDIBLE_SEAWEED                       = 1245 // Edible seaweed
+	ROUGH_SKETCH_OF_A_BOWL               = 1246 // Rough Sketch of a bowl
+	BURNT_MANTA_RAY                      = 1247 // Burnt Manta ray
+	BURNT_SEA_TURTLE                     = 1248 // Burnt Sea turtle
+	CUT_REED_PLANT                       = 1249 // Cut reed plant
+	MAGICAL_FIRE_PASS                    = 1250 // Magical Fire Pass
+	SNAKES_WEED_SOLUTION                 = 1251 // Snakes Weed Solution
+	ARDRIGAL_SOLUTION                    = 1252 // Ardrigal Solution
+	GUJUO_POTION                         = 1253 // Gujuo Potion
+	GERMINATED_YOMMI_TREE_SEED           = 1254 // Germinated Yommi tree seed
+	DARK_DAGGER                          = 1255 // Dark Dagger
+	GLOWING_DARK_DAGGER                  = 1256 // Glowing Dark Dagger
+	HOLY_FORCE_SPELL                     = 1257 // Holy Force Spell
+	IRON_PICKAXE                         = 1258 // Iron Pickaxe
+	STEEL_PICKAXE                        = 1259 // Steel Pickaxe
+	MITHRIL_PICKAXE                      = 1260 // Mithril Pickaxe
+	ADAMANTITE_PICKAXE                   = 1261 // Adamantite Pickaxe
+	RUNE_PICKAXE                         = 1262 // Rune Pickaxe
+	SLEEPING_BAG                         = 1263 // Sleeping Bag
+	A_BLUE_WIZARDS_HAT                   = 1264 // A blue wizards hat
+	GILDED_TOTEM_POLE                    = 1265 // Gilded Totem Pole
+	BLESSED_GOLDEN_BOWL                  = 1266 // Blessed Golden Bowl
+	BLESSED_GOLDEN_BOWL_WITH_PURE_WATER  = 1267 // Blessed Golden Bowl with Pure Water
+	RAW_OOMLIE_MEAT                      = 1268 // Raw Oomlie Meat
+	COOKED_OOMLIE_MEAT_PARCEL            = 1269 // Cooked Oomlie meat Parcel
+	DRAGON_BONE_CERTIFICATE              = 1270 // Dragon Bone Certificate
+	LIMPWURT_ROOT_CERTIFICATE            = 1271 // Limpwurt Root Certificate
+	PRAYER_POTION_CERTIFICATE            = 1272 // Prayer Potion Certificate
+	SUPER_ATTACK_POTION_CERTIFICATE      = 1273 // Super Attack Potion Certificate
+	SUPER_DEFENSE_POTION_CERTIFICATE     = 1274 // Super Defense Potion Certificate
+	SUPER_STRENGTH_POTION_CERTIFICATE    = 1275 // Super Strength Potion Certificate
+	HALF_DRAGON_SQUARE_SHIELD            = 1276 // Half Dragon Square Shield
+	HALF_DRAGON_SQUARE_SHIELD_1277       = 1277 // Half Dragon Square Shield
+	DRAGON_SQUARE_SHIELD                 = 1278 // Dragon Square Shield
+	PALM_TREE_LEAF                       = 1279 // Palm tree leaf
+	RAW_OOMLIE_MEAT_PARCEL               = 1280 // Raw Oomlie Meat Parcel
+	BURNT_OOMLIE_MEAT_PARCEL             = 1281 // Burnt Oomlie Meat parcel
+	BAILING_BUCKET                       = 1282 // Bailing Bucket
+	PLANK_1283                           = 1283 // Plank
+	ARCENIA_ROOT                         = 1284 // Arcenia root
+	DISPLAY_TEA                          = 1285 // display tea
+	BLESSED_GOLDEN_BOWL_WITH_PLAIN_WATER = 1286 // Blessed Golden Bowl with plain water
+	GOLDEN_BOWL_WITH_PLAIN_WATER         = 1287 // Golden Bowl with plain water
+	CAPE_OF_LEGENDS                      = 1288 // Cape of legends
+	SCYTHE                               = 1289 // Scythe
+)
+
+// Items Maps the name of every item constant, without any prefix, to its id.
+var Items = map[string]int{
+	"IRON_MACE":                            IRON_MACE,
+	"IRON_SHORT_SWORD":                     IRON_SHORT_SWORD,
+	"IRON_KITE_SHIELD":                     IRON_KITE_SHIELD,
+	"IRON_SQUARE_SHIELD":                   IRON_SQUARE_SHIELD,
+	"WOODEN_SHIELD":                        WOODEN_SHIELD,
+	"MEDIUM_IRON_HELMET":                   MEDIUM_IRON_HELMET,
+	"LARGE_IRON_HELMET":                    LARGE_IRON_HELMET,
+	"IRON_CHAIN_MAIL_BODY":                 IRON_CHAIN_MAIL_BODY,
+	"IRON_PLATE_MAIL_BODY":                 IRON_PLATE_MAIL_BODY,
+	"IRON_PLATE_MAIL_LEGS":                 IRON_PLATE_MAIL_LEGS,
+	"COINS":                                COINS,
+	"BRONZE_ARROWS":                        BRONZE_ARROWS,
+	"IRON_AXE":                             IRON_AXE,
+	"KNIFE":                                KNIFE,
+	"LOGS":                                 LOGS,
+	"LEATHER_ARMOUR":                       LEATHER_ARMOUR,
+	"LEATHER_GLOVES":                       LEATHER_GLOVES,
+	"BOOTS":                                BOOTS,
+	"CABBAGE":                              CABBAGE,
+	"EGG":                                  EGG,
+	"BONES":                                BONES,
+	"BUCKET":                               BUCKET,
+	"MILK":                                 MILK,
+	"FLOUR":                                FLOUR,
+	"AMULET_OF_GHOSTSPEAK":                 AMULET_OF_GHOSTSPEAK,
+	"SILVERLIGHT_KEY_1":                    SILVERLIGHT_KEY_1,
+	"SILVERLIGHT_KEY_2":                    SILVERLIGHT_KEY_2,
+	"SKULL":                                SKULL,
+	"IRON_DAGGER":                          IRON_DAGGER,
+	"GRAIN":                                GRAIN,
+	"BOOK":                                 BOOK,
+	"FIRE_RUNE":                            FIRE_RUNE,
+	"WATER_RUNE":                           WATER_RUNE,
+	"AIR_RUNE":                             AIR_RUNE,
+	"EARTH_RUNE":                           EARTH_RUNE,
+	"MIND_RUNE":                            MIND_RUNE,
+	"BODY_RUNE":                            BODY_RUNE,
+	"LIFE_RUNE":                            LIFE_RUNE,
+	"DEATH_RUNE":                           DEATH_RUNE,
+	"NEEDLE":                               NEEDLE,
+	"NATURE_RUNE":                          NATURE_RUNE,
+	"CHAOS_RUNE":                           CHAOS_RUNE,
+	"LAW_RUNE":                             LAW_RUNE,
+	"THREAD":                               THREAD,
+	"HOLY_SYMBOL_OF_SARADOMIN":             HOLY_SYMBOL_OF_SARADOMIN,
+	"UNBLESSED_HOLY_SYMBOL":                UNBLESSED_HOLY_SYMBOL,
+	"COSMIC_RUNE":                          COSMIC_RUNE,
+	"KEY":                                  KEY,
+	"KEY_48":                               KEY_48,
+	"SCROLL":                               SCROLL,
+	"WATER":                                WATER,
+	"SILVERLIGHT_KEY_3":                    SILVERLIGHT_KEY_3,
+	"SILVERLIGHT":                          SILVERLIGHT,
+	"BROKEN_SHIELD":                        BROKEN_SHIELD,
+	"BROKEN_SHIELD_54":                     BROKEN_SHIELD_54,
+	"CADAVABERRIES":                        CADAVABERRIES,
+	"MESSAGE":                              MESSAGE,
+	"CADAVA":                               CADAVA,
+	"POTION":                               POTION,
+	"PHOENIX_CROSSBOW":                     PHOENIX_CROSSBOW,
+	"CROSSBOW":                             CROSSBOW,
+	"CERTIFICATE":                          CERTIFICATE,
+	"BRONZE_DAGGER":                        BRONZE_DAGGER,
+	"STEEL_DAGGER":                         STEEL_DAGGER,
+	"MITHRIL_DAGGER":                       MITHRIL_DAGGER,
+	"ADAMANTITE_DAGGER":                    ADAMANTITE_DAGGER,
+	"BRONZE_SHORT_SWORD":                   BRONZE_SHORT_SWORD,
+	"STEEL_SHORT_SWORD":                    STEEL_SHORT_SWORD,
+	"MITHRIL_SHORT_SWORD":                  MITHRIL_SHORT_SWORD,
+	"ADAMANTITE_SHORT_SWORD":               ADAMANTITE_SHORT_SWORD,
+	"BRONZE_LONG_SWORD":                    BRONZE_LONG_SWORD,
+	"IRON_LONG_SWORD":                      IRON_LONG_SWORD,
+	"STEEL_LONG_SWORD":                     STEEL_LONG_SWORD,
+	"MITHRIL_LONG_SWORD":                   MITHRIL_LONG_SWORD,
+	"ADAMANTITE_LONG_SWORD":                ADAMANTITE_LONG_SWORD,
+	"RUNE_LONG_SWORD":                      RUNE_LONG_SWORD,
+	"BRONZE_2_HANDED_SWORD":                BRONZE_2_HANDED_SWORD,
+	"IRON_2_HANDED_SWORD":                  IRON_2_HANDED_SWORD,
+	"STEEL_2_HANDED_SWORD":                 STEEL_2_HANDED_SWORD,
+	"MITHRIL_2_HANDED_SWORD":               MITHRIL_2_HANDED_SWORD,
+	"ADAMANTITE_2_HANDED_SWORD":            ADAMANTITE_2_HANDED_SWORD,
+	"RUNE_2_HANDED_SWORD":                  RUNE_2_HANDED_SWORD,
+	"BRONZE_SCIMITAR":                      BRONZE_SCIMITAR,
+	"IRON_SCIMITAR":                        IRON_SCIMITAR,
+	"STEEL_SCIMITAR":                       STEEL_SCIMITAR,
+	"MITHRIL_SCIMITAR":                     MITHRIL_SCIMITAR,
+	"ADAMANTITE_SCIMITAR":                  ADAMANTITE_SCIMITAR,
+	"BRONZE_AXE":                           BRONZE_AXE,
+	"STEEL_AXE":                            STEEL_AXE,
+	"IRON_BATTLE_AXE":                      IRON_BATTLE_AXE,
+	"STEEL_BATTLE_AXE":                     STEEL_BATTLE_AXE,
+	"MITHRIL_BATTLE_AXE":                   MITHRIL_BATTLE_AXE,
+	"ADAMANTITE_BATTLE_AXE":                ADAMANTITE_BATTLE_AXE,
+	"RUNE_BATTLE_AXE":                      RUNE_BATTLE_AXE,
+	"BRONZE_MACE":                          BRONZE_MACE,
+	"STEEL_MACE":                           STEEL_MACE,
+	"MITHRIL_MACE":                         MITHRIL_MACE,
+	"ADAMANTITE_MACE":                      ADAMANTITE_MACE,
+	"RUNE_MACE":                            RUNE_MACE,
+	"BRASS_KEY":                            BRASS_KEY,
+	"STAFF":                                STAFF,
+	"STAFF_OF_AIR":                         STAFF_OF_AIR,
+	"STAFF_OF_WATER":                       STAFF_OF_WATER,
+	"STAFF_OF_EARTH":                       STAFF_OF_EARTH,
+	"MEDIUM_BRONZE_HELMET":                 MEDIUM_BRONZE_HELMET,
+	"MEDIUM_STEEL_HELMET":                  MEDIUM_STEEL_HELMET,
+	"MEDIUM_MITHRIL_HELMET":                MEDIUM_MITHRIL_HELMET,
+	"MEDIUM_ADAMANTITE_HELMET":             MEDIUM_ADAMANTITE_HELMET,
+	"LARGE_BRONZE_HELMET":                  LARGE_BRONZE_HELMET,
+	"LARGE_STEEL_HELMET":                   LARGE_STEEL_HELMET,
+	"LARGE_MITHRIL_HELMET":                 LARGE_MITHRIL_HELMET,
+	"LARGE_ADAMANTITE_HELMET":              LARGE_ADAMANTITE_HELMET,
+	"LARGE_RUNE_HELMET":                    LARGE_RUNE_HELMET,
+	"BRONZE_CHAIN_MAIL_BODY":               BRONZE_CHAIN_MAIL_BODY,
+	"STEEL_CHAIN_MAIL_BODY":                STEEL_CHAIN_MAIL_BODY,
+	"MITHRIL_CHAIN_MAIL_BODY":              MITHRIL_CHAIN_MAIL_BODY,
+	"ADAMANTITE_CHAIN_MAIL_BODY":           ADAMANTITE_CHAIN_MAIL_BODY,
+	"BRONZE_PLATE_MAIL_BODY":               BRONZE_PLATE_MAIL_BODY,
+	"STEEL_PLATE_MAIL_BODY":                STEEL_PLATE_MAIL_BODY,
+	"MITHRIL_PLATE_MAIL_BODY":              MITHRIL_PLATE_MAIL_BODY,
+	"ADAMANTITE_PLATE_MAIL_BODY":           ADAMANTITE_PLATE_MAIL_BODY,
+	"STEEL_PLATE_MAIL_LEGS":                STEEL_PLATE_MAIL_LEGS,
+	"MITHRIL_PLATE_MAIL_LEGS":              MITHRIL_PLATE_MAIL_LEGS,
+	"ADAMANTITE_PLATE_MAIL_LEGS":           ADAMANTITE_PLATE_MAIL_LEGS,
+	"BRONZE_SQUARE_SHIELD":                 BRONZE_SQUARE_SHIELD,
+	"STEEL_SQUARE_SHIELD":                  STEEL_SQUARE_SHIELD,
+	"MITHRIL_SQUARE_SHIELD":                MITHRIL_SQUARE_SHIELD,
+	"ADAMANTITE_SQUARE_SHIELD":             ADAMANTITE_SQUARE_SHIELD,
+	"BRONZE_KITE_SHIELD":                   BRONZE_KITE_SHIELD,
+	"STEEL_KITE_SHIELD":                    STEEL_KITE_SHIELD,
+	"MITHRIL_KITE_SHIELD":                  MITHRIL_KITE_SHIELD,
+	"ADAMANTITE_KITE_SHIELD":               ADAMANTITE_KITE_SHIELD,
+	"COOKEDMEAT":                           COOKEDMEAT,
+	"RAW_CHICKEN":                          RAW_CHICKEN,
+	"BURNTMEAT":                            BURNTMEAT,
+	"POT":                                  POT,
+	"FLOUR_136":                            FLOUR_136,
+	"BREAD_DOUGH":                          BREAD_DOUGH,
+	"BREAD":                                BREAD,
+	"BURNTBREAD":                           BURNTBREAD,
+	"JUG":                                  JUG,
+	"WATER_141":                            WATER_141,
+	"WINE":                                 WINE,
+	"GRAPES":                               GRAPES,
+	"SHEARS":                               SHEARS,
+	"WOOL":                                 WOOL,
+	"FUR":                                  FUR,
+	"COW_HIDE":                             COW_HIDE,
+	"LEATHER":                              LEATHER,
+	"CLAY":                                 CLAY,
+	"COPPER_ORE":                           COPPER_ORE,
+	"IRON_ORE":                             IRON_ORE,
+	"GOLD":                                 GOLD,
+	"MITHRIL_ORE":                          MITHRIL_ORE,
+	"ADAMANTITE_ORE":                       ADAMANTITE_ORE,
+	"COAL":                                 COAL,
+	"BRONZE_PICKAXE":                       BRONZE_PICKAXE,
+	"UNCUT_DIAMOND":                        UNCUT_DIAMOND,
+	"UNCUT_RUBY":                           UNCUT_RUBY,
+	"UNCUT_EMERALD":                        UNCUT_EMERALD,
+	"UNCUT_SAPPHIRE":                       UNCUT_SAPPHIRE,
+	"DIAMOND":                              DIAMOND,
+	"RUBY":                                 RUBY,
+	"EMERALD":                              EMERALD,
+	"SAPPHIRE":                             SAPPHIRE,
+	"HERB":                                 HERB,
+	"TINDERBOX":                            TINDERBOX,
+	"CHISEL":                               CHISEL,
+	"HAMMER":                               HAMMER,
+	"BRONZE_BAR":                           BRONZE_BAR,
+	"IRON_BAR":                             IRON_BAR,
+	"STEEL_BAR":                            STEEL_BAR,
+	"GOLD_BAR":                             GOLD_BAR,
+	"MITHRIL_BAR":                          MITHRIL_BAR,
+	"ADAMANTITE_BAR":                       ADAMANTITE_BAR,
+	"PRESSURE_GAUGE":                       PRESSURE_GAUGE,
+	"FISH_FOOD":                            FISH_FOOD,
+	"POISON":                               POISON,
+	"POISONED_FISH_FOOD":                   POISONED_FISH_FOOD,
+	"SPINACH_ROLL":                         SPINACH_ROLL,
+	"BAD_WINE":                             BAD_WINE,
+	"ASHES":                                ASHES,
+	"APRON":                                APRON,
+	"CAPE":                                 CAPE,
+	"WIZARDS_ROBE":                         WIZARDS_ROBE,
+	"WIZARDSHAT":                           WIZARDSHAT,
+	"BRASS_NECKLACE":                       BRASS_NECKLACE,
+	"SKIRT":                                SKIRT,
+	"LONGBOW":                              LONGBOW,
+	"SHORTBOW":                             SHORTBOW,
+	"CROSSBOW_BOLTS":                       CROSSBOW_BOLTS,
+	"APRON_191":                            APRON_191,
+	"CHEF_S_HAT":                           CHEF_S_HAT,
+	"BEER":                                 BEER,
+	"SKIRT_194":                            SKIRT_194,
+	"SKIRT_195":                            SKIRT_195,
+	"BLACK_PLATE_MAIL_BODY":                BLACK_PLATE_MAIL_BODY,
+	"STAFF_OF_FIRE":                        STAFF_OF_FIRE,
+	"MAGIC_STAFF":                          MAGIC_STAFF,
+	"WIZARDSHAT_199":                       WIZARDSHAT_199,
+	"SILK":                                 SILK,
+	"FLIER":                                FLIER,
+	"TIN_ORE":                              TIN_ORE,
+	"MITHRIL_AXE":                          MITHRIL_AXE,
+	"ADAMANTITE_AXE":                       ADAMANTITE_AXE,
+	"BRONZE_BATTLE_AXE":                    BRONZE_BATTLE_AXE,
+	"BRONZE_PLATE_MAIL_LEGS":               BRONZE_PLATE_MAIL_LEGS,
+	"BALL_OF_WOOL":                         BALL_OF_WOOL,
+	"OIL_CAN":                              OIL_CAN,
+	"CAPE_209":                             CAPE_209,
+	"KEBAB":                                KEBAB,
+	"SPADE":                                SPADE,
+	"CLOSET_KEY":                           CLOSET_KEY,
+	"RUBBER_TUBE":                          RUBBER_TUBE,
+	"BRONZE_PLATED_SKIRT":                  BRONZE_PLATED_SKIRT,
+	"IRON_PLATED_SKIRT":                    IRON_PLATED_SKIRT,
+	"BLACK_ROBE":                           BLACK_ROBE,
+	"STAKE":                                STAKE,
+	"GARLIC":                               GARLIC,
+	"RED_SPIDERS_EGGS":                     RED_SPIDERS_EGGS,
+	"LIMPWURT_ROOT":                        LIMPWURT_ROOT,
+	"STRENGTH_POTION":                      STRENGTH_POTION,
+	"STRENGTH_POTION_222":                  STRENGTH_POTION_222,
+	"STRENGTH_POTION_223":                  STRENGTH_POTION_223,
+	"STRENGTH_POTION_224":                  STRENGTH_POTION_224,
+	"STEEL_PLATED_SKIRT":                   STEEL_PLATED_SKIRT,
+	"MITHRIL_PLATED_SKIRT":                 MITHRIL_PLATED_SKIRT,
+	"ADAMANTITE_PLATED_SKIRT":              ADAMANTITE_PLATED_SKIRT,
+	"CABBAGE_228":                          CABBAGE_228,
+	"CAPE_229":                             CAPE_229,
+	"LARGE_BLACK_HELMET":                   LARGE_BLACK_HELMET,
+	"RED_BEAD":                             RED_BEAD,
+	"YELLOW_BEAD":                          YELLOW_BEAD,
+	"BLACK_BEAD":                           BLACK_BEAD,
+	"WHITE_BEAD":                           WHITE_BEAD,
+	"AMULET_OF_ACCURACY":                   AMULET_OF_ACCURACY,
+	"REDBERRIES":                           REDBERRIES,
+	"ROPE":                                 ROPE,
+	"REDDYE":                               REDDYE,
+	"YELLOWDYE":                            YELLOWDYE,
+	"PASTE":                                PASTE,
+	"ONION":                                ONION,
+	"BRONZE_KEY":                           BRONZE_KEY,
+	"SOFT_CLAY":                            SOFT_CLAY,
+	"WIG":                                  WIG,
+	"WIG_245":                              WIG_245,
+	"HALF_FULL_WINE_JUG":                   HALF_FULL_WINE_JUG,
+	"KEYPRINT":                             KEYPRINT,
+	"BLACK_PLATE_MAIL_LEGS":                BLACK_PLATE_MAIL_LEGS,
+	"BANANA":                               BANANA,
+	"PASTRY_DOUGH":                         PASTRY_DOUGH,
+	"PIE_DISH":                             PIE_DISH,
+	"COOKING_APPLE":                        COOKING_APPLE,
+	"PIE_SHELL":                            PIE_SHELL,
+	"UNCOOKED_APPLE_PIE":                   UNCOOKED_APPLE_PIE,
+	"UNCOOKED_MEAT_PIE":                    UNCOOKED_MEAT_PIE,
+	"UNCOOKED_REDBERRY_PIE":                UNCOOKED_REDBERRY_PIE,
+	"APPLE_PIE":                            APPLE_PIE,
+	"REDBERRY_PIE":                         REDBERRY_PIE,
+	"MEAT_PIE":                             MEAT_PIE,
+	"BURNTPIE":                             BURNTPIE,
+	"HALF_A_MEAT_PIE":                      HALF_A_MEAT_PIE,
+	"HALF_A_REDBERRY_PIE":                  HALF_A_REDBERRY_PIE,
+	"HALF_AN_APPLE_PIE":                    HALF_AN_APPLE_PIE,
+	"PORTRAIT":                             PORTRAIT,
+	"FALADIAN_KNIGHT_S_SWORD":              FALADIAN_KNIGHT_S_SWORD,
+	"BLURITE_ORE":                          BLURITE_ORE,
+	"ASGARNIAN_ALE":                        ASGARNIAN_ALE,
+	"WIZARD_S_MIND_BOMB":                   WIZARD_S_MIND_BOMB,
+	"DWARVEN_STOUT":                        DWARVEN_STOUT,
+	"EYE_OF_NEWT":                          EYE_OF_NEWT,
+	"RAT_S_TAIL":                           RAT_S_TAIL,
+	"BLUEDYE":                              BLUEDYE,
+	"GOBLIN_ARMOUR":                        GOBLIN_ARMOUR,
+	"GOBLIN_ARMOUR_274":                    GOBLIN_ARMOUR_274,
+	"GOBLIN_ARMOUR_275":                    GOBLIN_ARMOUR_275,
+	"UNSTRUNG_LONGBOW":                     UNSTRUNG_LONGBOW,
+	"UNSTRUNG_SHORTBOW":                    UNSTRUNG_SHORTBOW,
+	"UNFIRED_PIE_DISH":                     UNFIRED_PIE_DISH,
+	"UNFIRED_POT":                          UNFIRED_POT,
+	"ARROW_SHAFTS":                         ARROW_SHAFTS,
+	"WOAD_LEAF":                            WOAD_LEAF,
+	"ORANGEDYE":                            ORANGEDYE,
+	"GOLD_RING":                            GOLD_RING,
+	"SAPPHIRE_RING":                        SAPPHIRE_RING,
+	"EMERALD_RING":                         EMERALD_RING,
+	"RUBY_RING":                            RUBY_RING,
+	"DIAMOND_RING":                         DIAMOND_RING,
+	"GOLD_NECKLACE":                        GOLD_NECKLACE,
+	"SAPPHIRE_NECKLACE":                    SAPPHIRE_NECKLACE,
+	"EMERALD_NECKLACE":                     EMERALD_NECKLACE,
+	"RUBY_NECKLACE":                        RUBY_NECKLACE,
+	"DIAMOND_NECKLACE":                     DIAMOND_NECKLACE,
+	"RING_MOULD":                           RING_MOULD,
+	"AMULET_MOULD":                         AMULET_MOULD,
+	"NECKLACE_MOULD":                       NECKLACE_MOULD,
+	"GOLD_AMULET":                          GOLD_AMULET,
+	"SAPPHIRE_AMULET":                      SAPPHIRE_AMULET,
+	"EMERALD_AMULET":                       EMERALD_AMULET,
+	"RUBY_AMULET":                          RUBY_AMULET,
+	"DIAMOND_AMULET":                       DIAMOND_AMULET,
+	"GOLD_AMULET_301":                      GOLD_AMULET_301,
+	"SAPPHIRE_AMULET_302":                  SAPPHIRE_AMULET_302,
+	"EMERALD_AMULET_303":                   EMERALD_AMULET_303,
+	"RUBY_AMULET_304":                      RUBY_AMULET_304,
+	"DIAMOND_AMULET_305":                   DIAMOND_AMULET_305,
+	"SUPERCHISEL":                          SUPERCHISEL,
+	"MACE_OF_ZAMORAK":                      MACE_OF_ZAMORAK,
+	"BRONZE_PLATE_MAIL_TOP":                BRONZE_PLATE_MAIL_TOP,
+	"STEEL_PLATE_MAIL_TOP":                 STEEL_PLATE_MAIL_TOP,
+	"MITHRIL_PLATE_MAIL_TOP":               MITHRIL_PLATE_MAIL_TOP,
+	"ADAMANTITE_PLATE_MAIL_TOP":            ADAMANTITE_PLATE_MAIL_TOP,
+	"IRON_PLATE_MAIL_TOP":                  IRON_PLATE_MAIL_TOP,
+	"BLACK_PLATE_MAIL_TOP":                 BLACK_PLATE_MAIL_TOP,
+	"SAPPHIRE_AMULET_OF_MAGIC":             SAPPHIRE_AMULET_OF_MAGIC,
+	"EMERALD_AMULET_OF_PROTECTION":         EMERALD_AMULET_OF_PROTECTION,
+	"RUBY_AMULET_OF_STRENGTH":              RUBY_AMULET_OF_STRENGTH,
+	"DIAMOND_AMULET_OF_POWER":              DIAMOND_AMULET_OF_POWER,
+	"KARAMJA_RUM":                          KARAMJA_RUM,
+	"CHEESE":                               CHEESE,
+	"TOMATO":                               TOMATO,
+	"PIZZA_BASE":                           PIZZA_BASE,
+	"BURNT_PIZZA":                          BURNT_PIZZA,
+	"INCOMPLETE_PIZZA":                     INCOMPLETE_PIZZA,
+	"UNCOOKED_PIZZA":                       UNCOOKED_PIZZA,
+	"PLAIN_PIZZA":                          PLAIN_PIZZA,
+	"MEAT_PIZZA":                           MEAT_PIZZA,
+	"ANCHOVIE_PIZZA":                       ANCHOVIE_PIZZA,
+	"HALF_MEAT_PIZZA":                      HALF_MEAT_PIZZA,
+	"HALF_ANCHOVIE_PIZZA":                  HALF_ANCHOVIE_PIZZA,
+	"CAKE":                                 CAKE,
+	"BURNT_CAKE":                           BURNT_CAKE,
+	"CHOCOLATE_CAKE":                       CHOCOLATE_CAKE,
+	"PARTIAL_CAKE":                         PARTIAL_CAKE,
+	"PARTIAL_CHOCOLATE_CAKE":               PARTIAL_CHOCOLATE_CAKE,
+	"SLICE_OF_CAKE":                        SLICE_OF_CAKE,
+	"CHOCOLATE_SLICE":                      CHOCOLATE_SLICE,
+	"CHOCOLATE_BAR":                        CHOCOLATE_BAR,
+	"CAKE_TIN":                             CAKE_TIN,
+	"UNCOOKED_CAKE":                        UNCOOKED_CAKE,
+	"UNFIRED_BOWL":                         UNFIRED_BOWL,
+	"BOWL":                                 BOWL,
+	"BOWL_OF_WATER":                        BOWL_OF_WATER,
+	"INCOMPLETE_STEW":                      INCOMPLETE_STEW,
+	"INCOMPLETE_STEW_344":                  INCOMPLETE_STEW_344,
+	"UNCOOKED_STEW":                        UNCOOKED_STEW,
+	"STEW":                                 STEW,
+	"BURNT_STEW":                           BURNT_STEW,
+	"POTATO":                               POTATO,
+	"RAW_SHRIMP":                           RAW_SHRIMP,
+	"SHRIMP":                               SHRIMP,
+	"RAW_ANCHOVIES":                        RAW_ANCHOVIES,
+	"ANCHOVIES":                            ANCHOVIES,
+	"BURNT_FISH":                           BURNT_FISH,
+	"RAW_SARDINE":                          RAW_SARDINE,
+	"SARDINE":                              SARDINE,
+	"RAW_SALMON":                           RAW_SALMON,
+	"SALMON":                               SALMON,
+	"RAW_TROUT":                            RAW_TROUT,
+	"TROUT":                                TROUT,
+	"BURNT_FISH_360":                       BURNT_FISH_360,
+	"RAW_HERRING":                          RAW_HERRING,
+	"HERRING":                              HERRING,
+	"RAW_PIKE":                             RAW_PIKE,
+	"PIKE":                                 PIKE,
+	"BURNT_FISH_365":                       BURNT_FISH_365,
+	"RAW_TUNA":                             RAW_TUNA,
+	"TUNA":                                 TUNA,
+	"BURNT_FISH_368":                       BURNT_FISH_368,
+	"RAW_SWORDFISH":                        RAW_SWORDFISH,
+	"SWORDFISH":                            SWORDFISH,
+	"BURNT_SWORDFISH":                      BURNT_SWORDFISH,
+	"RAW_LOBSTER":                          RAW_LOBSTER,
+	"LOBSTER":                              LOBSTER,
+	"BURNT_LOBSTER":                        BURNT_LOBSTER,
+	"LOBSTER_POT":                          LOBSTER_POT,
+	"NET":                                  NET,
+	"FISHING_ROD":                          FISHING_ROD,
+	"FLY_FISHING_ROD":                      FLY_FISHING_ROD,
+	"HARPOON":                              HARPOON,
+	"FISHING_BAIT":                         FISHING_BAIT,
+	"FEATHER":                              FEATHER,
+	"CHEST_KEY":                            CHEST_KEY,
+	"SILVER":                               SILVER,
+	"SILVER_BAR":                           SILVER_BAR,
+	"HOLY_SYMBOL_OF_SARADOMIN_385":         HOLY_SYMBOL_OF_SARADOMIN_385,
+	"HOLY_SYMBOL_MOULD":                    HOLY_SYMBOL_MOULD,
+	"DISK_OF_RETURNING":                    DISK_OF_RETURNING,
+	"MONKS_ROBE":                           MONKS_ROBE,
+	"MONKS_ROBE_389":                       MONKS_ROBE_389,
+	"RED_KEY":                              RED_KEY,
+	"ORANGE_KEY":                           ORANGE_KEY,
+	"YELLOW_KEY":                           YELLOW_KEY,
+	"BLUE_KEY":                             BLUE_KEY,
+	"MAGENTA_KEY":                          MAGENTA_KEY,
+	"BLACK_KEY":                            BLACK_KEY,
+	"RUNE_DAGGER":                          RUNE_DAGGER,
+	"RUNE_SHORT_SWORD":                     RUNE_SHORT_SWORD,
+	"RUNE_SCIMITAR":                        RUNE_SCIMITAR,
+	"MEDIUM_RUNE_HELMET":                   MEDIUM_RUNE_HELMET,
+	"RUNE_CHAIN_MAIL_BODY":                 RUNE_CHAIN_MAIL_BODY,
+	"RUNE_PLATE_MAIL_BODY":                 RUNE_PLATE_MAIL_BODY,
+	"RUNE_PLATE_MAIL_LEGS":                 RUNE_PLATE_MAIL_LEGS,
+	"RUNE_SQUARE_SHIELD":                   RUNE_SQUARE_SHIELD,
+	"RUNE_KITE_SHIELD":                     RUNE_KITE_SHIELD,
+	"RUNE_AXE":                             RUNE_AXE,
+	"RUNE_SKIRT":                           RUNE_SKIRT,
+	"RUNE_PLATE_MAIL_TOP":                  RUNE_PLATE_MAIL_TOP,
+	"RUNITE_BAR":                           RUNITE_BAR,
+	"RUNITE_ORE":                           RUNITE_ORE,
+	"PLANK":                                PLANK,
+	"TILE":                                 TILE,
+	"SKULL_412":                            SKULL_412,
+	"BIG_BONES":                            BIG_BONES,
+	"MUDDY_KEY":                            MUDDY_KEY,
+	"MAP":                                  MAP,
+	"MAP_PIECE":                            MAP_PIECE,
+	"MAP_PIECE_417":                        MAP_PIECE_417,
+	"MAP_PIECE_418":                        MAP_PIECE_418,
+	"NAILS":                                NAILS,
+	"ANTI_DRAGON_BREATH_SHIELD":            ANTI_DRAGON_BREATH_SHIELD,
+	"MAZE_KEY":                             MAZE_KEY,
+	"PUMPKIN":                              PUMPKIN,
+	"BLACK_DAGGER":                         BLACK_DAGGER,
+	"BLACK_SHORT_SWORD":                    BLACK_SHORT_SWORD,
+	"BLACK_LONG_SWORD":                     BLACK_LONG_SWORD,
+	"BLACK_2_HANDED_SWORD":                 BLACK_2_HANDED_SWORD,
+	"BLACK_SCIMITAR":                       BLACK_SCIMITAR,
+	"BLACK_AXE":                            BLACK_AXE,
+	"BLACK_BATTLE_AXE":                     BLACK_BATTLE_AXE,
+	"BLACK_MACE":                           BLACK_MACE,
+	"BLACK_CHAIN_MAIL_BODY":                BLACK_CHAIN_MAIL_BODY,
+	"BLACK_SQUARE_SHIELD":                  BLACK_SQUARE_SHIELD,
+	"BLACK_KITE_SHIELD":                    BLACK_KITE_SHIELD,
+	"BLACK_PLATED_SKIRT":                   BLACK_PLATED_SKIRT,
+	"HERB_435":                             HERB_435,
+	"HERB_436":                             HERB_436,
+	"HERB_437":                             HERB_437,
+	"HERB_438":                             HERB_438,
+	"HERB_439":                             HERB_439,
+	"HERB_440":                             HERB_440,
+	"HERB_441":                             HERB_441,
+	"HERB_442":                             HERB_442,
+	"HERB_443":                             HERB_443,
+	"GUAM_LEAF":                            GUAM_LEAF,
+	"MARRENTILL":                           MARRENTILL,
+	"TARROMIN":                             TARROMIN,
+	"HARRALANDER":                          HARRALANDER,
+	"RANARR_WEED":                          RANARR_WEED,
+	"IRIT_LEAF":                            IRIT_LEAF,
+	"AVANTOE":                              AVANTOE,
+	"KWUARM":                               KWUARM,
+	"CADANTINE":                            CADANTINE,
+	"DWARF_WEED":                           DWARF_WEED,
+	"UNFINISHED_POTION":                    UNFINISHED_POTION,
+	"UNFINISHED_POTION_455":                UNFINISHED_POTION_455,
+	"UNFINISHED_POTION_456":                UNFINISHED_POTION_456,
+	"UNFINISHED_POTION_457":                UNFINISHED_POTION_457,
+	"UNFINISHED_POTION_458":                UNFINISHED_POTION_458,
+	"UNFINISHED_POTION_459":                UNFINISHED_POTION_459,
+	"UNFINISHED_POTION_460":                UNFINISHED_POTION_460,
+	"UNFINISHED_POTION_461":                UNFINISHED_POTION_461,
+	"UNFINISHED_POTION_462":                UNFINISHED_POTION_462,
+	"UNFINISHED_POTION_463":                UNFINISHED_POTION_463,
+	"VIAL":                                 VIAL,
+	"VIAL_465":                             VIAL_465,
+	"UNICORN_HORN":                         UNICORN_HORN,
+	"BLUE_DRAGON_SCALE":                    BLUE_DRAGON_SCALE,
+	"PESTLE_AND_MORTAR":                    PESTLE_AND_MORTAR,
+	"SNAPE_GRASS":                          SNAPE_GRASS,
+	"MEDIUM_BLACK_HELMET":                  MEDIUM_BLACK_HELMET,
+	"WHITE_BERRIES":                        WHITE_BERRIES,
+	"GROUND_BLUE_DRAGON_SCALE":             GROUND_BLUE_DRAGON_SCALE,
+	"GROUND_UNICORN_HORN":                  GROUND_UNICORN_HORN,
+	"ATTACK_POTION":                        ATTACK_POTION,
+	"ATTACK_POTION_475":                    ATTACK_POTION_475,
+	"ATTACK_POTION_476":                    ATTACK_POTION_476,
+	"STAT_RESTORATION_POTION":              STAT_RESTORATION_POTION,
+	"STAT_RESTORATION_POTION_478":          STAT_RESTORATION_POTION_478,
+	"STAT_RESTORATION_POTION_479":          STAT_RESTORATION_POTION_479,
+	"DEFENSE_POTION":                       DEFENSE_POTION,
+	"DEFENSE_POTION_481":                   DEFENSE_POTION_481,
+	"DEFENSE_POTION_482":                   DEFENSE_POTION_482,
+	"RESTORE_PRAYER_POTION":                RESTORE_PRAYER_POTION,
+	"RESTORE_PRAYER_POTION_484":            RESTORE_PRAYER_POTION_484,
+	"RESTORE_PRAYER_POTION_485":            RESTORE_PRAYER_POTION_485,
+	"SUPER_ATTACK_POTION":                  SUPER_ATTACK_POTION,
+	"SUPER_ATTACK_POTION_487":              SUPER_ATTACK_POTION_487,
+	"SUPER_ATTACK_POTION_488":              SUPER_ATTACK_POTION_488,
+	"FISHING_POTION":                       FISHING_POTION,
+	"FISHING_POTION_490":                   FISHING_POTION_490,
+	"FISHING_POTION_491":                   FISHING_POTION_491,
+	"SUPER_STRENGTH_POTION":                SUPER_STRENGTH_POTION,
+	"SUPER_STRENGTH_POTION_493":            SUPER_STRENGTH_POTION_493,
+	"SUPER_STRENGTH_POTION_494":            SUPER_STRENGTH_POTION_494,
+	"SUPER_DEFENSE_POTION":                 SUPER_DEFENSE_POTION,
+	"SUPER_DEFENSE_POTION_496":             SUPER_DEFENSE_POTION_496,
+	"SUPER_DEFENSE_POTION_497":             SUPER_DEFENSE_POTION_497,
+	"RANGING_POTION":                       RANGING_POTION,
+	"RANGING_POTION_499":                   RANGING_POTION_499,
+	"RANGING_POTION_500":                   RANGING_POTION_500,
+	"WINE_OF_ZAMORAK":                      WINE_OF_ZAMORAK,
+	"RAW_BEAR_MEAT":                        RAW_BEAR_MEAT,
+	"RAW_RAT_MEAT":                         RAW_RAT_MEAT,
+	"RAW_BEEF":                             RAW_BEEF,
+	"ENCHANTED_BEAR_MEAT":                  ENCHANTED_BEAR_MEAT,
+	"ENCHANTED_RAT_MEAT":                   ENCHANTED_RAT_MEAT,
+	"ENCHANTED_BEEF":                       ENCHANTED_BEEF,
+	"ENCHANTED_CHICKEN_MEAT":               ENCHANTED_CHICKEN_MEAT,
+	"DRAMEN_STAFF":                         DRAMEN_STAFF,
+	"DRAMEN_BRANCH":                        DRAMEN_BRANCH,
+	"CAPE_511":                             CAPE_511,
+	"CAPE_512":                             CAPE_512,
+	"CAPE_513":                             CAPE_513,
+	"CAPE_514":                             CAPE_514,
+	"GREENDYE":                             GREENDYE,
+	"PURPLEDYE":                            PURPLEDYE,
+	"IRON_ORE_CERTIFICATE":                 IRON_ORE_CERTIFICATE,
+	"COAL_CERTIFICATE":                     COAL_CERTIFICATE,
+	"MITHRIL_ORE_CERTIFICATE":              MITHRIL_ORE_CERTIFICATE,
+	"SILVER_CERTIFICATE":                   SILVER_CERTIFICATE,
+	"GOLD_CERTIFICATE":                     GOLD_CERTIFICATE,
+	"DRAGONSTONE_AMULET":                   DRAGONSTONE_AMULET,
+	"DRAGONSTONE":                          DRAGONSTONE,
+	"DRAGONSTONE_AMULET_524":               DRAGONSTONE_AMULET_524,
+	"CRYSTAL_KEY":                          CRYSTAL_KEY,
+	"HALF_OF_A_KEY":                        HALF_OF_A_KEY,
+	"HALF_OF_A_KEY_527":                    HALF_OF_A_KEY_527,
+	"IRON_BAR_CERTIFICATE":                 IRON_BAR_CERTIFICATE,
+	"STEEL_BAR_CERTIFICATE":                STEEL_BAR_CERTIFICATE,
+	"MITHRIL_BAR_CERTIFICATE":              MITHRIL_BAR_CERTIFICATE,
+	"SILVER_BAR_CERTIFICATE":               SILVER_BAR_CERTIFICATE,
+	"GOLD_BAR_CERTIFICATE":                 GOLD_BAR_CERTIFICATE,
+	"LOBSTER_CERTIFICATE":                  LOBSTER_CERTIFICATE,
+	"RAW_LOBSTER_CERTIFICATE":              RAW_LOBSTER_CERTIFICATE,
+	"SWORDFISH_CERTIFICATE":                SWORDFISH_CERTIFICATE,
+	"RAW_SWORDFISH_CERTIFICATE":            RAW_SWORDFISH_CERTIFICATE,
+	"DIARY":                                DIARY,
+	"FRONT_DOOR_KEY":                       FRONT_DOOR_KEY,
+	"BALL":                                 BALL,
+	"MAGNET":                               MAGNET,
+	"GREY_WOLF_FUR":                        GREY_WOLF_FUR,
+	"UNCUT_DRAGONSTONE":                    UNCUT_DRAGONSTONE,
+	"DRAGONSTONE_RING":                     DRAGONSTONE_RING,
+	"DRAGONSTONE_NECKLACE":                 DRAGONSTONE_NECKLACE,
+	"RAW_SHARK":                            RAW_SHARK,
+	"SHARK":                                SHARK,
+	"BURNT_SHARK":                          BURNT_SHARK,
+	"BIG_NET":                              BIG_NET,
+	"CASKET":                               CASKET,
+	"RAW_COD":                              RAW_COD,
+	"COD":                                  COD,
+	"RAW_MACKEREL":                         RAW_MACKEREL,
+	"MACKEREL":                             MACKEREL,
+	"RAW_BASS":                             RAW_BASS,
+	"BASS":                                 BASS,
+	"ICE_GLOVES":                           ICE_GLOVES,
+	"FIREBIRD_FEATHER":                     FIREBIRD_FEATHER,
+	"FIREBIRD_FEATHER_558":                 FIREBIRD_FEATHER_558,
+	"POISONED_IRON_DAGGER":                 POISONED_IRON_DAGGER,
+	"POISONED_BRONZE_DAGGER":               POISONED_BRONZE_DAGGER,
+	"POISONED_STEEL_DAGGER":                POISONED_STEEL_DAGGER,
+	"POISONED_MITHRIL_DAGGER":              POISONED_MITHRIL_DAGGER,
+	"POISONED_RUNE_DAGGER":                 POISONED_RUNE_DAGGER,
+	"POISONED_ADAMANTITE_DAGGER":           POISONED_ADAMANTITE_DAGGER,
+	"POISONED_BLACK_DAGGER":                POISONED_BLACK_DAGGER,
+	"CURE_POISON_POTION":                   CURE_POISON_POTION,
+	"CURE_POISON_POTION_567":               CURE_POISON_POTION_567,
+	"CURE_POISON_POTION_568":               CURE_POISON_POTION_568,
+	"POISON_ANTIDOTE":                      POISON_ANTIDOTE,
+	"POISON_ANTIDOTE_570":                  POISON_ANTIDOTE_570,
+	"POISON_ANTIDOTE_571":                  POISON_ANTIDOTE_571,
+	"WEAPON_POISON":                        WEAPON_POISON,
+	"ID_PAPER":                             ID_PAPER,
+	"POISON_BRONZE_ARROWS":                 POISON_BRONZE_ARROWS,
+	"CHRISTMAS_CRACKER":                    CHRISTMAS_CRACKER,
+	"PARTY_HAT":                            PARTY_HAT,
+	"PARTY_HAT_577":                        PARTY_HAT_577,
+	"PARTY_HAT_578":                        PARTY_HAT_578,
+	"PARTY_HAT_579":                        PARTY_HAT_579,
+	"PARTY_HAT_580":                        PARTY_HAT_580,
+	"PARTY_HAT_581":                        PARTY_HAT_581,
+	"MISCELLANEOUS_KEY":                    MISCELLANEOUS_KEY,
+	"BUNCH_OF_KEYS":                        BUNCH_OF_KEYS,
+	"WHISKY":                               WHISKY,
+	"CANDLESTICK":                          CANDLESTICK,
+	"MASTER_THIEF_ARMBAND":                 MASTER_THIEF_ARMBAND,
+	"BLAMISH_SNAIL_SLIME":                  BLAMISH_SNAIL_SLIME,
+	"BLAMISH_OIL":                          BLAMISH_OIL,
+	"OILY_FISHING_ROD":                     OILY_FISHING_ROD,
+	"LAVA_EEL":                             LAVA_EEL,
+	"RAW_LAVA_EEL":                         RAW_LAVA_EEL,
+	"POISON_CROSSBOW_BOLTS":                POISON_CROSSBOW_BOLTS,
+	"DRAGON_SWORD":                         DRAGON_SWORD,
+	"DRAGON_AXE":                           DRAGON_AXE,
+	"JAIL_KEYS":                            JAIL_KEYS,
+	"DUSTY_KEY":                            DUSTY_KEY,
+	"CHARGED_DRAGONSTONE_AMULET":           CHARGED_DRAGONSTONE_AMULET,
+	"GROG":                                 GROG,
+	"CANDLE":                               CANDLE,
+	"BLACK_CANDLE":                         BLACK_CANDLE,
+	"CANDLE_601":                           CANDLE_601,
+	"BLACK_CANDLE_602":                     BLACK_CANDLE_602,
+	"INSECT_REPELLANT":                     INSECT_REPELLANT,
+	"BAT_BONES":                            BAT_BONES,
+	"WAX_BUCKET":                           WAX_BUCKET,
+	"EXCALIBUR":                            EXCALIBUR,
+	"DRUIDS_ROBE":                          DRUIDS_ROBE,
+	"DRUIDS_ROBE_608":                      DRUIDS_ROBE_608,
+	"EYE_PATCH":                            EYE_PATCH,
+	"UNENCHANTED_DRAGONSTONE_AMULET":       UNENCHANTED_DRAGONSTONE_AMULET,
+	"UNPOWERED_ORB":                        UNPOWERED_ORB,
+	"FIRE_ORB":                             FIRE_ORB,
+	"WATER_ORB":                            WATER_ORB,
+	"BATTLESTAFF":                          BATTLESTAFF,
+	"BATTLESTAFF_OF_FIRE":                  BATTLESTAFF_OF_FIRE,
+	"BATTLESTAFF_OF_WATER":                 BATTLESTAFF_OF_WATER,
+	"BATTLESTAFF_OF_AIR":                   BATTLESTAFF_OF_AIR,
+	"BATTLESTAFF_OF_EARTH":                 BATTLESTAFF_OF_EARTH,
+	"BLOOD_RUNE":                           BLOOD_RUNE,
+	"BEER_GLASS":                           BEER_GLASS,
+	"GLASSBLOWING_PIPE":                    GLASSBLOWING_PIPE,
+	"SEAWEED":                              SEAWEED,
+	"MOLTEN_GLASS":                         MOLTEN_GLASS,
+	"SODA_ASH":                             SODA_ASH,
+	"SAND":                                 SAND,
+	"AIR_ORB":                              AIR_ORB,
+	"EARTH_ORB":                            EARTH_ORB,
+	"BASS_CERTIFICATE":                     BASS_CERTIFICATE,
+	"RAW_BASS_CERTIFICATE":                 RAW_BASS_CERTIFICATE,
+	"SHARK_CERTIFICATE":                    SHARK_CERTIFICATE,
+	"RAW_SHARK_CERTIFICATE":                RAW_SHARK_CERTIFICATE,
+	"OAK_LOGS":                             OAK_LOGS,
+	"WILLOW_LOGS":                          WILLOW_LOGS,
+	"MAPLE_LOGS":                           MAPLE_LOGS,
+	"YEW_LOGS":                             YEW_LOGS,
+	"MAGIC_LOGS":                           MAGIC_LOGS,
+	"HEADLESS_ARROWS":                      HEADLESS_ARROWS,
+	"IRON_ARROWS":                          IRON_ARROWS,
+	"POISON_IRON_ARROWS":                   POISON_IRON_ARROWS,
+	"STEEL_ARROWS":                         STEEL_ARROWS,
+	"POISON_STEEL_ARROWS":                  POISON_STEEL_ARROWS,
+	"MITHRIL_ARROWS":                       MITHRIL_ARROWS,
+	"POISON_MITHRIL_ARROWS":                POISON_MITHRIL_ARROWS,
+	"ADAMANTITE_ARROWS":                    ADAMANTITE_ARROWS,
+	"POISON_ADAMANTITE_ARROWS":             POISON_ADAMANTITE_ARROWS,
+	"RUNE_ARROWS":                          RUNE_ARROWS,
+	"POISON_RUNE_ARROWS":                   POISON_RUNE_ARROWS,
+	"OAK_LONGBOW":                          OAK_LONGBOW,
+	"OAK_SHORTBOW":                         OAK_SHORTBOW,
+	"WILLOW_LONGBOW":                       WILLOW_LONGBOW,
+	"WILLOW_SHORTBOW":                      WILLOW_SHORTBOW,
+	"MAPLE_LONGBOW":                        MAPLE_LONGBOW,
+	"MAPLE_SHORTBOW":                       MAPLE_SHORTBOW,
+	"YEW_LONGBOW":                          YEW_LONGBOW,
+	"YEW_SHORTBOW":                         YEW_SHORTBOW,
+	"MAGIC_LONGBOW":                        MAGIC_LONGBOW,
+	"MAGIC_SHORTBOW":                       MAGIC_SHORTBOW,
+	"UNSTRUNG_OAK_LONGBOW":                 UNSTRUNG_OAK_LONGBOW,
+	"UNSTRUNG_OAK_SHORTBOW":                UNSTRUNG_OAK_SHORTBOW,
+	"UNSTRUNG_WILLOW_LONGBOW":              UNSTRUNG_WILLOW_LONGBOW,
+	"UNSTRUNG_WILLOW_SHORTBOW":             UNSTRUNG_WILLOW_SHORTBOW,
+	"UNSTRUNG_MAPLE_LONGBOW":               UNSTRUNG_MAPLE_LONGBOW,
+	"UNSTRUNG_MAPLE_SHORTBOW":              UNSTRUNG_MAPLE_SHORTBOW,
+	"UNSTRUNG_YEW_LONGBOW":                 UNSTRUNG_YEW_LONGBOW,
+	"UNSTRUNG_YEW_SHORTBOW":                UNSTRUNG_YEW_SHORTBOW,
+	"UNSTRUNG_MAGIC_LONGBOW":               UNSTRUNG_MAGIC_LONGBOW,
+	"UNSTRUNG_MAGIC_SHORTBOW":              UNSTRUNG_MAGIC_SHORTBOW,
+	"BARCRAWL_CARD":                        BARCRAWL_CARD,
+	"BRONZE_ARROW_HEADS":                   BRONZE_ARROW_HEADS,
+	"IRON_ARROW_HEADS":                     IRON_ARROW_HEADS,
+	"STEEL_ARROW_HEADS":                    STEEL_ARROW_HEADS,
+	"MITHRIL_ARROW_HEADS":                  MITHRIL_ARROW_HEADS,
+	"ADAMANTITE_ARROW_HEADS":               ADAMANTITE_ARROW_HEADS,
+	"RUNE_ARROW_HEADS":                     RUNE_ARROW_HEADS,
+	"FLAX":                                 FLAX,
+	"BOW_STRING":                           BOW_STRING,
+	"EASTER_EGG":                           EASTER_EGG,
+	"SCORPION_CAGE":                        SCORPION_CAGE,
+	"SCORPION_CAGE_679":                    SCORPION_CAGE_679,
+	"SCORPION_CAGE_680":                    SCORPION_CAGE_680,
+	"SCORPION_CAGE_681":                    SCORPION_CAGE_681,
+	"ENCHANTED_BATTLESTAFF_OF_FIRE":        ENCHANTED_BATTLESTAFF_OF_FIRE,
+	"ENCHANTED_BATTLESTAFF_OF_WATER":       ENCHANTED_BATTLESTAFF_OF_WATER,
+	"ENCHANTED_BATTLESTAFF_OF_AIR":         ENCHANTED_BATTLESTAFF_OF_AIR,
+	"ENCHANTED_BATTLESTAFF_OF_EARTH":       ENCHANTED_BATTLESTAFF_OF_EARTH,
+	"SCORPION_CAGE_686":                    SCORPION_CAGE_686,
+	"SCORPION_CAGE_687":                    SCORPION_CAGE_687,
+	"SCORPION_CAGE_688":                    SCORPION_CAGE_688,
+	"SCORPION_CAGE_689":                    SCORPION_CAGE_689,
+	"GOLD_690":                             GOLD_690,
+	"GOLD_BAR_691":                         GOLD_BAR_691,
+	"RUBY_RING_692":                        RUBY_RING_692,
+	"RUBY_NECKLACE_693":                    RUBY_NECKLACE_693,
+	"FAMILY_CREST":                         FAMILY_CREST,
+	"CREST_FRAGMENT":                       CREST_FRAGMENT,
+	"CREST_FRAGMENT_696":                   CREST_FRAGMENT_696,
+	"CREST_FRAGMENT_697":                   CREST_FRAGMENT_697,
+	"STEEL_GAUNTLETS":                      STEEL_GAUNTLETS,
+	"GAUNTLETS_OF_GOLDSMITHING":            GAUNTLETS_OF_GOLDSMITHING,
+	"GAUNTLETS_OF_COOKING":                 GAUNTLETS_OF_COOKING,
+	"GAUNTLETS_OF_CHAOS":                   GAUNTLETS_OF_CHAOS,
+	"ROBE_OF_ZAMORAK":                      ROBE_OF_ZAMORAK,
+	"ROBE_OF_ZAMORAK_703":                  ROBE_OF_ZAMORAK_703,
+	"ADDRESS_LABEL":                        ADDRESS_LABEL,
+	"TRIBAL_TOTEM":                         TRIBAL_TOTEM,
+	"TOURIST_GUIDE":                        TOURIST_GUIDE,
+	"SPICE":                                SPICE,
+	"UNCOOKED_CURRY":                       UNCOOKED_CURRY,
+	"CURRY":                                CURRY,
+	"BURNT_CURRY":                          BURNT_CURRY,
+	"YEW_LOGS_CERTIFICATE":                 YEW_LOGS_CERTIFICATE,
+	"MAPLE_LOGS_CERTIFICATE":               MAPLE_LOGS_CERTIFICATE,
+	"WILLOW_LOGS_CERTIFICATE":              WILLOW_LOGS_CERTIFICATE,
+	"LOCKPICK":                             LOCKPICK,
+	"RED_VINE_WORMS":                       RED_VINE_WORMS,
+	"BLANKET":                              BLANKET,
+	"RAW_GIANT_CARP":                       RAW_GIANT_CARP,
+	"GIANT_CARP":                           GIANT_CARP,
+	"FISHING_COMPETITION_PASS":             FISHING_COMPETITION_PASS,
+	"HEMENSTER_FISHING_TROPHY":             HEMENSTER_FISHING_TROPHY,
+	"PENDANT_OF_LUCIEN":                    PENDANT_OF_LUCIEN,
+	"BOOTS_OF_LIGHTFOOTEDNESS":             BOOTS_OF_LIGHTFOOTEDNESS,
+	"ICE_ARROWS":                           ICE_ARROWS,
+	"LEVER":                                LEVER,
+	"STAFF_OF_ARMADYL":                     STAFF_OF_ARMADYL,
+	"PENDANT_OF_ARMADYL":                   PENDANT_OF_ARMADYL,
+	"LARGE_COG":                            LARGE_COG,
+	"LARGE_COG_728":                        LARGE_COG_728,
+	"LARGE_COG_729":                        LARGE_COG_729,
+	"LARGE_COG_730":                        LARGE_COG_730,
+	"RAT_POISON":                           RAT_POISON,
+	"SHINY_KEY":                            SHINY_KEY,
+	"KHAZARD_HELMET":                       KHAZARD_HELMET,
+	"KHAZARD_CHAINMAIL":                    KHAZARD_CHAINMAIL,
+	"KHALI_BREW":                           KHALI_BREW,
+	"KHAZARD_CELL_KEYS":                    KHAZARD_CELL_KEYS,
+	"POISON_CHALICE":                       POISON_CHALICE,
+	"MAGIC_WHISTLE":                        MAGIC_WHISTLE,
+	"CUP_OF_TEA":                           CUP_OF_TEA,
+	"ORB_OF_PROTECTION":                    ORB_OF_PROTECTION,
+	"ORBS_OF_PROTECTION":                   ORBS_OF_PROTECTION,
+	"HOLY_TABLE_NAPKIN":                    HOLY_TABLE_NAPKIN,
+	"BELL":                                 BELL,
+	"GNOME_EMERALD_AMULET_OF_PROTECTION":   GNOME_EMERALD_AMULET_OF_PROTECTION,
+	"MAGIC_GOLDEN_FEATHER":                 MAGIC_GOLDEN_FEATHER,
+	"HOLY_GRAIL":                           HOLY_GRAIL,
+	"SCRIPT_OF_HAZEEL":                     SCRIPT_OF_HAZEEL,
+	"PINEAPPLE":                            PINEAPPLE,
+	"PINEAPPLE_RING":                       PINEAPPLE_RING,
+	"PINEAPPLE_PIZZA":                      PINEAPPLE_PIZZA,
+	"HALF_PINEAPPLE_PIZZA":                 HALF_PINEAPPLE_PIZZA,
+	"MAGIC_SCROLL":                         MAGIC_SCROLL,
+	"MARK_OF_HAZEEL":                       MARK_OF_HAZEEL,
+	"BLOODY_AXE_OF_ZAMORAK":                BLOODY_AXE_OF_ZAMORAK,
+	"CARNILLEAN_ARMOUR":                    CARNILLEAN_ARMOUR,
+	"CARNILLEAN_KEY":                       CARNILLEAN_KEY,
+	"CATTLE_PROD":                          CATTLE_PROD,
+	"PLAGUED_SHEEP_REMAINS":                PLAGUED_SHEEP_REMAINS,
+	"POISONED_ANIMAL_FEED":                 POISONED_ANIMAL_FEED,
+	"PROTECTIVE_JACKET":                    PROTECTIVE_JACKET,
+	"PROTECTIVE_TROUSERS":                  PROTECTIVE_TROUSERS,
+	"PLAGUED_SHEEP_REMAINS_762":            PLAGUED_SHEEP_REMAINS_762,
+	"PLAGUED_SHEEP_REMAINS_763":            PLAGUED_SHEEP_REMAINS_763,
+	"PLAGUED_SHEEP_REMAINS_764":            PLAGUED_SHEEP_REMAINS_764,
+	"DWELLBERRIES":                         DWELLBERRIES,
+	"GASMASK":                              GASMASK,
+	"PICTURE":                              PICTURE,
+	"BOOK_768":                             BOOK_768,
+	"SEASLUG":                              SEASLUG,
+	"CHOCOLATY_MILK":                       CHOCOLATY_MILK,
+	"HANGOVER_CURE":                        HANGOVER_CURE,
+	"CHOCOLATE_DUST":                       CHOCOLATE_DUST,
+	"TORCH":                                TORCH,
+	"TORCH_774":                            TORCH_774,
+	"WARRANT":                              WARRANT,
+	"DAMP_STICKS":                          DAMP_STICKS,
+	"DRY_STICKS":                           DRY_STICKS,
+	"BROKEN_GLASS":                         BROKEN_GLASS,
+	"OYSTER_PEARLS":                        OYSTER_PEARLS,
+	"LITTLE_KEY":                           LITTLE_KEY,
+	"SCRUFFY_NOTE":                         SCRUFFY_NOTE,
+	"GLARIAL_S_AMULET":                     GLARIAL_S_AMULET,
+	"SWAMP_TAR":                            SWAMP_TAR,
+	"UNCOOKED_SWAMP_PASTE":                 UNCOOKED_SWAMP_PASTE,
+	"SWAMP_PASTE":                          SWAMP_PASTE,
+	"OYSTER_PEARL_BOLTS":                   OYSTER_PEARL_BOLTS,
+	"GLARIALS_PEBBLE":                      GLARIALS_PEBBLE,
+	"BOOK_ON_BAXTORIAN":                    BOOK_ON_BAXTORIAN,
+	"LARGE_KEY":                            LARGE_KEY,
+	"OYSTER_PEARL_BOLT_TIPS":               OYSTER_PEARL_BOLT_TIPS,
+	"OYSTER":                               OYSTER,
+	"OYSTER_PEARLS_792":                    OYSTER_PEARLS_792,
+	"OYSTER_793":                           OYSTER_793,
+	"SOIL":                                 SOIL,
+	"DRAGON_MEDIUM_HELMET":                 DRAGON_MEDIUM_HELMET,
+	"MITHRIL_SEED":                         MITHRIL_SEED,
+	"AN_OLD_KEY":                           AN_OLD_KEY,
+	"PIGEON_CAGE":                          PIGEON_CAGE,
+	"MESSENGER_PIGEONS":                    MESSENGER_PIGEONS,
+	"BIRD_FEED":                            BIRD_FEED,
+	"ROTTEN_APPLES":                        ROTTEN_APPLES,
+	"DOCTORS_GOWN":                         DOCTORS_GOWN,
+	"BRONZE_KEY_803":                       BRONZE_KEY_803,
+	"DISTILLATOR":                          DISTILLATOR,
+	"GLARIAL_S_URN":                        GLARIAL_S_URN,
+	"GLARIAL_S_URN_806":                    GLARIAL_S_URN_806,
+	"PRIEST_ROBE":                          PRIEST_ROBE,
+	"PRIEST_GOWN":                          PRIEST_GOWN,
+	"LIQUID_HONEY":                         LIQUID_HONEY,
+	"ETHENEA":                              ETHENEA,
+	"SULPHURIC_BROLINE":                    SULPHURIC_BROLINE,
+	"PLAGUE_SAMPLE":                        PLAGUE_SAMPLE,
+	"TOUCH_PAPER":                          TOUCH_PAPER,
+	"DRAGON_BONES":                         DRAGON_BONES,
+	"HERB_815":                             HERB_815,
+	"SNAKE_WEED":                           SNAKE_WEED,
+	"HERB_817":                             HERB_817,
+	"ARDRIGAL":                             ARDRIGAL,
+	"HERB_819":                             HERB_819,
+	"SITO_FOIL":                            SITO_FOIL,
+	"HERB_821":                             HERB_821,
+	"VOLENCIA_MOSS":                        VOLENCIA_MOSS,
+	"HERB_823":                             HERB_823,
+	"ROGUES_PURSE":                         ROGUES_PURSE,
+	"SOUL_RUNE":                            SOUL_RUNE,
+	"KING_LATHAS_AMULET":                   KING_LATHAS_AMULET,
+	"BRONZE_SPEAR":                         BRONZE_SPEAR,
+	"HALLOWEEN_MASK":                       HALLOWEEN_MASK,
+	"DRAGON_BITTER":                        DRAGON_BITTER,
+	"GREENMANS_ALE":                        GREENMANS_ALE,
+	"HALLOWEEN_MASK_831":                   HALLOWEEN_MASK_831,
+	"HALLOWEEN_MASK_832":                   HALLOWEEN_MASK_832,
+	"COCKTAIL_GLASS":                       COCKTAIL_GLASS,
+	"COCKTAIL_SHAKER":                      COCKTAIL_SHAKER,
+	"BONE_KEY":                             BONE_KEY,
+	"GNOME_ROBE":                           GNOME_ROBE,
+	"GNOME_ROBE_837":                       GNOME_ROBE_837,
+	"GNOME_ROBE_838":                       GNOME_ROBE_838,
+	"GNOME_ROBE_839":                       GNOME_ROBE_839,
+	"GNOME_ROBE_840":                       GNOME_ROBE_840,
+	"GNOMESHAT":                            GNOMESHAT,
+	"GNOMESHAT_842":                        GNOMESHAT_842,
+	"GNOMESHAT_843":                        GNOMESHAT_843,
+	"GNOMESHAT_844":                        GNOMESHAT_844,
+	"GNOMESHAT_845":                        GNOMESHAT_845,
+	"GNOME_TOP":                            GNOME_TOP,
+	"GNOME_TOP_847":                        GNOME_TOP_847,
+	"GNOME_TOP_848":                        GNOME_TOP_848,
+	"GNOME_TOP_849":                        GNOME_TOP_849,
+	"GNOME_TOP_850":                        GNOME_TOP_850,
+	"GNOME_COCKTAIL_GUIDE":                 GNOME_COCKTAIL_GUIDE,
+	"BEADS_OF_THE_DEAD":                    BEADS_OF_THE_DEAD,
+	"COCKTAIL_GLASS_853":                   COCKTAIL_GLASS_853,
+	"COCKTAIL_GLASS_854":                   COCKTAIL_GLASS_854,
+	"LEMON":                                LEMON,
+	"LEMON_SLICES":                         LEMON_SLICES,
+	"ORANGE":                               ORANGE,
+	"ORANGE_SLICES":                        ORANGE_SLICES,
+	"DICED_ORANGE":                         DICED_ORANGE,
+	"DICED_LEMON":                          DICED_LEMON,
+	"FRESH_PINEAPPLE":                      FRESH_PINEAPPLE,
+	"PINEAPPLE_CHUNKS":                     PINEAPPLE_CHUNKS,
+	"LIME":                                 LIME,
+	"LIME_CHUNKS":                          LIME_CHUNKS,
+	"LIME_SLICES":                          LIME_SLICES,
+	"FRUIT_BLAST":                          FRUIT_BLAST,
+	"ODD_LOOKING_COCKTAIL":                 ODD_LOOKING_COCKTAIL,
+	"WHISKY_868":                           WHISKY_868,
+	"VODKA":                                VODKA,
+	"GIN":                                  GIN,
+	"CREAM":                                CREAM,
+	"DRUNK_DRAGON":                         DRUNK_DRAGON,
+	"EQUA_LEAVES":                          EQUA_LEAVES,
+	"SGG":                                  SGG,
+	"CHOCOLATE_SATURDAY":                   CHOCOLATE_SATURDAY,
+	"BRANDY":                               BRANDY,
+	"BLURBERRY_SPECIAL":                    BLURBERRY_SPECIAL,
+	"WIZARD_BLIZZARD":                      WIZARD_BLIZZARD,
+	"PINEAPPLE_PUNCH":                      PINEAPPLE_PUNCH,
+	"GNOMEBATTA_DOUGH":                     GNOMEBATTA_DOUGH,
+	"GIANNE_DOUGH":                         GIANNE_DOUGH,
+	"GNOMEBOWL_DOUGH":                      GNOMEBOWL_DOUGH,
+	"GNOMECRUNCHIE_DOUGH":                  GNOMECRUNCHIE_DOUGH,
+	"GNOMEBATTA":                           GNOMEBATTA,
+	"GNOMEBOWL":                            GNOMEBOWL,
+	"GNOMEBATTA_886":                       GNOMEBATTA_886,
+	"GNOMECRUNCHIE":                        GNOMECRUNCHIE,
+	"GNOMEBOWL_888":                        GNOMEBOWL_888,
+	"UNCUT_RED_TOPAZ":                      UNCUT_RED_TOPAZ,
+	"UNCUT_JADE":                           UNCUT_JADE,
+	"UNCUT_OPAL":                           UNCUT_OPAL,
+	"RED_TOPAZ":                            RED_TOPAZ,
+	"JADE":                                 JADE,
+	"OPAL":                                 OPAL,
+	"SWAMP_TOAD":                           SWAMP_TOAD,
+	"TOAD_LEGS":                            TOAD_LEGS,
+	"KING_WORM":                            KING_WORM,
+	"GNOME_SPICE":                          GNOME_SPICE,
+	"GIANNE_COOK_BOOK":                     GIANNE_COOK_BOOK,
+	"GNOMECRUNCHIE_900":                    GNOMECRUNCHIE_900,
+	"CHEESE_AND_TOMATO_BATTA":              CHEESE_AND_TOMATO_BATTA,
+	"TOAD_BATTA":                           TOAD_BATTA,
+	"GNOME_BATTA":                          GNOME_BATTA,
+	"WORM_BATTA":                           WORM_BATTA,
+	"FRUIT_BATTA":                          FRUIT_BATTA,
+	"VEG_BATTA":                            VEG_BATTA,
+	"CHOCOLATE_BOMB":                       CHOCOLATE_BOMB,
+	"VEGBALL":                              VEGBALL,
+	"WORM_HOLE":                            WORM_HOLE,
+	"TANGLED_TOADS_LEGS":                   TANGLED_TOADS_LEGS,
+	"CHOC_CRUNCHIES":                       CHOC_CRUNCHIES,
+	"WORM_CRUNCHIES":                       WORM_CRUNCHIES,
+	"TOAD_CRUNCHIES":                       TOAD_CRUNCHIES,
+	"SPICE_CRUNCHIES":                      SPICE_CRUNCHIES,
+	"CRUSHED_GEMSTONE":                     CRUSHED_GEMSTONE,
+	"BLURBERRY_BADGE":                      BLURBERRY_BADGE,
+	"GIANNE_BADGE":                         GIANNE_BADGE,
+	"TREE_GNOME_TRANSLATION":               TREE_GNOME_TRANSLATION,
+	"BARK_SAMPLE":                          BARK_SAMPLE,
+	"WAR_SHIP":                             WAR_SHIP,
+	"GLOUGHS_JOURNAL":                      GLOUGHS_JOURNAL,
+	"INVOICE":                              INVOICE,
+	"UGTHANKI_KEBAB":                       UGTHANKI_KEBAB,
+	"SPECIAL_CURRY":                        SPECIAL_CURRY,
+	"GLOUGH_S_KEY":                         GLOUGH_S_KEY,
+	"GLOUGH_S_NOTES":                       GLOUGH_S_NOTES,
+	"PEBBLE":                               PEBBLE,
+	"PEBBLE_928":                           PEBBLE_928,
+	"PEBBLE_929":                           PEBBLE_929,
+	"PEBBLE_930":                           PEBBLE_930,
+	"DACONIA_ROCK":                         DACONIA_ROCK,
+	"SINISTER_KEY":                         SINISTER_KEY,
+	"HERB_933":                             HERB_933,
+	"TORSTOL":                              TORSTOL,
+	"UNFINISHED_POTION_935":                UNFINISHED_POTION_935,
+	"JANGERBERRIES":                        JANGERBERRIES,
+	"FRUIT_BLAST_937":                      FRUIT_BLAST_937,
+	"BLURBERRY_SPECIAL_938":                BLURBERRY_SPECIAL_938,
+	"WIZARD_BLIZZARD_939":                  WIZARD_BLIZZARD_939,
+	"PINEAPPLE_PUNCH_940":                  PINEAPPLE_PUNCH_940,
+	"SGG_941":                              SGG_941,
+	"CHOCOLATE_SATURDAY_942":               CHOCOLATE_SATURDAY_942,
+	"DRUNK_DRAGON_943":                     DRUNK_DRAGON_943,
+	"CHEESE_AND_TOMATO_BATTA_944":          CHEESE_AND_TOMATO_BATTA_944,
+	"TOAD_BATTA_945":                       TOAD_BATTA_945,
+	"GNOME_BATTA_946":                      GNOME_BATTA_946,
+	"WORM_BATTA_947":                       WORM_BATTA_947,
+	"FRUIT_BATTA_948":                      FRUIT_BATTA_948,
+	"VEG_BATTA_949":                        VEG_BATTA_949,
+	"CHOCOLATE_BOMB_950":                   CHOCOLATE_BOMB_950,
+	"VEGBALL_951":                          VEGBALL_951,
+	"WORM_HOLE_952":                        WORM_HOLE_952,
+	"TANGLED_TOADS_LEGS_953":               TANGLED_TOADS_LEGS_953,
+	"CHOC_CRUNCHIES_954":                   CHOC_CRUNCHIES_954,
+	"WORM_CRUNCHIES_955":                   WORM_CRUNCHIES_955,
+	"TOAD_CRUNCHIES_956":                   TOAD_CRUNCHIES_956,
+	"SPICE_CRUNCHIES_957":                  SPICE_CRUNCHIES_957,
+	"STONE_PLAQUE":                         STONE_PLAQUE,
+	"TATTERED_SCROLL":                      TATTERED_SCROLL,
+	"CRUMPLED_SCROLL":                      CRUMPLED_SCROLL,
+	"BERVIRIUS_TOMB_NOTES":                 BERVIRIUS_TOMB_NOTES,
+	"ZADIMUS_CORPSE":                       ZADIMUS_CORPSE,
+	"POTION_OF_ZAMORAK":                    POTION_OF_ZAMORAK,
+	"POTION_OF_ZAMORAK_964":                POTION_OF_ZAMORAK_964,
+	"POTION_OF_ZAMORAK_965":                POTION_OF_ZAMORAK_965,
+	"BOOTS_966":                            BOOTS_966,
+	"BOOTS_967":                            BOOTS_967,
+	"BOOTS_968":                            BOOTS_968,
+	"BOOTS_969":                            BOOTS_969,
+	"BOOTS_970":                            BOOTS_970,
+	"SANTA_S_HAT":                          SANTA_S_HAT,
+	"LOCATING_CRYSTAL":                     LOCATING_CRYSTAL,
+	"SWORD_POMMEL":                         SWORD_POMMEL,
+	"BONE_SHARD":                           BONE_SHARD,
+	"STEEL_WIRE":                           STEEL_WIRE,
+	"BONE_BEADS":                           BONE_BEADS,
+	"RASHILIYA_CORPSE":                     RASHILIYA_CORPSE,
+	"RESETCRYSTAL":                         RESETCRYSTAL,
+	"BRONZE_WIRE":                          BRONZE_WIRE,
+	"PRESENT":                              PRESENT,
+	"GNOME_BALL":                           GNOME_BALL,
+	"PAPYRUS":                              PAPYRUS,
+	"A_LUMP_OF_CHARCOAL":                   A_LUMP_OF_CHARCOAL,
+	"ARROW":                                ARROW,
+	"LIT_ARROW":                            LIT_ARROW,
+	"ROCKS":                                ROCKS,
+	"PARAMAYA_REST_TICKET":                 PARAMAYA_REST_TICKET,
+	"SHIP_TICKET":                          SHIP_TICKET,
+	"DAMP_CLOTH":                           DAMP_CLOTH,
+	"DESERT_BOOTS":                         DESERT_BOOTS,
+	"ORB_OF_LIGHT":                         ORB_OF_LIGHT,
+	"ORB_OF_LIGHT_992":                     ORB_OF_LIGHT_992,
+	"ORB_OF_LIGHT_993":                     ORB_OF_LIGHT_993,
+	"ORB_OF_LIGHT_994":                     ORB_OF_LIGHT_994,
+	"RAILING":                              RAILING,
+	"RANDAS_S_JOURNAL":                     RANDAS_S_JOURNAL,
+	"UNICORN_HORN_997":                     UNICORN_HORN_997,
+	"COAT_OF_ARMS":                         COAT_OF_ARMS,
+	"COAT_OF_ARMS_999":                     COAT_OF_ARMS_999,
+	"STAFF_OF_IBAN":                        STAFF_OF_IBAN,
+	"DWARF_BREW":                           DWARF_BREW,
+	"IBANS_ASHES":                          IBANS_ASHES,
+	"CAT":                                  CAT,
+	"A_DOLL_OF_IBAN":                       A_DOLL_OF_IBAN,
+	"OLD_JOURNAL":                          OLD_JOURNAL,
+	"KLANK_S_GAUNTLETS":                    KLANK_S_GAUNTLETS,
+	"IBAN_S_SHADOW":                        IBAN_S_SHADOW,
+	"IBAN_S_CONSCIENCE":                    IBAN_S_CONSCIENCE,
+	"AMULET_OF_OTHAINIAN":                  AMULET_OF_OTHAINIAN,
+	"AMULET_OF_DOOMION":                    AMULET_OF_DOOMION,
+	"AMULET_OF_HOLTHION":                   AMULET_OF_HOLTHION,
+	"KEEP_KEY":                             KEEP_KEY,
+	"BRONZE_THROWING_DART":                 BRONZE_THROWING_DART,
+	"PROTOTYPE_THROWING_DART":              PROTOTYPE_THROWING_DART,
+	"IRON_THROWING_DART":                   IRON_THROWING_DART,
+	"FULL_WATER_SKIN":                      FULL_WATER_SKIN,
+	"LENS_MOULD":                           LENS_MOULD,
+	"LENS":                                 LENS,
+	"DESERT_ROBE":                          DESERT_ROBE,
+	"DESERT_SHIRT":                         DESERT_SHIRT,
+	"METAL_KEY":                            METAL_KEY,
+	"SLAVES_ROBE_BOTTOM":                   SLAVES_ROBE_BOTTOM,
+	"SLAVES_ROBE_TOP":                      SLAVES_ROBE_TOP,
+	"STEEL_THROWING_DART":                  STEEL_THROWING_DART,
+	"ASTROLOGY_BOOK":                       ASTROLOGY_BOOK,
+	"UNHOLY_SYMBOL_MOULD":                  UNHOLY_SYMBOL_MOULD,
+	"UNHOLY_SYMBOL_OF_ZAMORAK":             UNHOLY_SYMBOL_OF_ZAMORAK,
+	"UNBLESSED_UNHOLY_SYMBOL_OF_ZAMORAK":   UNBLESSED_UNHOLY_SYMBOL_OF_ZAMORAK,
+	"UNHOLY_SYMBOL_OF_ZAMORAK_1029":        UNHOLY_SYMBOL_OF_ZAMORAK_1029,
+	"SHANTAY_DESERT_PASS":                  SHANTAY_DESERT_PASS,
+	"STAFF_OF_IBAN_1031":                   STAFF_OF_IBAN_1031,
+	"DWARF_CANNON_BASE":                    DWARF_CANNON_BASE,
+	"DWARF_CANNON_STAND":                   DWARF_CANNON_STAND,
+	"DWARF_CANNON_BARRELS":                 DWARF_CANNON_BARRELS,
+	"DWARF_CANNON_FURNACE":                 DWARF_CANNON_FURNACE,
+	"FINGERNAILS":                          FINGERNAILS,
+	"POWERING_CRYSTAL1":                    POWERING_CRYSTAL1,
+	"MINING_BARREL":                        MINING_BARREL,
+	"ANA_IN_A_BARREL":                      ANA_IN_A_BARREL,
+	"STOLEN_GOLD":                          STOLEN_GOLD,
+	"MULTI_CANNON_BALL":                    MULTI_CANNON_BALL,
+	"RAILING_1042":                         RAILING_1042,
+	"OGRE_TOOTH":                           OGRE_TOOTH,
+	"OGRE_RELIC":                           OGRE_RELIC,
+	"SKAVID_MAP":                           SKAVID_MAP,
+	"DWARF_REMAINS":                        DWARF_REMAINS,
+	"KEY_1047":                             KEY_1047,
+	"OGRE_RELIC_PART":                      OGRE_RELIC_PART,
+	"OGRE_RELIC_PART_1049":                 OGRE_RELIC_PART_1049,
+	"OGRE_RELIC_PART_1050":                 OGRE_RELIC_PART_1050,
+	"GROUND_BAT_BONES":                     GROUND_BAT_BONES,
+	"UNFINISHED_POTION_1052":               UNFINISHED_POTION_1052,
+	"OGRE_POTION":                          OGRE_POTION,
+	"MAGIC_OGRE_POTION":                    MAGIC_OGRE_POTION,
+	"TOOL_KIT":                             TOOL_KIT,
+	"NULODION_S_NOTES":                     NULODION_S_NOTES,
+	"CANNON_AMMO_MOULD":                    CANNON_AMMO_MOULD,
+	"TENTI_PINEAPPLE":                      TENTI_PINEAPPLE,
+	"BEDOBIN_COPY_KEY":                     BEDOBIN_COPY_KEY,
+	"TECHNICAL_PLANS":                      TECHNICAL_PLANS,
+	"ROCK_CAKE":                            ROCK_CAKE,
+	"BRONZE_DART_TIPS":                     BRONZE_DART_TIPS,
+	"IRON_DART_TIPS":                       IRON_DART_TIPS,
+	"STEEL_DART_TIPS":                      STEEL_DART_TIPS,
+	"MITHRIL_DART_TIPS":                    MITHRIL_DART_TIPS,
+	"ADAMANTITE_DART_TIPS":                 ADAMANTITE_DART_TIPS,
+	"RUNE_DART_TIPS":                       RUNE_DART_TIPS,
+	"MITHRIL_THROWING_DART":                MITHRIL_THROWING_DART,
+	"ADAMANTITE_THROWING_DART":             ADAMANTITE_THROWING_DART,
+	"RUNE_THROWING_DART":                   RUNE_THROWING_DART,
+	"PROTOTYPE_DART_TIP":                   PROTOTYPE_DART_TIP,
+	"INFO_DOCUMENT":                        INFO_DOCUMENT,
+	"INSTRUCTION_MANUAL":                   INSTRUCTION_MANUAL,
+	"UNFINISHED_POTION_1074":               UNFINISHED_POTION_1074,
+	"IRON_THROWING_KNIFE":                  IRON_THROWING_KNIFE,
+	"BRONZE_THROWING_KNIFE":                BRONZE_THROWING_KNIFE,
+	"STEEL_THROWING_KNIFE":                 STEEL_THROWING_KNIFE,
+	"MITHRIL_THROWING_KNIFE":               MITHRIL_THROWING_KNIFE,
+	"ADAMANTITE_THROWING_KNIFE":            ADAMANTITE_THROWING_KNIFE,
+	"RUNE_THROWING_KNIFE":                  RUNE_THROWING_KNIFE,
+	"BLACK_THROWING_KNIFE":                 BLACK_THROWING_KNIFE,
+	"WATER_SKIN_MOSTLY_FULL":               WATER_SKIN_MOSTLY_FULL,
+	"WATER_SKIN_MOSTLY_EMPTY":              WATER_SKIN_MOSTLY_EMPTY,
+	"WATER_SKIN_MOUTHFUL_LEFT":             WATER_SKIN_MOUTHFUL_LEFT,
+	"EMPTY_WATER_SKIN":                     EMPTY_WATER_SKIN,
+	"NIGHTSHADE":                           NIGHTSHADE,
+	"SHAMAN_ROBE":                          SHAMAN_ROBE,
+	"IRON_SPEAR":                           IRON_SPEAR,
+	"STEEL_SPEAR":                          STEEL_SPEAR,
+	"MITHRIL_SPEAR":                        MITHRIL_SPEAR,
+	"ADAMANTITE_SPEAR":                     ADAMANTITE_SPEAR,
+	"RUNE_SPEAR":                           RUNE_SPEAR,
+	"CAT_1093":                             CAT_1093,
+	"SEASONED_SARDINE":                     SEASONED_SARDINE,
+	"KITTENS":                              KITTENS,
+	"KITTEN":                               KITTEN,
+	"WROUGHT_IRON_KEY":                     WROUGHT_IRON_KEY,
+	"CELL_DOOR_KEY":                        CELL_DOOR_KEY,
+	"A_FREE_SHANTAY_DISCLAIMER":            A_FREE_SHANTAY_DISCLAIMER,
+	"DOOGLE_LEAVES":                        DOOGLE_LEAVES,
+	"RAW_UGTHANKI_MEAT":                    RAW_UGTHANKI_MEAT,
+	"TASTY_UGTHANKI_KEBAB":                 TASTY_UGTHANKI_KEBAB,
+	"COOKED_UGTHANKI_MEAT":                 COOKED_UGTHANKI_MEAT,
+	"UNCOOKED_PITTA_BREAD":                 UNCOOKED_PITTA_BREAD,
+	"PITTA_BREAD":                          PITTA_BREAD,
+	"TOMATO_MIXTURE":                       TOMATO_MIXTURE,
+	"ONION_MIXTURE":                        ONION_MIXTURE,
+	"ONION_AND_TOMATO_MIXTURE":             ONION_AND_TOMATO_MIXTURE,
+	"ONION_AND_TOMATO_AND_UGTHANKI_MIX":    ONION_AND_TOMATO_AND_UGTHANKI_MIX,
+	"BURNT_PITTA_BREAD":                    BURNT_PITTA_BREAD,
+	"PANNING_TRAY":                         PANNING_TRAY,
+	"PANNING_TRAY_1112":                    PANNING_TRAY_1112,
+	"PANNING_TRAY_1113":                    PANNING_TRAY_1113,
+	"ROCK_PICK":                            ROCK_PICK,
+	"SPECIMEN_BRUSH":                       SPECIMEN_BRUSH,
+	"SPECIMEN_JAR":                         SPECIMEN_JAR,
+	"ROCK_SAMPLE":                          ROCK_SAMPLE,
+	"GOLD_NUGGETS":                         GOLD_NUGGETS,
+	"CAT_1119":                             CAT_1119,
+	"SCRUMPLED_PIECE_OF_PAPER":             SCRUMPLED_PIECE_OF_PAPER,
+	"DIGSITE_INFO":                         DIGSITE_INFO,
+	"POISONED_BRONZE_THROWING_DART":        POISONED_BRONZE_THROWING_DART,
+	"POISONED_IRON_THROWING_DART":          POISONED_IRON_THROWING_DART,
+	"POISONED_STEEL_THROWING_DART":         POISONED_STEEL_THROWING_DART,
+	"POISONED_MITHRIL_THROWING_DART":       POISONED_MITHRIL_THROWING_DART,
+	"POISONED_ADAMANTITE_THROWING_DART":    POISONED_ADAMANTITE_THROWING_DART,
+	"POISONED_RUNE_THROWING_DART":          POISONED_RUNE_THROWING_DART,
+	"POISONED_BRONZE_THROWING_KNIFE":       POISONED_BRONZE_THROWING_KNIFE,
+	"POISONED_IRON_THROWING_KNIFE":         POISONED_IRON_THROWING_KNIFE,
+	"POISONED_STEEL_THROWING_KNIFE":        POISONED_STEEL_THROWING_KNIFE,
+	"POISONED_MITHRIL_THROWING_KNIFE":      POISONED_MITHRIL_THROWING_KNIFE,
+	"POISONED_BLACK_THROWING_KNIFE":        POISONED_BLACK_THROWING_KNIFE,
+	"POISONED_ADAMANTITE_THROWING_KNIFE":   POISONED_ADAMANTITE_THROWING_KNIFE,
+	"POISONED_RUNE_THROWING_KNIFE":         POISONED_RUNE_THROWING_KNIFE,
+	"POISONED_BRONZE_SPEAR":                POISONED_BRONZE_SPEAR,
+	"POISONED_IRON_SPEAR":                  POISONED_IRON_SPEAR,
+	"POISONED_STEEL_SPEAR":                 POISONED_STEEL_SPEAR,
+	"POISONED_MITHRIL_SPEAR":               POISONED_MITHRIL_SPEAR,
+	"POISONED_ADAMANTITE_SPEAR":            POISONED_ADAMANTITE_SPEAR,
+	"POISONED_RUNE_SPEAR":                  POISONED_RUNE_SPEAR,
+	"BOOK_OF_EXPERIMENTAL_CHEMISTRY":       BOOK_OF_EXPERIMENTAL_CHEMISTRY,
+	"LEVEL_1_CERTIFICATE":                  LEVEL_1_CERTIFICATE,
+	"LEVEL_2_CERTIFICATE":                  LEVEL_2_CERTIFICATE,
+	"LEVEL_3_CERTIFICATE":                  LEVEL_3_CERTIFICATE,
+	"TROWEL":                               TROWEL,
+	"STAMPED_LETTER_OF_RECOMMENDATION":     STAMPED_LETTER_OF_RECOMMENDATION,
+	"UNSTAMPED_LETTER_OF_RECOMMENDATION":   UNSTAMPED_LETTER_OF_RECOMMENDATION,
+	"ROCK_SAMPLE_1148":                     ROCK_SAMPLE_1148,
+	"ROCK_SAMPLE_1149":                     ROCK_SAMPLE_1149,
+	"CRACKED_ROCK_SAMPLE":                  CRACKED_ROCK_SAMPLE,
+	"BELT_BUCKLE":                          BELT_BUCKLE,
+	"POWERING_CRYSTAL2":                    POWERING_CRYSTAL2,
+	"POWERING_CRYSTAL3":                    POWERING_CRYSTAL3,
+	"POWERING_CRYSTAL4":                    POWERING_CRYSTAL4,
+	"OLD_BOOT":                             OLD_BOOT,
+	"BUNNY_EARS":                           BUNNY_EARS,
+	"DAMAGED_ARMOUR":                       DAMAGED_ARMOUR,
+	"DAMAGED_ARMOUR_1158":                  DAMAGED_ARMOUR_1158,
+	"RUSTY_SWORD":                          RUSTY_SWORD,
+	"AMMONIUM_NITRATE":                     AMMONIUM_NITRATE,
+	"NITROGLYCERIN":                        NITROGLYCERIN,
+	"OLD_TOOTH":                            OLD_TOOTH,
+	"RADIMUS_SCROLLS":                      RADIMUS_SCROLLS,
+	"CHEST_KEY_1164":                       CHEST_KEY_1164,
+	"BROKEN_ARROW":                         BROKEN_ARROW,
+	"BUTTONS":                              BUTTONS,
+	"BROKEN_STAFF":                         BROKEN_STAFF,
+	"VASE":                                 VASE,
+	"CERAMIC_REMAINS":                      CERAMIC_REMAINS,
+	"BROKEN_GLASS_1170":                    BROKEN_GLASS_1170,
+	"UNIDENTIFIED_POWDER":                  UNIDENTIFIED_POWDER,
+	"MACHETTE":                             MACHETTE,
+	"SCROLL_1173":                          SCROLL_1173,
+	"STONE_TABLET":                         STONE_TABLET,
+	"TALISMAN_OF_ZAROS":                    TALISMAN_OF_ZAROS,
+	"EXPLOSIVE_COMPOUND":                   EXPLOSIVE_COMPOUND,
+	"BULL_ROARER":                          BULL_ROARER,
+	"MIXED_CHEMICALS":                      MIXED_CHEMICALS,
+	"GROUND_CHARCOAL":                      GROUND_CHARCOAL,
+	"MIXED_CHEMICALS_1180":                 MIXED_CHEMICALS_1180,
+	"SPELL_SCROLL":                         SPELL_SCROLL,
+	"YOMMI_TREE_SEED":                      YOMMI_TREE_SEED,
+	"TOTEM_POLE":                           TOTEM_POLE,
+	"DWARF_CANNON_BASE_1184":               DWARF_CANNON_BASE_1184,
+	"DWARF_CANNON_STAND_1185":              DWARF_CANNON_STAND_1185,
+	"DWARF_CANNON_BARRELS_1186":            DWARF_CANNON_BARRELS_1186,
+	"DWARF_CANNON_FURNACE_1187":            DWARF_CANNON_FURNACE_1187,
+	"GOLDEN_BOWL":                          GOLDEN_BOWL,
+	"GOLDEN_BOWL_WITH_PURE_WATER":          GOLDEN_BOWL_WITH_PURE_WATER,
+	"RAW_MANTA_RAY":                        RAW_MANTA_RAY,
+	"MANTA_RAY":                            MANTA_RAY,
+	"RAW_SEA_TURTLE":                       RAW_SEA_TURTLE,
+	"SEA_TURTLE":                           SEA_TURTLE,
+	"ANNAS_SILVER_NECKLACE":                ANNAS_SILVER_NECKLACE,
+	"BOBS_SILVER_TEACUP":                   BOBS_SILVER_TEACUP,
+	"CAROLS_SILVER_BOTTLE":                 CAROLS_SILVER_BOTTLE,
+	"DAVIDS_SILVER_BOOK":                   DAVIDS_SILVER_BOOK,
+	"ELIZABETHS_SILVER_NEEDLE":             ELIZABETHS_SILVER_NEEDLE,
+	"FRANKS_SILVER_POT":                    FRANKS_SILVER_POT,
+	"THREAD_1200":                          THREAD_1200,
+	"THREAD_1201":                          THREAD_1201,
+	"THREAD_1202":                          THREAD_1202,
+	"FLYPAPER":                             FLYPAPER,
+	"MURDER_SCENE_POT":                     MURDER_SCENE_POT,
+	"A_SILVER_DAGGER":                      A_SILVER_DAGGER,
+	"MURDERERS_FINGERPRINT":                MURDERERS_FINGERPRINT,
+	"ANNAS_FINGERPRINT":                    ANNAS_FINGERPRINT,
+	"BOBS_FINGERPRINT":                     BOBS_FINGERPRINT,
+	"CAROLS_FINGERPRINT":                   CAROLS_FINGERPRINT,
+	"DAVIDS_FINGERPRINT":                   DAVIDS_FINGERPRINT,
+	"ELIZABETHS_FINGERPRINT":               ELIZABETHS_FINGERPRINT,
+	"FRANKS_FINGERPRINT":                   FRANKS_FINGERPRINT,
+	"ZAMORAK_CAPE":                         ZAMORAK_CAPE,
+	"SARADOMIN_CAPE":                       SARADOMIN_CAPE,
+	"GUTHIX_CAPE":                          GUTHIX_CAPE,
+	"STAFF_OF_ZAMORAK":                     STAFF_OF_ZAMORAK,
+	"STAFF_OF_GUTHIX":                      STAFF_OF_GUTHIX,
+	"STAFF_OF_SARADOMIN":                   STAFF_OF_SARADOMIN,
+	"A_CHUNK_OF_CRYSTAL":                   A_CHUNK_OF_CRYSTAL,
+	"A_LUMP_OF_CRYSTAL":                    A_LUMP_OF_CRYSTAL,
+	"A_HUNK_OF_CRYSTAL":                    A_HUNK_OF_CRYSTAL,
+	"A_RED_CRYSTAL":                        A_RED_CRYSTAL,
+	"UNIDENTIFIED_FINGERPRINT":             UNIDENTIFIED_FINGERPRINT,
+	"ANNAS_SILVER_NECKLACE_1224":           ANNAS_SILVER_NECKLACE_1224,
+	"BOBS_SILVER_TEACUP_1225":              BOBS_SILVER_TEACUP_1225,
+	"CAROLS_SILVER_BOTTLE_1226":            CAROLS_SILVER_BOTTLE_1226,
+	"DAVIDS_SILVER_BOOK_1227":              DAVIDS_SILVER_BOOK_1227,
+	"ELIZABETHS_SILVER_NEEDLE_1228":        ELIZABETHS_SILVER_NEEDLE_1228,
+	"FRANKS_SILVER_POT_1229":               FRANKS_SILVER_POT_1229,
+	"A_SILVER_DAGGER_1230":                 A_SILVER_DAGGER_1230,
+	"A_GLOWING_RED_CRYSTAL":                A_GLOWING_RED_CRYSTAL,
+	"UNIDENTIFIED_LIQUID":                  UNIDENTIFIED_LIQUID,
+	"RADIMUS_SCROLLS_1233":                 RADIMUS_SCROLLS_1233,
+	"ROBE":                                 ROBE,
+	"ARMOUR":                               ARMOUR,
+	"DAGGER":                               DAGGER,
+	"EYE_PATCH_1237":                       EYE_PATCH_1237,
+	"BOOKING_OF_BINDING":                   BOOKING_OF_BINDING,
+	"HOLY_WATER_VIAL":                      HOLY_WATER_VIAL,
+	"ENCHANTED_VIAL":                       ENCHANTED_VIAL,
+	"SCRIBBLED_NOTES":                      SCRIBBLED_NOTES,
+	"SCRAWLED_NOTES":                       SCRAWLED_NOTES,
+	"SCATCHED_NOTES":                       SCATCHED_NOTES,
+	"SHAMANS_TOME":                         SHAMANS_TOME,
+	"EDIBLE_SEAWEED":                       EDIBLE_SEAWEED,
+	"ROUGH_SKETCH_OF_A_BOWL":               ROUGH_SKETCH_OF_A_BOWL,
+	"BURNT_MANTA_RAY":                      BURNT_MANTA_RAY,
+	"BURNT_SEA_TURTLE":                     BURNT_SEA_TURTLE,
+	"CUT_REED_PLANT":                       CUT_REED_PLANT,
+	"MAGICAL_FIRE_PASS":                    MAGICAL_FIRE_PASS,
+	"SNAKES_WEED_SOLUTION":                 SNAKES_WEED_SOLUTION,
+	"ARDRIGAL_SOLUTION":                    ARDRIGAL_SOLUTION,
+	"GUJUO_POTION":                         GUJUO_POTION,
+	"GERMINATED_YOMMI_TREE_SEED":           GERMINATED_YOMMI_TREE_SEED,
+	"DARK_DAGGER":                          DARK_DAGGER,
+	"GLOWING_DARK_DAGGER":                  GLOWING_DARK_DAGGER,
+	"HOLY_FORCE_SPELL":                     HOLY_FORCE_SPELL,
+	"IRON_PICKAXE":                         IRON_PICKAXE,
+	"STEEL_PICKAXE":                        STEEL_PICKAXE,
+	"MITHRIL_PICKAXE":                      MITHRIL_PICKAXE,
+	"ADAMANTITE_PICKAXE":                   ADAMANTITE_PICKAXE,
+	"RUNE_PICKAXE":                         RUNE_PICKAXE,
+	"SLEEPING_BAG":                         SLEEPING_BAG,
+	"A_BLUE_WIZARDS_HAT":                   A_BLUE_WIZARDS_HAT,
+	"GILDED_TOTEM_POLE":                    GILDED_TOTEM_POLE,
+	"BLESSED_GOLDEN_BOWL":                  BLESSED_GOLDEN_BOWL,
+	"BLESSED_GOLDEN_BOWL_WITH_PURE_WATER":  BLESSED_GOLDEN_BOWL_WITH_PURE_WATER,
+	"RAW_OOMLIE_MEAT":                      RAW_OOMLIE_MEAT,
+	"COOKED_OOMLIE_MEAT_PARCEL":            COOKED_OOMLIE_MEAT_PARCEL,
+	"DRAGON_BONE_CERTIFICATE":              DRAGON_BONE_CERTIFICATE,
+	"LIMPWURT_ROOT_CERTIFICATE":            LIMPWURT_ROOT_CERTIFICATE,
+	"PRAYER_POTION_CERTIFICATE":            PRAYER_POTION_CERTIFICATE,
+	"SUPER_ATTACK_POTION_CERTIFICATE":      SUPER_ATTACK_POTION_CERTIFICATE,
+	"SUPER_DEFENSE_POTION_CERTIFICATE":     SUPER_DEFENSE_POTION_CERTIFICATE,
+	"SUPER_STRENGTH_POTION_CERTIFICATE":    SUPER_STRENGTH_POTION_CERTIFICATE,
+	"HALF_DRAGON_SQUARE_SHIELD":            HALF_DRAGON_SQUARE_SHIELD,
+	"HALF_DRAGON_SQUARE_SHIELD_1277":       HALF_DRAGON_SQUARE_SHIELD_1277,
+	"DRAGON_SQUARE_SHIELD":                 DRAGON_SQUARE_SHIELD,
+	"PALM_TREE_LEAF":                       PALM_TREE_LEAF,
+	"RAW_OOMLIE_MEAT_PARCEL":               RAW_OOMLIE_MEAT_PARCEL,
+	"BURNT_OOMLIE_MEAT_PARCEL":             BURNT_OOMLIE_MEAT_PARCEL,
+	"BAILING_BUCKET":                       BAILING_BUCKET,
+	"PLANK_1283":                           PLANK_1283,
+	"ARCENIA_ROOT":                         ARCENIA_ROOT,
+	"DISPLAY_TEA":                          DISPLAY_TEA,
+	"BLESSED_GOLDEN_BOWL_WITH_PLAIN_WATER": BLESSED_GOLDEN_BOWL_WITH_PLAIN_WATER,
+	"GOLDEN_BOWL_WITH_PLAIN_WATER":         GOLDEN_BOWL_WITH_PLAIN_WATER,
+	"CAPE_OF_LEGENDS":                      CAPE_OF_LEGENDS,
+	"SCYTHE":                               SCYTHE,
+}
@@ -0,0 +1,1599 @@
+// Code generated by idgen from the entity database. DO NOT EDIT.
+
+package ids
+
+// The ids of every NPC definition.
+const (
+	NPC_UNICORN                      = 0   // Unicorn
+	NPC_BOB                          = 1   // Bob
+	NPC_SHEEP                        = 2   // Sheep
+	NPC_CHICKEN                      = 3   // Chicken
+	NPC_GOBLIN                       = 4   // Goblin
+	NPC_HANS                         = 5   // Hans
+	NPC_COW                          = 6   // cow
+	NPC_COOK                         = 7   // cook
+	NPC_BEAR                         = 8   // Bear
+	NPC_PRIEST                       = 9   // Priest
+	NPC_URHNEY                       = 10  // Urhney
+	NPC_MAN                          = 11  // Man
+	NPC_BARTENDER                    = 12  // Bartender
+	NPC_CAMEL                        = 13  // Camel
+	NPC_GYPSY                        = 14  // Gypsy
+	NPC_GHOST                        = 15  // Ghost
+	NPC_SIR_PRYSIN                   = 16  // Sir Prysin
+	NPC_TRAIBORN_THE_WIZARD          = 17  // Traiborn the wizard
+	NPC_CAPTAIN_ROVIN                = 18  // Captain Rovin
+	NPC_RAT                          = 19  // Rat
+	NPC_RELDO                        = 20  // Reldo
+	NPC_MUGGER                       = 21  // mugger
+	NPC_LESSER_DEMON                 = 22  // Lesser Demon
+	NPC_GIANT_SPIDER                 = 23  // Giant Spider
+	NPC_MAN_24                       = 24  // Man
+	NPC_JONNY_THE_BEARD              = 25  // Jonny the beard
+	NPC_BARAEK                       = 26  // Baraek
+	NPC_KATRINE                      = 27  // Katrine
+	NPC_TRAMP                        = 28  // Tramp
+	NPC_RAT_29                       = 29  // Rat
+	NPC_ROMEO                        = 30  // Romeo
+	NPC_JULIET                       = 31  // Juliet
+	NPC_FATHER_LAWRENCE              = 32  // Father Lawrence
+	NPC_APOTHECARY                   = 33  // Apothecary
+	NPC_SPIDER                       = 34  // spider
+	NPC_DELRITH                      = 35  // Delrith
+	NPC_VERONICA                     = 36  // Veronica
+	NPC_WEAPONSMASTER                = 37  // Weaponsmaster
+	NPC_PROFESSOR_ODDENSTEIN         = 38  // Professor Oddenstein
+	NPC_CURATOR                      = 39  // Curator
+	NPC_SKELETON                     = 40  // skeleton
+	NPC_ZOMBIE                       = 41  // zombie
+	NPC_KING                         = 42  // king
+	NPC_GIANT_BAT                    = 43  // Giant bat
+	NPC_BARTENDER_44                 = 44  // Bartender
+	NPC_SKELETON_45                  = 45  // skeleton
+	NPC_SKELETON_46                  = 46  // skeleton
+	NPC_RAT_47                       = 47  // Rat
+	NPC_HORVIK_THE_ARMOURER          = 48  // Horvik the Armourer
+	NPC_BEAR_49                      = 49  // Bear
+	NPC_SKELETON_50                  = 50  // skeleton
+	NPC_SHOPKEEPER                   = 51  // Shopkeeper
+	NPC_ZOMBIE_52                    = 52  // zombie
+	NPC_GHOST_53                     = 53  // Ghost
+	NPC_AUBURY                       = 54  // Aubury
+	NPC_SHOPKEEPER_55                = 55  // Shopkeeper
+	NPC_SHOPKEEPER_56                = 56  // shopkeeper
+	NPC_DARKWIZARD                   = 57  // Darkwizard
+	NPC_LOWE                         = 58  // lowe
+	NPC_THESSALIA                    = 59  // Thessalia
+	NPC_DARKWIZARD_60                = 60  // Darkwizard
+	NPC_GIANT                        = 61  // Giant
+	NPC_GOBLIN_62                    = 62  // Goblin
+	NPC_FARMER                       = 63  // farmer
+	NPC_THIEF                        = 64  // Thief
+	NPC_GUARD                        = 65  // Guard
+	NPC_BLACK_KNIGHT                 = 66  // Black Knight
+	NPC_HOBGOBLIN                    = 67  // Hobgoblin
+	NPC_ZOMBIE_68                    = 68  // zombie
+	NPC_ZAFF                         = 69  // Zaff
+	NPC_SCORPION                     = 70  // Scorpion
+	NPC_SILK_TRADER                  = 71  // silk trader
+	NPC_MAN_72                       = 72  // Man
+	NPC_GUIDE                        = 73  // Guide
+	NPC_GIANT_SPIDER_74              = 74  // Giant Spider
+	NPC_PEKSA                        = 75  // Peksa
+	NPC_BARBARIAN                    = 76  // Barbarian
+	NPC_FRED_THE_FARMER              = 77  // Fred the farmer
+	NPC_GUNTHOR_THE_BRAVE            = 78  // Gunthor the Brave
+	NPC_WITCH                        = 79  // Witch
+	NPC_GHOST_80                     = 80  // Ghost
+	NPC_WIZARD                       = 81  // Wizard
+	NPC_SHOP_ASSISTANT               = 82  // Shop Assistant
+	NPC_SHOP_ASSISTANT_83            = 83  // Shop Assistant
+	NPC_ZEKE                         = 84  // Zeke
+	NPC_LOUIE_LEGS                   = 85  // Louie Legs
+	NPC_WARRIOR                      = 86  // Warrior
+	NPC_SHOPKEEPER_87                = 87  // Shopkeeper
+	NPC_SHOP_ASSISTANT_88            = 88  // Shop Assistant
+	NPC_HIGHWAYMAN                   = 89  // Highwayman
+	NPC_KEBAB_SELLER                 = 90  // Kebab Seller
+	NPC_CHICKEN_91                   = 91  // Chicken
+	NPC_ERNEST                       = 92  // Ernest
+	NPC_MONK                         = 93  // Monk
+	NPC_DWARF                        = 94  // Dwarf
+	NPC_BANKER                       = 95  // Banker
+	NPC_COUNT_DRAYNOR                = 96  // Count Draynor
+	NPC_MORGAN                       = 97  // Morgan
+	NPC_DR_HARLOW                    = 98  // Dr Harlow
+	NPC_DEADLY_RED_SPIDER            = 99  // Deadly Red spider
+	NPC_GUARD_100                    = 100 // Guard
+	NPC_CASSIE                       = 101 // Cassie
+	NPC_WHITE_KNIGHT                 = 102 // White Knight
+	NPC_RANAEL                       = 103 // Ranael
+	NPC_MOSS_GIANT                   = 104 // Moss Giant
+	NPC_SHOPKEEPER_105               = 105 // Shopkeeper
+	NPC_SHOP_ASSISTANT_106           = 106 // Shop Assistant
+	NPC_WITCH_107                    = 107 // Witch
+	NPC_BLACK_KNIGHT_108             = 108 // Black Knight
+	NPC_GRELDO                       = 109 // Greldo
+	NPC_SIR_AMIK_VARZE               = 110 // Sir Amik Varze
+	NPC_GUILDMASTER                  = 111 // Guildmaster
+	NPC_VALAINE                      = 112 // Valaine
+	NPC_DROGO                        = 113 // Drogo
+	NPC_IMP                          = 114 // Imp
+	NPC_FLYNN                        = 115 // Flynn
+	NPC_WYSON_THE_GARDENER           = 116 // Wyson the gardener
+	NPC_WIZARD_MIZGOG                = 117 // Wizard Mizgog
+	NPC_PRINCE_ALI                   = 118 // Prince Ali
+	NPC_HASSAN                       = 119 // Hassan
+	NPC_OSMAN                        = 120 // Osman
+	NPC_JOE                          = 121 // Joe
+	NPC_LEELA                        = 122 // Leela
+	NPC_LADY_KELI                    = 123 // Lady Keli
+	NPC_NED                          = 124 // Ned
+	NPC_AGGIE                        = 125 // Aggie
+	NPC_PRINCE_ALI_126               = 126 // Prince Ali
+	NPC_JAILGUARD                    = 127 // Jailguard
+	NPC_REDBEARD_FRANK               = 128 // Redbeard Frank
+	NPC_WYDIN                        = 129 // Wydin
+	NPC_SHOP_ASSISTANT_130           = 130 // shop assistant
+	NPC_BRIAN                        = 131 // Brian
+	NPC_SQUIRE                       = 132 // squire
+	NPC_HEAD_CHEF                    = 133 // Head chef
+	NPC_THURGO                       = 134 // Thurgo
+	NPC_ICE_GIANT                    = 135 // Ice Giant
+	NPC_KING_SCORPION                = 136 // King Scorpion
+	NPC_PIRATE                       = 137 // Pirate
+	NPC_SIR_VYVIN                    = 138 // Sir Vyvin
+	NPC_MONK_OF_ZAMORAK              = 139 // Monk of Zamorak
+	NPC_MONK_OF_ZAMORAK_140          = 140 // Monk of Zamorak
+	NPC_WAYNE                        = 141 // Wayne
+	NPC_BARMAID                      = 142 // Barmaid
+	NPC_DWARVEN_SHOPKEEPER           = 143 // Dwarven shopkeeper
+	NPC_DORIC                        = 144 // Doric
+	NPC_SHOPKEEPER_145               = 145 // Shopkeeper
+	NPC_SHOP_ASSISTANT_146           = 146 // Shop Assistant
+	NPC_GUIDE_147                    = 147 // Guide
+	NPC_HETTY                        = 148 // Hetty
+	NPC_BETTY                        = 149 // Betty
+	NPC_BARTENDER_150                = 150 // Bartender
+	NPC_GENERAL_WARTFACE             = 151 // General wartface
+	NPC_GENERAL_BENTNOZE             = 152 // General Bentnoze
+	NPC_GOBLIN_153                   = 153 // Goblin
+	NPC_GOBLIN_154                   = 154 // Goblin
+	NPC_HERQUIN                      = 155 // Herquin
+	NPC_ROMMIK                       = 156 // Rommik
+	NPC_GRUM                         = 157 // Grum
+	NPC_ICE_WARRIOR                  = 158 // Ice warrior
+	NPC_WARRIOR_159                  = 159 // Warrior
+	NPC_THRANDER                     = 160 // Thrander
+	NPC_BORDER_GUARD                 = 161 // Border Guard
+	NPC_BORDER_GUARD_162             = 162 // Border Guard
+	NPC_CUSTOMS_OFFICER              = 163 // Customs Officer
+	NPC_LUTHAS                       = 164 // Luthas
+	NPC_ZAMBO                        = 165 // Zambo
+	NPC_CAPTAIN_TOBIAS               = 166 // Captain Tobias
+	NPC_GERRANT                      = 167 // Gerrant
+	NPC_SHOPKEEPER_168               = 168 // Shopkeeper
+	NPC_SHOP_ASSISTANT_169           = 169 // Shop Assistant
+	NPC_SEAMAN_LORRIS                = 170 // Seaman Lorris
+	NPC_SEAMAN_THRESNOR              = 171 // Seaman Thresnor
+	NPC_TANNER                       = 172 // Tanner
+	NPC_DOMMIK                       = 173 // Dommik
+	NPC_ABBOT_LANGLEY                = 174 // Abbot Langley
+	NPC_THORDUR                      = 175 // Thordur
+	NPC_BROTHER_JERED                = 176 // Brother Jered
+	NPC_RAT_177                      = 177 // Rat
+	NPC_GHOST_178                    = 178 // Ghost
+	NPC_SKELETON_179                 = 179 // skeleton
+	NPC_ZOMBIE_180                   = 180 // zombie
+	NPC_LESSER_DEMON_181             = 181 // Lesser Demon
+	NPC_MELZAR_THE_MAD               = 182 // Melzar the mad
+	NPC_SCAVVO                       = 183 // Scavvo
+	NPC_GREATER_DEMON                = 184 // Greater Demon
+	NPC_SHOPKEEPER_185               = 185 // Shopkeeper
+	NPC_SHOP_ASSISTANT_186           = 186 // Shop Assistant
+	NPC_OZIACH                       = 187 // Oziach
+	NPC_BEAR_188                     = 188 // Bear
+	NPC_BLACK_KNIGHT_189             = 189 // Black Knight
+	NPC_CHAOS_DWARF                  = 190 // chaos Dwarf
+	NPC_DWARF_191                    = 191 // dwarf
+	NPC_WORMBRAIN                    = 192 // Wormbrain
+	NPC_KLARENSE                     = 193 // Klarense
+	NPC_NED_194                      = 194 // Ned
+	NPC_SKELETON_195                 = 195 // skeleton
+	NPC_DRAGON                       = 196 // Dragon
+	NPC_ORACLE                       = 197 // Oracle
+	NPC_DUKE_OF_LUMBRIDGE            = 198 // Duke of Lumbridge
+	NPC_DARK_WARRIOR                 = 199 // Dark Warrior
+	NPC_DRUID                        = 200 // Druid
+	NPC_RED_DRAGON                   = 201 // Red Dragon
+	NPC_BLUE_DRAGON                  = 202 // Blue Dragon
+	NPC_BABY_BLUE_DRAGON             = 203 // Baby Blue Dragon
+	NPC_KAQEMEEX                     = 204 // Kaqemeex
+	NPC_SANFEW                       = 205 // Sanfew
+	NPC_SUIT_OF_ARMOUR               = 206 // Suit of armour
+	NPC_ADVENTURER                   = 207 // Adventurer
+	NPC_ADVENTURER_208               = 208 // Adventurer
+	NPC_ADVENTURER_209               = 209 // Adventurer
+	NPC_ADVENTURER_210               = 210 // Adventurer
+	NPC_LEPRECHAUN                   = 211 // Leprechaun
+	NPC_MONK_OF_ENTRANA              = 212 // Monk of entrana
+	NPC_MONK_OF_ENTRANA_213          = 213 // Monk of entrana
+	NPC_ZOMBIE_214                   = 214 // zombie
+	NPC_MONK_OF_ENTRANA_215          = 215 // Monk of entrana
+	NPC_TREE_SPIRIT                  = 216 // tree spirit
+	NPC_COW_217                      = 217 // cow
+	NPC_IRKSOL                       = 218 // Irksol
+	NPC_FAIRY_LUNDERWIN              = 219 // Fairy Lunderwin
+	NPC_JAKUT                        = 220 // Jakut
+	NPC_DOORMAN                      = 221 // Doorman
+	NPC_FAIRY_SHOPKEEPER             = 222 // Fairy Shopkeeper
+	NPC_FAIRY_SHOP_ASSISTANT         = 223 // Fairy Shop Assistant
+	NPC_FAIRY_BANKER                 = 224 // Fairy banker
+	NPC_GILES                        = 225 // Giles
+	NPC_MILES                        = 226 // Miles
+	NPC_NILES                        = 227 // Niles
+	NPC_GAIUS                        = 228 // Gaius
+	NPC_FAIRY_LADDER_ATTENDANT       = 229 // Fairy Ladder attendant
+	NPC_JATIX                        = 230 // Jatix
+	NPC_MASTER_CRAFTER               = 231 // Master Crafter
+	NPC_BANDIT                       = 232 // Bandit
+	NPC_NOTERAZZO                    = 233 // Noterazzo
+	NPC_BANDIT_234                   = 234 // Bandit
+	NPC_FAT_TONY                     = 235 // Fat Tony
+	NPC_DONNY_THE_LAD                = 236 // Donny the lad
+	NPC_BLACK_HEATHER                = 237 // Black Heather
+	NPC_SPEEDY_KEITH                 = 238 // Speedy Keith
+	NPC_WHITE_WOLF_SENTRY            = 239 // White wolf sentry
+	NPC_BOY                          = 240 // Boy
+	NPC_RAT_241                      = 241 // Rat
+	NPC_NORA_T_HAG                   = 242 // Nora T Hag
+	NPC_GREY_WOLF                    = 243 // Grey wolf
+	NPC_SHAPESHIFTER                 = 244 // shapeshifter
+	NPC_SHAPESHIFTER_245             = 245 // shapeshifter
+	NPC_SHAPESHIFTER_246             = 246 // shapeshifter
+	NPC_SHAPESHIFTER_247             = 247 // shapeshifter
+	NPC_WHITE_WOLF                   = 248 // White wolf
+	NPC_PACK_LEADER                  = 249 // Pack leader
+	NPC_HARRY                        = 250 // Harry
+	NPC_THUG                         = 251 // Thug
+	NPC_FIREBIRD                     = 252 // Firebird
+	NPC_ACHETTIES                    = 253 // Achetties
+	NPC_ICE_QUEEN                    = 254 // Ice queen
+	NPC_GRUBOR                       = 255 // Grubor
+	NPC_TROBERT                      = 256 // Trobert
+	NPC_GARV                         = 257 // Garv
+	NPC_GUARD_258                    = 258 // guard
+	NPC_GRIP                         = 259 // Grip
+	NPC_ALFONSE_THE_WAITER           = 260 // Alfonse the waiter
+	NPC_CHARLIE_THE_COOK             = 261 // Charlie the cook
+	NPC_GUARD_DOG                    = 262 // Guard Dog
+	NPC_ICE_SPIDER                   = 263 // Ice spider
+	NPC_PIRATE_264                   = 264 // Pirate
+	NPC_JAILER                       = 265 // Jailer
+	NPC_LORD_DARQUARIUS              = 266 // Lord Darquarius
+	NPC_SETH                         = 267 // Seth
+	NPC_BANKER_268                   = 268 // Banker
+	NPC_HELEMOS                      = 269 // Helemos
+	NPC_CHAOS_DRUID                  = 270 // Chaos Druid
+	NPC_POISON_SCORPION              = 271 // Poison Scorpion
+	NPC_VELRAK_THE_EXPLORER          = 272 // Velrak the explorer
+	NPC_SIR_LANCELOT                 = 273 // Sir Lancelot
+	NPC_SIR_GAWAIN                   = 274 // Sir Gawain
+	NPC_KING_ARTHUR                  = 275 // King Arthur
+	NPC_SIR_MORDRED                  = 276 // Sir Mordred
+	NPC_RENEGADE_KNIGHT              = 277 // Renegade knight
+	NPC_DAVON                        = 278 // Davon
+	NPC_BARTENDER_279                = 279 // Bartender
+	NPC_ARHEIN                       = 280 // Arhein
+	NPC_MORGAN_LE_FAYE               = 281 // Morgan le faye
+	NPC_CANDLEMAKER                  = 282 // Candlemaker
+	NPC_LADY                         = 283 // lady
+	NPC_LADY_284                     = 284 // lady
+	NPC_LADY_285                     = 285 // lady
+	NPC_BEGGAR                       = 286 // Beggar
+	NPC_MERLIN                       = 287 // Merlin
+	NPC_THRANTAX                     = 288 // Thrantax
+	NPC_HICKTON                      = 289 // Hickton
+	NPC_BLACK_DEMON                  = 290 // Black Demon
+	NPC_BLACK_DRAGON                 = 291 // Black Dragon
+	NPC_POISON_SPIDER                = 292 // Poison Spider
+	NPC_MONK_OF_ZAMORAK_293          = 293 // Monk of Zamorak
+	NPC_HELLHOUND                    = 294 // Hellhound
+	NPC_ANIMATED_AXE                 = 295 // Animated axe
+	NPC_BLACK_UNICORN                = 296 // Black Unicorn
+	NPC_FRINCOS                      = 297 // Frincos
+	NPC_OTHERWORLDLY_BEING           = 298 // Otherworldly being
+	NPC_OWEN                         = 299 // Owen
+	NPC_THORMAC_THE_SORCEROR         = 300 // Thormac the sorceror
+	NPC_SEER                         = 301 // Seer
+	NPC_KHARID_SCORPION              = 302 // Kharid Scorpion
+	NPC_KHARID_SCORPION_303          = 303 // Kharid Scorpion
+	NPC_KHARID_SCORPION_304          = 304 // Kharid Scorpion
+	NPC_BARBARIAN_GUARD              = 305 // Barbarian guard
+	NPC_BARTENDER_306                = 306 // Bartender
+	NPC_MAN_307                      = 307 // man
+	NPC_GEM_TRADER                   = 308 // gem trader
+	NPC_DIMINTHEIS                   = 309 // Dimintheis
+	NPC_CHEF                         = 310 // chef
+	NPC_HOBGOBLIN_311                = 311 // Hobgoblin
+	NPC_OGRE                         = 312 // Ogre
+	NPC_BOOT_THE_DWARF               = 313 // Boot the Dwarf
+	NPC_WIZARD_314                   = 314 // Wizard
+	NPC_CHRONOZON                    = 315 // Chronozon
+	NPC_CAPTAIN_BARNABY              = 316 // Captain Barnaby
+	NPC_CUSTOMS_OFFICIAL             = 317 // Customs Official
+	NPC_MAN_318                      = 318 // Man
+	NPC_FARMER_319                   = 319 // farmer
+	NPC_WARRIOR_320                  = 320 // Warrior
+	NPC_GUARD_321                    = 321 // Guard
+	NPC_KNIGHT                       = 322 // Knight
+	NPC_PALADIN                      = 323 // Paladin
+	NPC_HERO                         = 324 // Hero
+	NPC_BAKER                        = 325 // Baker
+	NPC_SILK_MERCHANT                = 326 // silk merchant
+	NPC_FUR_TRADER                   = 327 // Fur trader
+	NPC_SILVER_MERCHANT              = 328 // silver merchant
+	NPC_SPICE_MERCHANT               = 329 // spice merchant
+	NPC_GEM_MERCHANT                 = 330 // gem merchant
+	NPC_ZENESHA                      = 331 // Zenesha
+	NPC_KANGAI_MAU                   = 332 // Kangai Mau
+	NPC_WIZARD_CROMPERTY             = 333 // Wizard Cromperty
+	NPC_RPDT_EMPLOYEE                = 334 // RPDT employee
+	NPC_HORACIO                      = 335 // Horacio
+	NPC_AEMAD                        = 336 // Aemad
+	NPC_KORTAN                       = 337 // Kortan
+	NPC_ZOO_KEEPER                   = 338 // zoo keeper
+	NPC_MAKE_OVER_MAGE               = 339 // Make over mage
+	NPC_BARTENDER_340                = 340 // Bartender
+	NPC_CHUCK                        = 341 // chuck
+	NPC_ROGUE                        = 342 // Rogue
+	NPC_SHADOW_SPIDER                = 343 // Shadow spider
+	NPC_FIRE_GIANT                   = 344 // Fire Giant
+	NPC_GRANDPA_JACK                 = 345 // Grandpa Jack
+	NPC_SINISTER_STRANGER            = 346 // Sinister stranger
+	NPC_BONZO                        = 347 // Bonzo
+	NPC_FORESTER                     = 348 // Forester
+	NPC_MORRIS                       = 349 // Morris
+	NPC_BROTHER_OMAD                 = 350 // Brother Omad
+	NPC_THIEF_351                    = 351 // Thief
+	NPC_HEAD_THIEF                   = 352 // Head Thief
+	NPC_BIG_DAVE                     = 353 // Big Dave
+	NPC_JOSHUA                       = 354 // Joshua
+	NPC_MOUNTAIN_DWARF               = 355 // Mountain Dwarf
+	NPC_MOUNTAIN_DWARF_356           = 356 // Mountain Dwarf
+	NPC_BROTHER_CEDRIC               = 357 // Brother Cedric
+	NPC_NECROMANCER                  = 358 // Necromancer
+	NPC_ZOMBIE_359                   = 359 // zombie
+	NPC_LUCIEN                       = 360 // Lucien
+	NPC_THE_FIRE_WARRIOR_OF_LESARKUS = 361 // The Fire warrior of lesarkus
+	NPC_GUARDIAN_OF_ARMADYL          = 362 // guardian of Armadyl
+	NPC_GUARDIAN_OF_ARMADYL_363      = 363 // guardian of Armadyl
+	NPC_LUCIEN_364                   = 364 // Lucien
+	NPC_WINELDA                      = 365 // winelda
+	NPC_BROTHER_KOJO                 = 366 // Brother Kojo
+	NPC_DUNGEON_RAT                  = 367 // Dungeon Rat
+	NPC_MASTER_FISHER                = 368 // Master fisher
+	NPC_ORVEN                        = 369 // Orven
+	NPC_PADIK                        = 370 // Padik
+	NPC_SHOPKEEPER_371               = 371 // Shopkeeper
+	NPC_LADY_SERVIL                  = 372 // Lady servil
+	NPC_GUARD_373                    = 373 // Guard
+	NPC_GUARD_374                    = 374 // Guard
+	NPC_GUARD_375                    = 375 // Guard
+	NPC_GUARD_376                    = 376 // Guard
+	NPC_JEREMY_SERVIL                = 377 // Jeremy Servil
+	NPC_JUSTIN_SERVIL                = 378 // Justin Servil
+	NPC_FIGHTSLAVE_JOE               = 379 // fightslave joe
+	NPC_FIGHTSLAVE_KELVIN            = 380 // fightslave kelvin
+	NPC_LOCAL                        = 381 // local
+	NPC_KHAZARD_BARTENDER            = 382 // Khazard Bartender
+	NPC_GENERAL_KHAZARD              = 383 // General Khazard
+	NPC_KHAZARD_OGRE                 = 384 // Khazard Ogre
+	NPC_GUARD_385                    = 385 // Guard
+	NPC_KHAZARD_SCORPION             = 386 // Khazard Scorpion
+	NPC_HENGRAD                      = 387 // hengrad
+	NPC_BOUNCER                      = 388 // Bouncer
+	NPC_STANKERS                     = 389 // Stankers
+	NPC_DOCKY                        = 390 // Docky
+	NPC_SHOPKEEPER_391               = 391 // Shopkeeper
+	NPC_FAIRY_QUEEN                  = 392 // Fairy queen
+	NPC_MERLIN_393                   = 393 // Merlin
+	NPC_CRONE                        = 394 // Crone
+	NPC_HIGH_PRIEST_OF_ENTRANA       = 395 // High priest of entrana
+	NPC_ELKOY                        = 396 // elkoy
+	NPC_REMSAI                       = 397 // remsai
+	NPC_BOLKOY                       = 398 // bolkoy
+	NPC_LOCAL_GNOME                  = 399 // local gnome
+	NPC_BOLREN                       = 400 // bolren
+	NPC_BLACK_KNIGHT_TITAN           = 401 // Black Knight titan
+	NPC_KALRON                       = 402 // kalron
+	NPC_BROTHER_GALAHAD              = 403 // brother Galahad
+	NPC_TRACKER_1                    = 404 // tracker 1
+	NPC_TRACKER_2                    = 405 // tracker 2
+	NPC_TRACKER_3                    = 406 // tracker 3
+	NPC_KHAZARD_TROOP                = 407 // Khazard troop
+	NPC_COMMANDER_MONTAI             = 408 // commander montai
+	NPC_GNOME_TROOP                  = 409 // gnome troop
+	NPC_KHAZARD_WARLORD              = 410 // khazard warlord
+	NPC_SIR_PERCIVAL                 = 411 // Sir Percival
+	NPC_FISHER_KING                  = 412 // Fisher king
+	NPC_MAIDEN                       = 413 // maiden
+	NPC_FISHERMAN                    = 414 // Fisherman
+	NPC_KING_PERCIVAL                = 415 // King Percival
+	NPC_UNHAPPY_PEASANT              = 416 // unhappy peasant
+	NPC_HAPPY_PEASANT                = 417 // happy peasant
+	NPC_CERIL                        = 418 // ceril
+	NPC_BUTLER                       = 419 // butler
+	NPC_CARNILLEAN_GUARD             = 420 // carnillean guard
+	NPC_TRIBESMAN                    = 421 // Tribesman
+	NPC_HENRYETA                     = 422 // henryeta
+	NPC_PHILIPE                      = 423 // philipe
+	NPC_CLIVET                       = 424 // clivet
+	NPC_CULT_MEMBER                  = 425 // cult member
+	NPC_LORD_HAZEEL                  = 426 // Lord hazeel
+	NPC_ALOMONE                      = 427 // alomone
+	NPC_KHAZARD_COMMANDER            = 428 // Khazard commander
+	NPC_CLAUS                        = 429 // claus
+	NPC_N1ST_PLAGUE_SHEEP            = 430 // 1st plague sheep
+	NPC_N2ND_PLAGUE_SHEEP            = 431 // 2nd plague sheep
+	NPC_N3RD_PLAGUE_SHEEP            = 432 // 3rd plague sheep
+	NPC_N4TH_PLAGUE_SHEEP            = 433 // 4th plague sheep
+	NPC_FARMER_BRUMTY                = 434 // Farmer brumty
+	NPC_DOCTOR_ORBON                 = 435 // Doctor orbon
+	NPC_COUNCILLOR_HALGRIVE          = 436 // Councillor Halgrive
+	NPC_EDMOND                       = 437 // Edmond
+	NPC_CITIZEN                      = 438 // Citizen
+	NPC_CITIZEN_439                  = 439 // Citizen
+	NPC_CITIZEN_440                  = 440 // Citizen
+	NPC_CITIZEN_441                  = 441 // Citizen
+	NPC_CITIZEN_442                  = 442 // Citizen
+	NPC_JETHICK                      = 443 // Jethick
+	NPC_MOURNER                      = 444 // Mourner
+	NPC_MOURNER_445                  = 445 // Mourner
+	NPC_TED_REHNISON                 = 446 // Ted Rehnison
+	NPC_MARTHA_REHNISON              = 447 // Martha Rehnison
+	NPC_BILLY_REHNISON               = 448 // Billy Rehnison
+	NPC_MILLI_REHNISON               = 449 // Milli Rehnison
+	NPC_ALRENA                       = 450 // Alrena
+	NPC_MOURNER_451                  = 451 // Mourner
+	NPC_CLERK                        = 452 // Clerk
+	NPC_CARLA                        = 453 // Carla
+	NPC_BRAVEK                       = 454 // Bravek
+	NPC_CAROLINE                     = 455 // Caroline
+	NPC_HOLGART                      = 456 // Holgart
+	NPC_HOLGART_457                  = 457 // Holgart
+	NPC_HOLGART_458                  = 458 // Holgart
+	NPC_KENT                         = 459 // kent
+	NPC_BAILEY                       = 460 // bailey
+	NPC_KENNITH                      = 461 // kennith
+	NPC_PLATFORM_FISHERMAN           = 462 // Platform Fisherman
+	NPC_PLATFORM_FISHERMAN_463       = 463 // Platform Fisherman
+	NPC_PLATFORM_FISHERMAN_464       = 464 // Platform Fisherman
+	NPC_ELENA                        = 465 // Elena
+	NPC_JINNO                        = 466 // jinno
+	NPC_WATTO                        = 467 // Watto
+	NPC_RECRUITER                    = 468 // Recruiter
+	NPC_HEAD_MOURNER                 = 469 // Head mourner
+	NPC_ALMERA                       = 470 // Almera
+	NPC_HUDON                        = 471 // hudon
+	NPC_HADLEY                       = 472 // hadley
+	NPC_RAT_473                      = 473 // Rat
+	NPC_COMBAT_INSTRUCTOR            = 474 // Combat instructor
+	NPC_GOLRIE                       = 475 // golrie
+	NPC_GUIDE_476                    = 476 // Guide
+	NPC_KING_BLACK_DRAGON            = 477 // King Black Dragon
+	NPC_COOKING_INSTRUCTOR           = 478 // cooking instructor
+	NPC_FISHING_INSTRUCTOR           = 479 // fishing instructor
+	NPC_FINANCIAL_ADVISOR            = 480 // financial advisor
+	NPC_GERALD                       = 481 // gerald
+	NPC_MINING_INSTRUCTOR            = 482 // mining instructor
+	NPC_ELENA_483                    = 483 // Elena
+	NPC_OMART                        = 484 // Omart
+	NPC_BANK_ASSISTANT               = 485 // Bank assistant
+	NPC_JERICO                       = 486 // Jerico
+	NPC_KILRON                       = 487 // Kilron
+	NPC_GUIDOR_S_WIFE                = 488 // Guidor's wife
+	NPC_QUEST_ADVISOR                = 489 // Quest advisor
+	NPC_CHEMIST                      = 490 // chemist
+	NPC_MOURNER_491                  = 491 // Mourner
+	NPC_MOURNER_492                  = 492 // Mourner
+	NPC_WILDERNESS_GUIDE             = 493 // Wilderness guide
+	NPC_MAGIC_INSTRUCTOR             = 494 // Magic Instructor
+	NPC_MOURNER_495                  = 495 // Mourner
+	NPC_COMMUNITY_INSTRUCTOR         = 496 // Community instructor
+	NPC_BOATMAN                      = 497 // boatman
+	NPC_SKELETON_MAGE                = 498 // skeleton mage
+	NPC_CONTROLS_GUIDE               = 499 // controls guide
+	NPC_NURSE_SARAH                  = 500 // nurse sarah
+	NPC_TAILOR                       = 501 // Tailor
+	NPC_MOURNER_502                  = 502 // Mourner
+	NPC_GUARD_503                    = 503 // Guard
+	NPC_CHEMIST_504                  = 504 // Chemist
+	NPC_CHANCY                       = 505 // Chancy
+	NPC_HOPS                         = 506 // Hops
+	NPC_DEVINCI                      = 507 // DeVinci
+	NPC_GUIDOR                       = 508 // Guidor
+	NPC_CHANCY_509                   = 509 // Chancy
+	NPC_HOPS_510                     = 510 // Hops
+	NPC_DEVINCI_511                  = 511 // DeVinci
+	NPC_KING_LATHAS                  = 512 // king Lathas
+	NPC_HEAD_WIZARD                  = 513 // Head wizard
+	NPC_MAGIC_STORE_OWNER            = 514 // Magic store owner
+	NPC_WIZARD_FRUMSCONE             = 515 // Wizard Frumscone
+	NPC_TARGET_PRACTICE_ZOMBIE       = 516 // target practice zombie
+	NPC_TRUFITUS                     = 517 // Trufitus
+	NPC_COLONEL_RADICK               = 518 // Colonel Radick
+	NPC_SOLDIER                      = 519 // Soldier
+	NPC_BARTENDER_520                = 520 // Bartender
+	NPC_JUNGLE_SPIDER                = 521 // Jungle Spider
+	NPC_JIMINUA                      = 522 // Jiminua
+	NPC_JOGRE                        = 523 // Jogre
+	NPC_GUARD_524                    = 524 // Guard
+	NPC_OGRE_525                     = 525 // Ogre
+	NPC_GUARD_526                    = 526 // Guard
+	NPC_GUARD_527                    = 527 // Guard
+	NPC_SHOP_KEEPER                  = 528 // shop keeper
+	NPC_BARTENDER_529                = 529 // Bartender
+	NPC_FRENITA                      = 530 // Frenita
+	NPC_OGRE_CHIEFTAN                = 531 // Ogre chieftan
+	NPC_ROMETTI                      = 532 // rometti
+	NPC_RASHILIYIA                   = 533 // Rashiliyia
+	NPC_BLURBERRY                    = 534 // Blurberry
+	NPC_HECKEL_FUNCH                 = 535 // Heckel funch
+	NPC_ALUFT_GIANNE                 = 536 // Aluft Gianne
+	NPC_HUDO_GLENFAD                 = 537 // Hudo glenfad
+	NPC_IRENA                        = 538 // Irena
+	NPC_MOSOL                        = 539 // Mosol
+	NPC_GNOME_BANKER                 = 540 // Gnome banker
+	NPC_KING_NARNODE_SHAREEN         = 541 // King Narnode Shareen
+	NPC_UNDEADONE                    = 542 // UndeadOne
+	NPC_DRUCAS                       = 543 // Drucas
+	NPC_TOURIST                      = 544 // tourist
+	NPC_KING_NARNODE_SHAREEN_545     = 545 // King Narnode Shareen
+	NPC_HAZELMERE                    = 546 // Hazelmere
+	NPC_GLOUGH                       = 547 // Glough
+	NPC_SHAR                         = 548 // Shar
+	NPC_SHANTAY                      = 549 // Shantay
+	NPC_CHARLIE                      = 550 // charlie
+	NPC_GNOME_GUARD                  = 551 // Gnome guard
+	NPC_GNOME_PILOT                  = 552 // Gnome pilot
+	NPC_MEHMAN                       = 553 // Mehman
+	NPC_ANA                          = 554 // Ana
+	NPC_CHAOS_DRUID_WARRIOR          = 555 // Chaos Druid warrior
+	NPC_GNOME_PILOT_556              = 556 // Gnome pilot
+	NPC_SHIPYARD_WORKER              = 557 // Shipyard worker
+	NPC_SHIPYARD_WORKER_558          = 558 // Shipyard worker
+	NPC_SHIPYARD_WORKER_559          = 559 // Shipyard worker
+	NPC_SHIPYARD_FOREMAN             = 560 // Shipyard foreman
+	NPC_SHIPYARD_FOREMAN_561         = 561 // Shipyard foreman
+	NPC_GNOME_GUARD_562              = 562 // Gnome guard
+	NPC_FEMI                         = 563 // Femi
+	NPC_FEMI_564                     = 564 // Femi
+	NPC_ANITA                        = 565 // Anita
+	NPC_GLOUGH_566                   = 566 // Glough
+	NPC_SALARIN_THE_TWISTED          = 567 // Salarin the twisted
+	NPC_BLACK_DEMON_568              = 568 // Black Demon
+	NPC_GNOME_PILOT_569              = 569 // Gnome pilot
+	NPC_GNOME_PILOT_570              = 570 // Gnome pilot
+	NPC_GNOME_PILOT_571              = 571 // Gnome pilot
+	NPC_GNOME_PILOT_572              = 572 // Gnome pilot
+	NPC_SIGBERT_THE_ADVENTURER       = 573 // Sigbert the Adventurer
+	NPC_YANILLE_WATCHMAN             = 574 // Yanille Watchman
+	NPC_TOWER_GUARD                  = 575 // Tower guard
+	NPC_GNOME_TRAINER                = 576 // Gnome Trainer
+	NPC_GNOME_TRAINER_577            = 577 // Gnome Trainer
+	NPC_GNOME_TRAINER_578            = 578 // Gnome Trainer
+	NPC_GNOME_TRAINER_579            = 579 // Gnome Trainer
+	NPC_BLURBERRY_BARMAN             = 580 // Blurberry barman
+	NPC_GNOME_WAITER                 = 581 // Gnome waiter
+	NPC_GNOME_GUARD_582              = 582 // Gnome guard
+	NPC_GNOME_CHILD                  = 583 // Gnome child
+	NPC_EARTH_WARRIOR                = 584 // Earth warrior
+	NPC_GNOME_CHILD_585              = 585 // Gnome child
+	NPC_GNOME_CHILD_586              = 586 // Gnome child
+	NPC_GULLUCK                      = 587 // Gulluck
+	NPC_GUNNJORN                     = 588 // Gunnjorn
+	NPC_ZADIMUS                      = 589 // Zadimus
+	NPC_BRIMSTAIL                    = 590 // Brimstail
+	NPC_GNOME_CHILD_591              = 591 // Gnome child
+	NPC_GNOME_LOCAL                  = 592 // Gnome local
+	NPC_GNOME_LOCAL_593              = 593 // Gnome local
+	NPC_MOSS_GIANT_594               = 594 // Moss Giant
+	NPC_GNOME_BALLER                 = 595 // Gnome Baller
+	NPC_GOALIE                       = 596 // Goalie
+	NPC_GNOME_BALLER_597             = 597 // Gnome Baller
+	NPC_GNOME_BALLER_598             = 598 // Gnome Baller
+	NPC_GNOME_BALLER_599             = 599 // Gnome Baller
+	NPC_GNOME_BALLER_600             = 600 // Gnome Baller
+	NPC_REFEREE                      = 601 // Referee
+	NPC_GNOME_BALLER_602             = 602 // Gnome Baller
+	NPC_GNOME_BALLER_603             = 603 // Gnome Baller
+	NPC_GNOME_BALLER_604             = 604 // Gnome Baller
+	NPC_GNOME_BALLER_605             = 605 // Gnome Baller
+	NPC_GNOME_BALLER_606             = 606 // Gnome Baller
+	NPC_GNOME_BALLER_607             = 607 // Gnome Baller
+	NPC_GNOME_BALLER_608             = 608 // Gnome Baller
+	NPC_GNOME_BALLER_609             = 609 // Gnome Baller
+	NPC_GNOME_BALLER_610             = 610 // Gnome Baller
+	NPC_CHEERLEADER                  = 611 // Cheerleader
+	NPC_CHEERLEADER_612              = 612 // Cheerleader
+	NPC_NAZASTAROOL_ZOMBIE           = 613 // Nazastarool Zombie
+	NPC_NAZASTAROOL_SKELETON         = 614 // Nazastarool Skeleton
+	NPC_NAZASTAROOL_GHOST            = 615 // Nazastarool Ghost
+	NPC_FERNAHEI                     = 616 // Fernahei
+	NPC_JUNGLE_BANKER                = 617 // Jungle Banker
+	NPC_CART_DRIVER                  = 618 // Cart Driver
+	NPC_CART_DRIVER_619              = 619 // Cart Driver
+	NPC_OBLI                         = 620 // Obli
+	NPC_KALEB                        = 621 // Kaleb
+	NPC_YOHNUS                       = 622 // Yohnus
+	NPC_SEREVEL                      = 623 // Serevel
+	NPC_YANNI                        = 624 // Yanni
+	NPC_OFFICIAL                     = 625 // Official
+	NPC_KOFTIK                       = 626 // Koftik
+	NPC_KOFTIK_627                   = 627 // Koftik
+	NPC_KOFTIK_628                   = 628 // Koftik
+	NPC_KOFTIK_629                   = 629 // Koftik
+	NPC_BLESSED_VERMEN               = 630 // Blessed Vermen
+	NPC_BLESSED_SPIDER               = 631 // Blessed Spider
+	NPC_PALADIN_632                  = 632 // Paladin
+	NPC_PALADIN_633                  = 633 // Paladin
+	NPC_SLAVE                        = 634 // slave
+	NPC_SLAVE_635                    = 635 // slave
+	NPC_SLAVE_636                    = 636 // slave
+	NPC_SLAVE_637                    = 637 // slave
+	NPC_SLAVE_638                    = 638 // slave
+	NPC_SLAVE_639                    = 639 // slave
+	NPC_SLAVE_640                    = 640 // slave
+	NPC_KALRAG                       = 641 // Kalrag
+	NPC_NILOOF                       = 642 // Niloof
+	NPC_KARDIA_THE_WITCH             = 643 // Kardia the Witch
+	NPC_SOULESS                      = 644 // Souless
+	NPC_OTHAINIAN                    = 645 // Othainian
+	NPC_DOOMION                      = 646 // Doomion
+	NPC_HOLTHION                     = 647 // Holthion
+	NPC_KLANK                        = 648 // Klank
+	NPC_IBAN                         = 649 // Iban
+	NPC_KOFTIK_650                   = 650 // Koftik
+	NPC_GOBLIN_GUARD                 = 651 // Goblin guard
+	NPC_OBSERVATORY_PROFESSOR        = 652 // Observatory Professor
+	NPC_UGTHANKI                     = 653 // Ugthanki
+	NPC_OBSERVATORY_ASSISTANT        = 654 // Observatory assistant
+	NPC_SOULESS_655                  = 655 // Souless
+	NPC_DUNGEON_SPIDER               = 656 // Dungeon spider
+	NPC_KAMEN                        = 657 // Kamen
+	NPC_IBAN_DISCIPLE                = 658 // Iban disciple
+	NPC_KOFTIK_659                   = 659 // Koftik
+	NPC_GOBLIN_660                   = 660 // Goblin
+	NPC_CHADWELL                     = 661 // Chadwell
+	NPC_PROFESSOR                    = 662 // Professor
+	NPC_SAN_TOJALON                  = 663 // San Tojalon
+	NPC_GHOST_664                    = 664 // Ghost
+	NPC_SPIRIT_OF_SCORPIUS           = 665 // Spirit of Scorpius
+	NPC_SCORPION_666                 = 666 // Scorpion
+	NPC_DARK_MAGE                    = 667 // Dark Mage
+	NPC_MERCENARY                    = 668 // Mercenary
+	NPC_MERCENARY_CAPTAIN            = 669 // Mercenary Captain
+	NPC_MERCENARY_670                = 670 // Mercenary
+	NPC_MINING_SLAVE                 = 671 // Mining Slave
+	NPC_WATCHTOWER_WIZARD            = 672 // Watchtower wizard
+	NPC_OGRE_SHAMAN                  = 673 // Ogre Shaman
+	NPC_SKAVID                       = 674 // Skavid
+	NPC_OGRE_GUARD                   = 675 // Ogre guard
+	NPC_OGRE_GUARD_676               = 676 // Ogre guard
+	NPC_OGRE_GUARD_677               = 677 // Ogre guard
+	NPC_SKAVID_678                   = 678 // Skavid
+	NPC_SKAVID_679                   = 679 // Skavid
+	NPC_OG                           = 680 // Og
+	NPC_GREW                         = 681 // Grew
+	NPC_TOBAN                        = 682 // Toban
+	NPC_GORAD                        = 683 // Gorad
+	NPC_OGRE_GUARD_684               = 684 // Ogre guard
+	NPC_YANILLE_WATCHMAN_685         = 685 // Yanille Watchman
+	NPC_OGRE_MERCHANT                = 686 // Ogre merchant
+	NPC_OGRE_TRADER                  = 687 // Ogre trader
+	NPC_OGRE_TRADER_688              = 688 // Ogre trader
+	NPC_OGRE_TRADER_689              = 689 // Ogre trader
+	NPC_MERCENARY_690                = 690 // Mercenary
+	NPC_CITY_GUARD                   = 691 // City Guard
+	NPC_MERCENARY_692                = 692 // Mercenary
+	NPC_LAWGOF                       = 693 // Lawgof
+	NPC_DWARF_694                    = 694 // Dwarf
+	NPC_LOLLK                        = 695 // lollk
+	NPC_SKAVID_696                   = 696 // Skavid
+	NPC_OGRE_GUARD_697               = 697 // Ogre guard
+	NPC_NULODION                     = 698 // Nulodion
+	NPC_DWARF_699                    = 699 // Dwarf
+	NPC_AL_SHABIM                    = 700 // Al Shabim
+	NPC_BEDABIN_NOMAD                = 701 // Bedabin Nomad
+	NPC_CAPTAIN_SIAD                 = 702 // Captain Siad
+	NPC_BEDABIN_NOMAD_GUARD          = 703 // Bedabin Nomad Guard
+	NPC_OGRE_CITIZEN                 = 704 // Ogre citizen
+	NPC_ROCK_OF_AGES                 = 705 // Rock of ages
+	NPC_OGRE_706                     = 706 // Ogre
+	NPC_SKAVID_707                   = 707 // Skavid
+	NPC_SKAVID_708                   = 708 // Skavid
+	NPC_SKAVID_709                   = 709 // Skavid
+	NPC_DRAFT_MERCENARY_GUARD        = 710 // Draft Mercenary Guard
+	NPC_MINING_CART_DRIVER           = 711 // Mining Cart Driver
+	NPC_KOLODION                     = 712 // kolodion
+	NPC_KOLODION_713                 = 713 // kolodion
+	NPC_GERTRUDE                     = 714 // Gertrude
+	NPC_SHILOP                       = 715 // Shilop
+	NPC_ROWDY_GUARD                  = 716 // Rowdy Guard
+	NPC_SHANTAY_PASS_GUARD           = 717 // Shantay Pass Guard
+	NPC_ROWDY_SLAVE                  = 718 // Rowdy Slave
+	NPC_SHANTAY_PASS_GUARD_719       = 719 // Shantay Pass Guard
+	NPC_ASSISTANT                    = 720 // Assistant
+	NPC_DESERT_WOLF                  = 721 // Desert Wolf
+	NPC_WORKMAN                      = 722 // Workman
+	NPC_EXAMINER                     = 723 // Examiner
+	NPC_STUDENT                      = 724 // Student
+	NPC_STUDENT_725                  = 725 // Student
+	NPC_GUIDE_726                    = 726 // Guide
+	NPC_STUDENT_727                  = 727 // Student
+	NPC_ARCHAEOLOGICAL_EXPERT        = 728 // Archaeological expert
+	NPC_CIVILLIAN                    = 729 // civillian
+	NPC_CIVILLIAN_730                = 730 // civillian
+	NPC_CIVILLIAN_731                = 731 // civillian
+	NPC_CIVILLIAN_732                = 732 // civillian
+	NPC_MURPHY                       = 733 // Murphy
+	NPC_MURPHY_734                   = 734 // Murphy
+	NPC_SIR_RADIMUS_ERKLE            = 735 // Sir Radimus Erkle
+	NPC_LEGENDS_GUILD_GUARD          = 736 // Legends Guild Guard
+	NPC_ESCAPING_MINING_SLAVE        = 737 // Escaping Mining Slave
+	NPC_WORKMAN_738                  = 738 // Workman
+	NPC_MURPHY_739                   = 739 // Murphy
+	NPC_ECHNED_ZEKIN                 = 740 // Echned Zekin
+	NPC_DONOVAN_THE_HANDYMAN         = 741 // Donovan the Handyman
+	NPC_PIERRE_THE_DOG_HANDLER       = 742 // Pierre the Dog Handler
+	NPC_HOBBES_THE_BUTLER            = 743 // Hobbes the Butler
+	NPC_LOUISA_THE_COOK              = 744 // Louisa The Cook
+	NPC_MARY_THE_MAID                = 745 // Mary The Maid
+	NPC_STANFORD_THE_GARDENER        = 746 // Stanford The Gardener
+	NPC_GUARD_747                    = 747 // Guard
+	NPC_GUARD_DOG_748                = 748 // Guard Dog
+	NPC_GUARD_749                    = 749 // Guard
+	NPC_MAN_750                      = 750 // Man
+	NPC_ANNA_SINCLAIR                = 751 // Anna Sinclair
+	NPC_BOB_SINCLAIR                 = 752 // Bob Sinclair
+	NPC_CAROL_SINCLAIR               = 753 // Carol Sinclair
+	NPC_DAVID_SINCLAIR               = 754 // David Sinclair
+	NPC_ELIZABETH_SINCLAIR           = 755 // Elizabeth Sinclair
+	NPC_FRANK_SINCLAIR               = 756 // Frank Sinclair
+	NPC_KOLODION_757                 = 757 // kolodion
+	NPC_KOLODION_758                 = 758 // kolodion
+	NPC_KOLODION_759                 = 759 // kolodion
+	NPC_KOLODION_760                 = 760 // kolodion
+	NPC_IRVIG_SENAY                  = 761 // Irvig Senay
+	NPC_RANALPH_DEVERE               = 762 // Ranalph Devere
+	NPC_POISON_SALESMAN              = 763 // Poison Salesman
+	NPC_GUJUO                        = 764 // Gujuo
+	NPC_JUNGLE_FORESTER              = 765 // Jungle Forester
+	NPC_UNGADULU                     = 766 // Ungadulu
+	NPC_UNGADULU_767                 = 767 // Ungadulu
+	NPC_DEATH_WING                   = 768 // Death Wing
+	NPC_NEZIKCHENED                  = 769 // Nezikchened
+	NPC_DWARF_CANNON_ENGINEER        = 770 // Dwarf Cannon engineer
+	NPC_DWARF_COMMANDER              = 771 // Dwarf commander
+	NPC_VIYELDI                      = 772 // Viyeldi
+	NPC_NURMOF                       = 773 // Nurmof
+	NPC_FATIGUE_EXPERT               = 774 // Fatigue expert
+	NPC_KARAMJA_WOLF                 = 775 // Karamja Wolf
+	NPC_JUNGLE_SAVAGE                = 776 // Jungle Savage
+	NPC_OOMLIE_BIRD                  = 777 // Oomlie Bird
+	NPC_SIDNEY_SMITH                 = 778 // Sidney Smith
+	NPC_SIEGFRIED_ERKLE              = 779 // Siegfried Erkle
+	NPC_TEA_SELLER                   = 780 // Tea seller
+	NPC_WILOUGH                      = 781 // Wilough
+	NPC_PHILOP                       = 782 // Philop
+	NPC_KANEL                        = 783 // Kanel
+	NPC_CHAMBER_GUARDIAN             = 784 // chamber guardian
+	NPC_SIR_RADIMUS_ERKLE_785        = 785 // Sir Radimus Erkle
+	NPC_PIT_SCORPION                 = 786 // Pit Scorpion
+	NPC_SHADOW_WARRIOR               = 787 // Shadow Warrior
+	NPC_FIONELLA                     = 788 // Fionella
+	NPC_BATTLE_MAGE                  = 789 // Battle mage
+	NPC_BATTLE_MAGE_790              = 790 // Battle mage
+	NPC_BATTLE_MAGE_791              = 791 // Battle mage
+	NPC_GUNDAI                       = 792 // Gundai
+	NPC_LUNDAIL                      = 793 // Lundail
+)
+
+// Npcs Maps the name of every NPC constant, without any prefix, to its id.
+var Npcs = map[string]int{
+	"UNICORN":                      NPC_UNICORN,
+	"BOB":                          NPC_BOB,
+	"SHEEP":                        NPC_SHEEP,
+	"CHICKEN":                      NPC_CHICKEN,
+	"GOBLIN":                       NPC_GOBLIN,
+	"HANS":                         NPC_HANS,
+	"COW":                          NPC_COW,
+	"COOK":                         NPC_COOK,
+	"BEAR":                         NPC_BEAR,
+	"PRIEST":                       NPC_PRIEST,
+	"URHNEY":                       NPC_URHNEY,
+	"MAN":                          NPC_MAN,
+	"BARTENDER":                    NPC_BARTENDER,
+	"CAMEL":                        NPC_CAMEL,
+	"GYPSY":                        NPC_GYPSY,
+	"GHOST":                        NPC_GHOST,
+	"SIR_PRYSIN":                   NPC_SIR_PRYSIN,
+	"TRAIBORN_THE_WIZARD":          NPC_TRAIBORN_THE_WIZARD,
+	"CAPTAIN_ROVIN":                NPC_CAPTAIN_ROVIN,
+	"RAT":                          NPC_RAT,
+	"RELDO":                        NPC_RELDO,
+	"MUGGER":                       NPC_MUGGER,
+	"LESSER_DEMON":                 NPC_LESSER_DEMON,
+	"GIANT_SPIDER":                 NPC_GIANT_SPIDER,
+	"MAN_24":                       NPC_MAN_24,
+	"JONNY_THE_BEARD":              NPC_JONNY_THE_BEARD,
+	"BARAEK":                       NPC_BARAEK,
+	"KATRINE":                      NPC_KATRINE,
+	"TRAMP":                        NPC_TRAMP,
+	"RAT_29":                       NPC_RAT_29,
+	"ROMEO":                        NPC_ROMEO,
+	"JULIET":                       NPC_JULIET,
+	"FATHER_LAWRENCE":              NPC_FATHER_LAWRENCE,
+	"APOTHECARY":                   NPC_APOTHECARY,
+	"SPIDER":                       NPC_SPIDER,
+	"DELRITH":                      NPC_DELRITH,
+	"VERONICA":                     NPC_VERONICA,
+	"WEAPONSMASTER":                NPC_WEAPONSMASTER,
+	"PROFESSOR_ODDENSTEIN":         NPC_PROFESSOR_ODDENSTEIN,
+	"CURATOR":                      NPC_CURATOR,
+	"SKELETON":                     NPC_SKELETON,
+	"ZOMBIE":                       NPC_ZOMBIE,
+	"KING":                         NPC_KING,
+	"GIANT_BAT":                    NPC_GIANT_BAT,
+	"BARTENDER_44":                 NPC_BARTENDER_44,
+	"SKELETON_45":                  NPC_SKELETON_45,
+	"SKELETON_46":                  NPC_SKELETON_46,
+	"RAT_47":                       NPC_RAT_47,
+	"HORVIK_THE_ARMOURER":          NPC_HORVIK_THE_ARMOURER,
+	"BEAR_49":                      NPC_BEAR_49,
+	"SKELETON_50":                  NPC_SKELETON_50,
+	"SHOPKEEPER":                   NPC_SHOPKEEPER,
+	"ZOMBIE_52":                    NPC_ZOMBIE_52,
+	"GHOST_53":                     NPC_GHOST_53,
+	"AUBURY":                       NPC_AUBURY,
+	"SHOPKEEPER_55":                NPC_SHOPKEEPER_55,
+	"SHOPKEEPER_56":                NPC_SHOPKEEPER_56,
+	"DARKWIZARD":                   NPC_DARKWIZARD,
+	"LOWE":                         NPC_LOWE,
+	"THESSALIA":                    NPC_THESSALIA,
+	"DARKWIZARD_60":                NPC_DARKWIZARD_60,
+	"GIANT":                        NPC_GIANT,
+	"GOBLIN_62":                    NPC_GOBLIN_62,
+	"FARMER":                       NPC_FARMER,
+	"THIEF":                        NPC_THIEF,
+	"GUARD":                        NPC_GUARD,
+	"BLACK_KNIGHT":                 NPC_BLACK_KNIGHT,
+	"HOBGOBLIN":                    NPC_HOBGOBLIN,
+	"ZOMBIE_68":                    NPC_ZOMBIE_68,
+	"ZAFF":                         NPC_ZAFF,
+	"SCORPION":                     NPC_SCORPION,
+	"SILK_TRADER":                  NPC_SILK_TRADER,
+	"MAN_72":                       NPC_MAN_72,
+	"GUIDE":                        NPC_GUIDE,
+	"GIANT_SPIDER_74":              NPC_GIANT_SPIDER_74,
+	"PEKSA":                        NPC_PEKSA,
+	"BARBARIAN":                    NPC_BARBARIAN,
+	"FRED_THE_FARMER":              NPC_FRED_THE_FARMER,
+	"GUNTHOR_THE_BRAVE":            NPC_GUNTHOR_THE_BRAVE,
+	"WITCH":                        NPC_WITCH,
+	"GHOST_80":                     NPC_GHOST_80,
+	"WIZARD":                       NPC_WIZARD,
+	"SHOP_ASSISTANT":               NPC_SHOP_ASSISTANT,
+	"SHOP_ASSISTANT_83":            NPC_SHOP_ASSISTANT_83,
+	"ZEKE":                         NPC_ZEKE,
+	"LOUIE_LEGS":                   NPC_LOUIE_LEGS,
+	"WARRIOR":                      NPC_WARRIOR,
+	"SHOPKEEPER_87":                NPC_SHOPKEEPER_87,
+	"SHOP_ASSISTANT_88":            NPC_SHOP_ASSISTANT_88,
+	"HIGHWAYMAN":                   NPC_HIGHWAYMAN,
+	"KEBAB_SELLER":                 NPC_KEBAB_SELLER,
+	"CHICKEN_91":                   NPC_CHICKEN_91,
+	"ERNEST":                       NPC_ERNEST,
+	"MONK":                         NPC_MONK,
+	"DWARF":                        NPC_DWARF,
+	"BANKER":                       NPC_BANKER,
+	"COUNT_DRAYNOR":                NPC_COUNT_DRAYNOR,
+	"MORGAN":                       NPC_MORGAN,
+	"DR_HARLOW":                    NPC_DR_HARLOW,
+	"DEADLY_RED_SPIDER":            NPC_DEADLY_RED_SPIDER,
+	"GUARD_100":                    NPC_GUARD_100,
+	"CASSIE":                       NPC_CASSIE,
+	"WHITE_KNIGHT":                 NPC_WHITE_KNIGHT,
+	"RANAEL":                       NPC_RANAEL,
+	"MOSS_GIANT":                   NPC_MOSS_GIANT,
+	"SHOPKEEPER_105":               NPC_SHOPKEEPER_105,
+	"SHOP_ASSISTANT_106":           NPC_SHOP_ASSISTANT_106,
+	"WITCH_107":                    NPC_WITCH_107,
+	"BLACK_KNIGHT_108":             NPC_BLACK_KNIGHT_108,
+	"GRELDO":                       NPC_GRELDO,
+	"SIR_AMIK_VARZE":               NPC_SIR_AMIK_VARZE,
+	"GUILDMASTER":                  NPC_GUILDMASTER,
+	"VALAINE":                      NPC_VALAINE,
+	"DROGO":                        NPC_DROGO,
+	"IMP":                          NPC_IMP,
+	"FLYNN":                        NPC_FLYNN,
+	"WYSON_THE_GARDENER":           NPC_WYSON_THE_GARDENER,
+	"WIZARD_MIZGOG":                NPC_WIZARD_MIZGOG,
+	"PRINCE_ALI":                   NPC_PRINCE_ALI,
+	"HASSAN":                       NPC_HASSAN,
+	"OSMAN":                        NPC_OSMAN,
+	"JOE":                          NPC_JOE,
+	"LEELA":                        NPC_LEELA,
+	"LADY_KELI":                    NPC_LADY_KELI,
+	"NED":                          NPC_NED,
+	"AGGIE":                        NPC_AGGIE,
+	"PRINCE_ALI_126":               NPC_PRINCE_ALI_126,
+	"JAILGUARD":                    NPC_JAILGUARD,
+	"REDBEARD_FRANK":               NPC_REDBEARD_FRANK,
+	"WYDIN":                        NPC_WYDIN,
+	"SHOP_ASSISTANT_130":           NPC_SHOP_ASSISTANT_130,
+	"BRIAN":                        NPC_BRIAN,
+	"SQUIRE":                       NPC_SQUIRE,
+	"HEAD_CHEF":                    NPC_HEAD_CHEF,
+	"THURGO":                       NPC_THURGO,
+	"ICE_GIANT":                    NPC_ICE_GIANT,
+	"KING_SCORPION":                NPC_KING_SCORPION,
+	"PIRATE":                       NPC_PIRATE,
+	"SIR_VYVIN":                    NPC_SIR_VYVIN,
+	"MONK_OF_ZAMORAK":              NPC_MONK_OF_ZAMORAK,
+	"MONK_OF_ZAMORAK_140":          NPC_MONK_OF_ZAMORAK_140,
+	"WAYNE":                        NPC_WAYNE,
+	"BARMAID":                      NPC_BARMAID,
+	"DWARVEN_SHOPKEEPER":           NPC_DWARVEN_SHOPKEEPER,
+	"DORIC":                        NPC_DORIC,
+	"SHOPKEEPER_145":               NPC_SHOPKEEPER_145,
+	"SHOP_ASSISTANT_146":           NPC_SHOP_ASSISTANT_146,
+	"GUIDE_147":                    NPC_GUIDE_147,
+	"HETTY":                        NPC_HETTY,
+	"BETTY":                        NPC_BETTY,
+	"BARTENDER_150":                NPC_BARTENDER_150,
+	"GENERAL_WARTFACE":             NPC_GENERAL_WARTFACE,
+	"GENERAL_BENTNOZE":             NPC_GENERAL_BENTNOZE,
+	"GOBLIN_153":                   NPC_GOBLIN_153,
+	"GOBLIN_154":                   NPC_GOBLIN_154,
+	"HERQUIN":                      NPC_HERQUIN,
+	"ROMMIK":                       NPC_ROMMIK,
+	"GRUM":                         NPC_GRUM,
+	"ICE_WARRIOR":                  NPC_ICE_WARRIOR,
+	"WARRIOR_159":                  NPC_WARRIOR_159,
+	"THRANDER":                     NPC_THRANDER,
+	"BORDER_GUARD":                 NPC_BORDER_GUARD,
+	"BORDER_GUARD_162":             NPC_BORDER_GUARD_162,
+	"CUSTOMS_OFFICER":              NPC_CUSTOMS_OFFICER,
+	"LUTHAS":                       NPC_LUTHAS,
+	"ZAMBO":                        NPC_ZAMBO,
+	"CAPTAIN_TOBIAS":               NPC_CAPTAIN_TOBIAS,
+	"GERRANT":                      NPC_GERRANT,
+	"SHOPKEEPER_168":               NPC_SHOPKEEPER_168,
+	"SHOP_ASSISTANT_169":           NPC_SHOP_ASSISTANT_169,
+	"SEAMAN_LORRIS":                NPC_SEAMAN_LORRIS,
+	"SEAMAN_THRESNOR":              NPC_SEAMAN_THRESNOR,
+	"TANNER":                       NPC_TANNER,
+	"DOMMIK":                       NPC_DOMMIK,
+	"ABBOT_LANGLEY":                NPC_ABBOT_LANGLEY,
+	"THORDUR":                      NPC_THORDUR,
+	"BROTHER_JERED":                NPC_BROTHER_JERED,
+	"RAT_177":                      NPC_RAT_177,
+	"GHOST_178":                    NPC_GHOST_178,
+	"SKELETON_179":                 NPC_SKELETON_179,
+	"ZOMBIE_180":                   NPC_ZOMBIE_180,
+	"LESSER_DEMON_181":             NPC_LESSER_DEMON_181,
+	"MELZAR_THE_MAD":               NPC_MELZAR_THE_MAD,
+	"SCAVVO":                       NPC_SCAVVO,
+	"GREATER_DEMON":                NPC_GREATER_DEMON,
+	"SHOPKEEPER_185":               NPC_SHOPKEEPER_185,
+	"SHOP_ASSISTANT_186":           NPC_SHOP_ASSISTANT_186,
+	"OZIACH":                       NPC_OZIACH,
+	"BEAR_188":                     NPC_BEAR_188,
+	"BLACK_KNIGHT_189":             NPC_BLACK_KNIGHT_189,
+	"CHAOS_DWARF":                  NPC_CHAOS_DWARF,
+	"DWARF_191":                    NPC_DWARF_191,
+	"WORMBRAIN":                    NPC_WORMBRAIN,
+	"KLARENSE":                     NPC_KLARENSE,
+	"NED_194":                      NPC_NED_194,
+	"SKELETON_195":                 NPC_SKELETON_195,
+	"DRAGON":                       NPC_DRAGON,
+	"ORACLE":                       NPC_ORACLE,
+	"DUKE_OF_LUMBRIDGE":            NPC_DUKE_OF_LUMBRIDGE,
+	"DARK_WARRIOR":                 NPC_DARK_WARRIOR,
+	"DRUID":                        NPC_DRUID,
+	"RED_DRAGON":                   NPC_RED_DRAGON,
+	"BLUE_DRAGON":                  NPC_BLUE_DRAGON,
+	"BABY_BLUE_DRAGON":             NPC_BABY_BLUE_DRAGON,
+	"KAQEMEEX":                     NPC_KAQEMEEX,
+	"SANFEW":                       NPC_SANFEW,
+	"SUIT_OF_ARMOUR":               NPC_SUIT_OF_ARMOUR,
+	"ADVENTURER":                   NPC_ADVENTURER,
+	"ADVENTURER_208":               NPC_ADVENTURER_208,
+	"ADVENTURER_209":               NPC_ADVENTURER_209,
+	"ADVENTURER_210":               NPC_ADVENTURER_210,
+	"LEPRECHAUN":                   NPC_LEPRECHAUN,
+	"MONK_OF_ENTRANA":              NPC_MONK_OF_ENTRANA,
+	"MONK_OF_ENTRANA_213":          NPC_MONK_OF_ENTRANA_213,
+	"ZOMBIE_214":                   NPC_ZOMBIE_214,
+	"MONK_OF_ENTRANA_215":          NPC_MONK_OF_ENTRANA_215,
+	"TREE_SPIRIT":                  NPC_TREE_SPIRIT,
+	"COW_217":                      NPC_COW_217,
+	"IRKSOL":                       NPC_IRKSOL,
+	"FAIRY_LUNDERWIN":              NPC_FAIRY_LUNDERWIN,
+	"JAKUT":                        NPC_JAKUT,
+	"DOORMAN":                      NPC_DOORMAN,
+	"FAIRY_SHOPKEEPER":             NPC_FAIRY_SHOPKEEPER,
+	"FAIRY_SHOP_ASSISTANT":         NPC_FAIRY_SHOP_ASSISTANT,
+	"FAIRY_BANKER":                 NPC_FAIRY_BANKER,
+	"GILES":                        NPC_GILES,
+	"MILES":                        NPC_MILES,
+	"NILES":                        NPC_NILES,
+	"GAIUS":                        NPC_GAIUS,
+	"FAIRY_LADDER_ATTENDANT":       NPC_FAIRY_LADDER_ATTENDANT,
+	"JATIX":                        NPC_JATIX,
+	"MASTER_CRAFTER":               NPC_MASTER_CRAFTER,
+	"BANDIT":                       NPC_BANDIT,
+	"NOTERAZZO":                    NPC_NOTERAZZO,
+	"BANDIT_234":                   NPC_BANDIT_234,
+	"FAT_TONY":                     NPC_FAT_TONY,
+	"DONNY_THE_LAD":                NPC_DONNY_THE_LAD,
+	"BLACK_HEATHER":                NPC_BLACK_HEATHER,
+	"SPEEDY_KEITH":                 NPC_SPEEDY_KEITH,
+	"WHITE_WOLF_SENTRY":            NPC_WHITE_WOLF_SENTRY,
+	"BOY":                          NPC_BOY,
+	"RAT_241":                      NPC_RAT_241,
+	"NORA_T_HAG":                   NPC_NORA_T_HAG,
+	"GREY_WOLF":                    NPC_GREY_WOLF,
+	"SHAPESHIFTER":                 NPC_SHAPESHIFTER,
+	"SHAPESHIFTER_245":             NPC_SHAPESHIFTER_245,
+	"SHAPESHIFTER_246":             NPC_SHAPESHIFTER_246,
+	"SHAPESHIFTER_247":             NPC_SHAPESHIFTER_247,
+	"WHITE_WOLF":                   NPC_WHITE_WOLF,
+	"PACK_LEADER":                  NPC_PACK_LEADER,
+	"HARRY":                        NPC_HARRY,
+	"THUG":                         NPC_THUG,
+	"FIREBIRD":                     NPC_FIREBIRD,
+	"ACHETTIES":                    NPC_ACHETTIES,
+	"ICE_QUEEN":                    NPC_ICE_QUEEN,
+	"GRUBOR":                       NPC_GRUBOR,
+	"TROBERT":                      NPC_TROBERT,
+	"GARV":                         NPC_GARV,
+	"GUARD_258":                    NPC_GUARD_258,
+	"GRIP":                         NPC_GRIP,
+	"ALFONSE_THE_WAITER":           NPC_ALFONSE_THE_WAITER,
+	"CHARLIE_THE_COOK":             NPC_CHARLIE_THE_COOK,
+	"GUARD_DOG":                    NPC_GUARD_DOG,
+	"ICE_SPIDER":                   NPC_ICE_SPIDER,
+	"PIRATE_264":                   NPC_PIRATE_264,
+	"JAILER":                       NPC_JAILER,
+	"LORD_DARQUARIUS":              NPC_LORD_DARQUARIUS,
+	"SETH":                         NPC_SETH,
+	"BANKER_268":                   NPC_BANKER_268,
+	"HELEMOS":                      NPC_HELEMOS,
+	"CHAOS_DRUID":                  NPC_CHAOS_DRUID,
+	"POISON_SCORPION":              NPC_POISON_SCORPION,
+	"VELRAK_THE_EXPLORER":          NPC_VELRAK_THE_EXPLORER,
+	"SIR_LANCELOT":                 NPC_SIR_LANCELOT,
+	"SIR_GAWAIN":                   NPC_SIR_GAWAIN,
+	"KING_ARTHUR":                  NPC_KING_ARTHUR,
+	"SIR_MORDRED":                  NPC_SIR_MORDRED,
+	"RENEGADE_KNIGHT":              NPC_RENEGADE_KNIGHT,
+	"DAVON":                        NPC_DAVON,
+	"BARTENDER_279":                NPC_BARTENDER_279,
+	"ARHEIN":                       NPC_ARHEIN,
+	"MORGAN_LE_FAYE":               NPC_MORGAN_LE_FAYE,
+	"CANDLEMAKER":                  NPC_CANDLEMAKER,
+	"LADY":                         NPC_LADY,
+	"LADY_284":                     NPC_LADY_284,
+	"LADY_285":                     NPC_LADY_285,
+	"BEGGAR":                       NPC_BEGGAR,
+	"MERLIN":                       NPC_MERLIN,
+	"THRANTAX":                     NPC_THRANTAX,
+	"HICKTON":                      NPC_HICKTON,
+	"BLACK_DEMON":                  NPC_BLACK_DEMON,
+	"BLACK_DRAGON":                 NPC_BLACK_DRAGON,
+	"POISON_SPIDER":                NPC_POISON_SPIDER,
+	"MONK_OF_ZAMORAK_293":          NPC_MONK_OF_ZAMORAK_293,
+	"HELLHOUND":                    NPC_HELLHOUND,
+	"ANIMATED_AXE":                 NPC_ANIMATED_AXE,
+	"BLACK_UNICORN":                NPC_BLACK_UNICORN,
+	"FRINCOS":                      NPC_FRINCOS,
+	"OTHERWORLDLY_BEING":           NPC_OTHERWORLDLY_BEING,
+	"OWEN":                         NPC_OWEN,
+	"THORMAC_THE_SORCEROR":         NPC_THORMAC_THE_SORCEROR,
+	"SEER":                         NPC_SEER,
+	"KHARID_SCORPION":              NPC_KHARID_SCORPION,
+	"KHARID_SCORPION_303":          NPC_KHARID_SCORPION_303,
+	"KHARID_SCORPION_304":          NPC_KHARID_SCORPION_304,
+	"BARBARIAN_GUARD":              NPC_BARBARIAN_GUARD,
+	"BARTENDER_306":                NPC_BARTENDER_306,
+	"MAN_307":                      NPC_MAN_307,
+	"GEM_TRADER":                   NPC_GEM_TRADER,
+	"DIMINTHEIS":                   NPC_DIMINTHEIS,
+	"CHEF":                         NPC_CHEF,
+	"HOBGOBLIN_311":                NPC_HOBGOBLIN_311,
+	"OGRE":                         NPC_OGRE,
+	"BOOT_THE_DWARF":               NPC_BOOT_THE_DWARF,
+	"WIZARD_314":                   NPC_WIZARD_314,
+	"CHRONOZON":                    NPC_CHRONOZON,
+	"CAPTAIN_BARNABY":              NPC_CAPTAIN_BARNABY,
+	"CUSTOMS_OFFICIAL":             NPC_CUSTOMS_OFFICIAL,
+	"MAN_318":                      NPC_MAN_318,
+	"FARMER_319":                   NPC_FARMER_319,
+	"WARRIOR_320":                  NPC_WARRIOR_320,
+	"GUARD_321":                    NPC_GUARD_321,
+	"KNIGHT":                       NPC_KNIGHT,
+	"PALADIN":                      NPC_PALADIN,
+	"HERO":                         NPC_HERO,
+	"BAKER":                        NPC_BAKER,
+	"SILK_MERCHANT":                NPC_SILK_MERCHANT,
+	"FUR_TRADER":                   NPC_FUR_TRADER,
+	"SILVER_MERCHANT":              NPC_SILVER_MERCHANT,
+	"SPICE_MERCHANT":               NPC_SPICE_MERCHANT,
+	"GEM_MERCHANT":                 NPC_GEM_MERCHANT,
+	"ZENESHA":                      NPC_ZENESHA,
+	"KANGAI_MAU":                   NPC_KANGAI_MAU,
+	"WIZARD_CROMPERTY":             NPC_WIZARD_CROMPERTY,
+	"RPDT_EMPLOYEE":                NPC_RPDT_EMPLOYEE,
+	"HORACIO":                      NPC_HORACIO,
+	"AEMAD":                        NPC_AEMAD,
+	"KORTAN":                       NPC_KORTAN,
+	"ZOO_KEEPER":                   NPC_ZOO_KEEPER,
+	"MAKE_OVER_MAGE":               NPC_MAKE_OVER_MAGE,
+	"BARTENDER_340":                NPC_BARTENDER_340,
+	"CHUCK":                        NPC_CHUCK,
+	"ROGUE":                        NPC_ROGUE,
+	"SHADOW_SPIDER":                NPC_SHADOW_SPIDER,
+	"FIRE_GIANT":                   NPC_FIRE_GIANT,
+	"GRANDPA_JACK":                 NPC_GRANDPA_JACK,
+	"SINISTER_STRANGER":            NPC_SINISTER_STRANGER,
+	"BONZO":                        NPC_BONZO,
+	"FORESTER":                     NPC_FORESTER,
+	"MORRIS":                       NPC_MORRIS,
+	"BROTHER_OMAD":                 NPC_BROTHER_OMAD,
+	"THIEF_351":                    NPC_THIEF_351,
+	"HEAD_THIEF":                   NPC_HEAD_THIEF,
+	"BIG_DAVE":                     NPC_BIG_DAVE,
+	"JOSHUA":                       NPC_JOSHUA,
+	"MOUNTAIN_DWARF":               NPC_MOUNTAIN_DWARF,
+	"MOUNTAIN_DWARF_356":           NPC_MOUNTAIN_DWARF_356,
+	"BROTHER_CEDRIC":               NPC_BROTHER_CEDRIC,
+	"NECROMANCER":                  NPC_NECROMANCER,
+	"ZOMBIE_359":                   NPC_ZOMBIE_359,
+	"LUCIEN":                       NPC_LUCIEN,
+	"THE_FIRE_WARRIOR_OF_LESARKUS": NPC_THE_FIRE_WARRIOR_OF_LESARKUS,
+	"GUARDIAN_OF_ARMADYL":          NPC_GUARDIAN_OF_ARMADYL,
+	"GUARDIAN_OF_ARMADYL_363":      NPC_GUARDIAN_OF_ARMADYL_363,
+	"LUCIEN_364":                   NPC_LUCIEN_364,
+	"WINELDA":                      NPC_WINELDA,
+	"BROTHER_KOJO":                 NPC_BROTHER_KOJO,
+	"DUNGEON_RAT":                  NPC_DUNGEON_RAT,
+	"MASTER_FISHER":                NPC_MASTER_FISHER,
+	"ORVEN":                        NPC_ORVEN,
+	"PADIK":                        NPC_PADIK,
+	"SHOPKEEPER_371":               NPC_SHOPKEEPER_371,
+	"LADY_SERVIL":                  NPC_LADY_SERVIL,
+	"GUARD_373":                    NPC_GUARD_373,
+	"GUARD_374":                    NPC_GUARD_374,
+	"GUARD_375":                    NPC_GUARD_375,
+	"GUARD_376":                    NPC_GUARD_376,
+	"JEREMY_SERVIL":                NPC_JEREMY_SERVIL,
+	"JUSTIN_SERVIL":                NPC_JUSTIN_SERVIL,
+	"FIGHTSLAVE_JOE":               NPC_FIGHTSLAVE_JOE,
+	"FIGHTSLAVE_KELVIN":            NPC_FIGHTSLAVE_KELVIN,
+	"LOCAL":                        NPC_LOCAL,
+	"KHAZARD_BARTENDER":            NPC_KHAZARD_BARTENDER,
+	"GENERAL_KHAZARD":              NPC_GENERAL_KHAZARD,
+	"KHAZARD_OGRE":                 NPC_KHAZARD_OGRE,
+	"GUARD_385":                    NPC_GUARD_385,
+	"KHAZARD_SCORPION":             NPC_KHAZARD_SCORPION,
+	"HENGRAD":                      NPC_HENGRAD,
+	"BOUNCER":                      NPC_BOUNCER,
+	"STANKERS":                     NPC_STANKERS,
+	"DOCKY":                        NPC_DOCKY,
+	"SHOPKEEPER_391":               NPC_SHOPKEEPER_391,
+	"FAIRY_QUEEN":                  NPC_FAIRY_QUEEN,
+	"MERLIN_393":                   NPC_MERLIN_393,
+	"CRONE":                        NPC_CRONE,
+	"HIGH_PRIEST_OF_ENTRANA":       NPC_HIGH_PRIEST_OF_ENTRANA,
+	"ELKOY":                        NPC_ELKOY,
+	"REMSAI":                       NPC_REMSAI,
+	"BOLKOY":                       NPC_BOLKOY,
+	"LOCAL_GNOME":                  NPC_LOCAL_GNOME,
+	"BOLREN":                       NPC_BOLREN,
+	"BLACK_KNIGHT_TITAN":           NPC_BLACK_KNIGHT_TITAN,
+	"KALRON":                       NPC_KALRON,
+	"BROTHER_GALAHAD":              NPC_BROTHER_GALAHAD,
+	"TRACKER_1":                    NPC_TRACKER_1,
+	"TRACKER_2":                    NPC_TRACKER_2,
+	"TRACKER_3":                    NPC_TRACKER_3,
+	"KHAZARD_TROOP":                NPC_KHAZARD_TROOP,
+	"COMMANDER_MONTAI":             NPC_COMMANDER_MONTAI,
+	"GNOME_TROOP":                  NPC_GNOME_TROOP,
+	"KHAZARD_WARLORD":              NPC_KHAZARD_WARLORD,
+	"SIR_PERCIVAL":                 NPC_SIR_PERCIVAL,
+	"FISHER_KING":                  NPC_FISHER_KING,
+	"MAIDEN":                       NPC_MAIDEN,
+	"FISHERMAN":                    NPC_FISHERMAN,
+	"KING_PERCIVAL":                NPC_KING_PERCIVAL,
+	"UNHAPPY_PEASANT":              NPC_UNHAPPY_PEASANT,
+	"HAPPY_PEASANT":                NPC_HAPPY_PEASANT,
+	"CERIL":                        NPC_CERIL,
+	"BUTLER":                       NPC_BUTLER,
+	"CARNILLEAN_GUARD":             NPC_CARNILLEAN_GUARD,
+	"TRIBESMAN":                    NPC_TRIBESMAN,
+	"HENRYETA":                     NPC_HENRYETA,
+	"PHILIPE":                      NPC_PHILIPE,
+	"CLIVET":                       NPC_CLIVET,
+	"CULT_MEMBER":                  NPC_CULT_MEMBER,
+	"LORD_HAZEEL":                  NPC_LORD_HAZEEL,
+	"ALOMONE":                      NPC_ALOMONE,
+	"KHAZARD_COMMANDER":            NPC_KHAZARD_COMMANDER,
+	"CLAUS":                        NPC_CLAUS,
+	"N1ST_PLAGUE_SHEEP":            NPC_N1ST_PLAGUE_SHEEP,
+	"N2ND_PLAGUE_SHEEP":            NPC_N2ND_PLAGUE_SHEEP,
+	"N3RD_PLAGUE_SHEEP":            NPC_N3RD_PLAGUE_SHEEP,
+	"N4TH_PLAGUE_SHEEP":            NPC_N4TH_PLAGUE_SHEEP,
+	"FARMER_BRUMTY":                NPC_FARMER_BRUMTY,
+	"DOCTOR_ORBON":                 NPC_DOCTOR_ORBON,
+	"COUNCILLOR_HALGRIVE":          NPC_COUNCILLOR_HALGRIVE,
+	"EDMOND":                       NPC_EDMOND,
+	"CITIZEN":                      NPC_CITIZEN,
+	"CITIZEN_439":                  NPC_CITIZEN_439,
+	"CITIZEN_440":                  NPC_CITIZEN_440,
+	"CITIZEN_441":                  NPC_CITIZEN_441,
+	"CITIZEN_442":                  NPC_CITIZEN_442,
+	"JETHICK":                      NPC_JETHICK,
+	"MOURNER":                      NPC_MOURNER,
+	"MOURNER_445":                  NPC_MOURNER_445,
+	"TED_REHNISON":                 NPC_TED_REHNISON,
+	"MARTHA_REHNISON":              NPC_MARTHA_REHNISON,
+	"BILLY_REHNISON":               NPC_BILLY_REHNISON,
+	"MILLI_REHNISON":               NPC_MILLI_REHNISON,
+	"ALRENA":                       NPC_ALRENA,
+	"MOURNER_451":                  NPC_MOURNER_451,
+	"CLERK":                        NPC_CLERK,
+	"CARLA":                        NPC_CARLA,
+	"BRAVEK":                       NPC_BRAVEK,
+	"CAROLINE":                     NPC_CAROLINE,
+	"HOLGART":                      NPC_HOLGART,
+	"HOLGART_457":                  NPC_HOLGART_457,
+	"HOLGART_458":                  NPC_HOLGART_458,
+	"KENT":                         NPC_KENT,
+	"BAILEY":                       NPC_BAILEY,
+	"KENNITH":                      NPC_KENNITH,
+	"PLATFORM_FISHERMAN":           NPC_PLATFORM_FISHERMAN,
+	"PLATFORM_FISHERMAN_463":       NPC_PLATFORM_FISHERMAN_463,
+	"PLATFORM_FISHERMAN_464":       NPC_PLATFORM_FISHERMAN_464,
+	"ELENA":                        NPC_ELENA,
+	"JINNO":                        NPC_JINNO,
+	"WATTO":                        NPC_WATTO,
+	"RECRUITER":                    NPC_RECRUITER,
+	"HEAD_MOURNER":                 NPC_HEAD_MOURNER,
+	"ALMERA":                       NPC_ALMERA,
+	"HUDON":                        NPC_HUDON,
+	"HADLEY":                       NPC_HADLEY,
+	"RAT_473":                      NPC_RAT_473,
+	"COMBAT_INSTRUCTOR":            NPC_COMBAT_INSTRUCTOR,
+	"GOLRIE":                       NPC_GOLRIE,
+	"GUIDE_476":                    NPC_GUIDE_476,
+	"KING_BLACK_DRAGON":            NPC_KING_BLACK_DRAGON,
+	"COOKING_INSTRUCTOR":           NPC_COOKING_INSTRUCTOR,
+	"FISHING_INSTRUCTOR":           NPC_FISHING_INSTRUCTOR,
+	"FINANCIAL_ADVISOR":            NPC_FINANCIAL_ADVISOR,
+	"GERALD":                       NPC_GERALD,
+	"MINING_INSTRUCTOR":            NPC_MINING_INSTRUCTOR,
+	"ELENA_483":                    NPC_ELENA_483,
+	"OMART":                        NPC_OMART,
+	"BANK_ASSISTANT":               NPC_BANK_ASSISTANT,
+	"JERICO":                       NPC_JERICO,
+	"KILRON":                       NPC_KILRON,
+	"GUIDOR_S_WIFE":                NPC_GUIDOR_S_WIFE,
+	"QUEST_ADVISOR":                NPC_QUEST_ADVISOR,
+	"CHEMIST":                      NPC_CHEMIST,
+	"MOURNER_491":                  NPC_MOURNER_491,
+	"MOURNER_492":                  NPC_MOURNER_492,
+	"WILDERNESS_GUIDE":             NPC_WILDERNESS_GUIDE,
+	"MAGIC_INSTRUCTOR":             NPC_MAGIC_INSTRUCTOR,
+	"MOURNER_495":                  NPC_MOURNER_495,
+	"COMMUNITY_INSTRUCTOR":         NPC_COMMUNITY_INSTRUCTOR,
+	"BOATMAN":                      NPC_BOATMAN,
+	"SKELETON_MAGE":                NPC_SKELETON_MAGE,
+	"CONTROLS_GUIDE":               NPC_CONTROLS_GUIDE,
+	"NURSE_SARAH":                  NPC_NURSE_SARAH,
+	"TAILOR":                       NPC_TAILOR,
+	"MOURNER_502":                  NPC_MOURNER_502,
+	"GUARD_503":                    NPC_GUARD_503,
+	"CHEMIST_504":                  NPC_CHEMIST_504,
+	"CHANCY":                       NPC_CHANCY,
+	"HOPS":                         NPC_HOPS,
+	"DEVINCI":                      NPC_DEVINCI,
+	"GUIDOR":                       NPC_GUIDOR,
+	"CHANCY_509":                   NPC_CHANCY_509,
+	"HOPS_510":                     NPC_HOPS_510,
+	"DEVINCI_511":                  NPC_DEVINCI_511,
+	"KING_LATHAS":                  NPC_KING_LATHAS,
+	"HEAD_WIZARD":                  NPC_HEAD_WIZARD,
+	"MAGIC_STORE_OWNER":            NPC_MAGIC_STORE_OWNER,
+	"WIZARD_FRUMSCONE":             NPC_WIZARD_FRUMSCONE,
+	"TARGET_PRACTICE_ZOMBIE":       NPC_TARGET_PRACTICE_ZOMBIE,
+	"TRUFITUS":                     NPC_TRUFITUS,
+	"COLONEL_RADICK":               NPC_COLONEL_RADICK,
+	"SOLDIER":                      NPC_SOLDIER,
+	"BARTENDER_520":                NPC_BARTENDER_520,
+	"JUNGLE_SPIDER":                NPC_JUNGLE_SPIDER,
+	"JIMINUA":                      NPC_JIMINUA,
+	"JOGRE":                        NPC_JOGRE,
+	"GUARD_524":                    NPC_GUARD_524,
+	"OGRE_525":                     NPC_OGRE_525,
+	"GUARD_526":                    NPC_GUARD_526,
+	"GUARD_527":                    NPC_GUARD_527,
+	"SHOP_KEEPER":                  NPC_SHOP_KEEPER,
+	"BARTENDER_529":                NPC_BARTENDER_529,
+	"FRENITA":                      NPC_FRENITA,
+	"OGRE_CHIEFTAN":                NPC_OGRE_CHIEFTAN,
+	"ROMETTI":                      NPC_ROMETTI,
+	"RASHILIYIA":                   NPC_RASHILIYIA,
+	"BLURBERRY":                    NPC_BLURBERRY,
+	"HECKEL_FUNCH":                 NPC_HECKEL_FUNCH,
+	"ALUFT_GIANNE":                 NPC_ALUFT_GIANNE,
+	"HUDO_GLENFAD":                 NPC_HUDO_GLENFAD,
+	"IRENA":                        NPC_IRENA,
+	"MOSOL":                        NPC_MOSOL,
+	"GNOME_BANKER":                 NPC_GNOME_BANKER,
+	"KING_NARNODE_SHAREEN":         NPC_KING_NARNODE_SHAREEN,
+	"UNDEADONE":                    NPC_UNDEADONE,
+	"DRUCAS":                       NPC_DRUCAS,
+	"TOURIST":                      NPC_TOURIST,
+	"KING_NARNODE_SHAREEN_545":     NPC_KING_NARNODE_SHAREEN_545,
+	"HAZELMERE":                    NPC_HAZELMERE,
+	"GLOUGH":                       NPC_GLOUGH,
+	"SHAR":                         NPC_SHAR,
+	"SHANTAY":                      NPC_SHANTAY,
+	"CHARLIE":                      NPC_CHARLIE,
+	"GNOME_GUARD":                  NPC_GNOME_GUARD,
+	"GNOME_PILOT":                  NPC_GNOME_PILOT,
+	"MEHMAN":                       NPC_MEHMAN,
+	"ANA":                          NPC_ANA,
+	"CHAOS_DRUID_WARRIOR":          NPC_CHAOS_DRUID_WARRIOR,
+	"GNOME_PILOT_556":              NPC_GNOME_PILOT_556,
+	"SHIPYARD_WORKER":              NPC_SHIPYARD_WORKER,
+	"SHIPYARD_WORKER_558":          NPC_SHIPYARD_WORKER_558,
+	"SHIPYARD_WORKER_559":          NPC_SHIPYARD_WORKER_559,
+	"SHIPYARD_FOREMAN":             NPC_SHIPYARD_FOREMAN,
+	"SHIPYARD_FOREMAN_561":         NPC_SHIPYARD_FOREMAN_561,
+	"GNOME_GUARD_562":              NPC_GNOME_GUARD_562,
+	"FEMI":                         NPC_FEMI,
+	"FEMI_564":                     NPC_FEMI_564,
+	"ANITA":                        NPC_ANITA,
+	"GLOUGH_566":                   NPC_GLOUGH_566,
+	"SALARIN_THE_TWISTED":          NPC_SALARIN_THE_TWISTED,
+	"BLACK_DEMON_568":              NPC_BLACK_DEMON_568,
+	"GNOME_PILOT_569":              NPC_GNOME_PILOT_569,
+	"GNOME_PILOT_570":              NPC_GNOME_PILOT_570,
+	"GNOME_PILOT_571":              NPC_GNOME_PILOT_571,
+	"GNOME_PILOT_572":              NPC_GNOME_PILOT_572,
+	"SIGBERT_THE_ADVENTURER":       NPC_SIGBERT_THE_ADVENTURER,
+	"YANILLE_WATCHMAN":             NPC_YANILLE_WATCHMAN,
+	"TOWER_GUARD":                  NPC_TOWER_GUARD,
+	"GNOME_TRAINER":                NPC_GNOME_TRAINER,
+	"GNOME_TRAINER_577":            NPC_GNOME_TRAINER_577,
+	"GNOME_TRAINER_578":            NPC_GNOME_TRAINER_578,
+	"GNOME_TRAINER_579":            NPC_GNOME_TRAINER_579,
+	"BLURBERRY_BARMAN":             NPC_BLURBERRY_BARMAN,
+	"GNOME_WAITER":                 NPC_GNOME_WAITER,
+	"GNOME_GUARD_582":              NPC_GNOME_GUARD_582,
+	"GNOME_CHILD":                  NPC_GNOME_CHILD,
+	"EARTH_WARRIOR":                NPC_EARTH_WARRIOR,
+	"GNOME_CHILD_585":              NPC_GNOME_CHILD_585,
+	"GNOME_CHILD_586":              NPC_GNOME_CHILD_586,
+	"GULLUCK":                      NPC_GULLUCK,
+	"GUNNJORN":                     NPC_GUNNJORN,
+	"ZADIMUS":                      NPC_ZADIMUS,
+	"BRIMSTAIL":                    NPC_BRIMSTAIL,
+	"GNOME_CHILD_591":              NPC_GNOME_CHILD_591,
+	"GNOME_LOCAL":                  NPC_GNOME_LOCAL,
+	"GNOME_LOCAL_593":              NPC_GNOME_LOCAL_593,
+	"MOSS_GIANT_594":               NPC_MOSS_GIANT_594,
+	"GNOME_BALLER":                 NPC_GNOME_BALLER,
+	"GOALIE":                       NPC_GOALIE,
+	"GNOME_BALLER_597":             NPC_GNOME_BALLER_597,
+	"GNOME_BALLER_598":             NPC_GNOME_BALLER_598,
+	"GNOME_BALLER_599":             NPC_GNOME_BALLER_599,
+	"GNOME_BALLER_600":             NPC_GNOME_BALLER_600,
+	"REFEREE":                      NPC_REFEREE,
+	"GNOME_BALLER_602":             NPC_GNOME_BALLER_602,
+	"GNOME_BALLER_603":             NPC_GNOME_BALLER_603,
+	"GNOME_BALLER_604":             NPC_GNOME_BALLER_604,
+	"GNOME_BALLER_605":             NPC_GNOME_BALLER_605,
+	"GNOME_BALLER_606":             NPC_GNOME_BALLER_606,
+	"GNOME_BALLER_607":             NPC_GNOME_BALLER_607,
+	"GNOME_BALLER_608":             NPC_GNOME_BALLER_608,
+	"GNOME_BALLER_609":             NPC_GNOME_BALLER_609,
+	"GNOME_BALLER_610":             NPC_GNOME_BALLER_610,
+	"CHEERLEADER":                  NPC_CHEERLEADER,
+	"CHEERLEADER_612":              NPC_CHEERLEADER_612,
+	"NAZASTAROOL_ZOMBIE":           NPC_NAZASTAROOL_ZOMBIE,
+	"NAZASTAROOL_SKELETON":         NPC_NAZASTAROOL_SKELETON,
+	"NAZASTAROOL_GHOST":            NPC_NAZASTAROOL_GHOST,
+	"FERNAHEI":                     NPC_FERNAHEI,
+	"JUNGLE_BANKER":                NPC_JUNGLE_BANKER,
+	"CART_DRIVER":                  NPC_CART_DRIVER,
+	"CART_DRIVER_619":              NPC_CART_DRIVER_619,
+	"OBLI":                         NPC_OBLI,
+	"KALEB":                        NPC_KALEB,
+	"YOHNUS":                       NPC_YOHNUS,
+	"SEREVEL":                      NPC_SEREVEL,
+	"YANNI":                        NPC_YANNI,
+	"OFFICIAL":                     NPC_OFFICIAL,
+	"KOFTIK":                       NPC_KOFTIK,
+	"KOFTIK_627":                   NPC_KOFTIK_627,
+	"KOFTIK_628":                   NPC_KOFTIK_628,
+	"KOFTIK_629":                   NPC_KOFTIK_629,
+	"BLESSED_VERMEN":               NPC_BLESSED_VERMEN,
+	"BLESSED_SPIDER":               NPC_BLESSED_SPIDER,
+	"PALADIN_632":                  NPC_PALADIN_632,
+	"PALADIN_633":                  NPC_PALADIN_633,
+	"SLAVE":                        NPC_SLAVE,
+	"SLAVE_635":                    NPC_SLAVE_635,
+	"SLAVE_636":                    NPC_SLAVE_636,
+	"SLAVE_637":                    NPC_SLAVE_637,
+	"SLAVE_638":                    NPC_SLAVE_638,
+	"SLAVE_639":                    NPC_SLAVE_639,
+	"SLAVE_640":                    NPC_SLAVE_640,
+	"KALRAG":                       NPC_KALRAG,
+	"NILOOF":                       NPC_NILOOF,
+	"KARDIA_THE_WITCH":             NPC_KARDIA_THE_WITCH,
+	"SOULESS":                      NPC_SOULESS,
+	"OTHAINIAN":                    NPC_OTHAINIAN,
+	"DOOMION":                      NPC_DOOMION,
+	"HOLTHION":                     NPC_HOLTHION,
+	"KLANK":                        NPC_KLANK,
+	"IBAN":                         NPC_IBAN,
+	"KOFTIK_650":                   NPC_KOFTIK_650,
+	"GOBLIN_GUARD":                 NPC_GOBLIN_GUARD,
+	"OBSERVATORY_PROFESSOR":        NPC_OBSERVATORY_PROFESSOR,
+	"UGTHANKI":                     NPC_UGTHANKI,
+	"OBSERVATORY_ASSISTANT":        NPC_OBSERVATORY_ASSISTANT,
+	"SOULESS_655":                  NPC_SOULESS_655,
+	"DUNGEON_SPIDER":               NPC_DUNGEON_SPIDER,
+	"KAMEN":                        NPC_KAMEN,
+	"IBAN_DISCIPLE":                NPC_IBAN_DISCIPLE,
+	"KOFTIK_659":                   NPC_KOFTIK_659,
+	"GOBLIN_660":                   NPC_GOBLIN_660,
+	"CHADWELL":                     NPC_CHADWELL,
+	"PROFESSOR":                    NPC_PROFESSOR,
+	"SAN_TOJALON":                  NPC_SAN_TOJALON,
+	"GHOST_664":                    NPC_GHOST_664,
+	"SPIRIT_OF_SCORPIUS":           NPC_SPIRIT_OF_SCORPIUS,
+	"SCORPION_666":                 NPC_SCORPION_666,
+	"DARK_MAGE":                    NPC_DARK_MAGE,
+	"MERCENARY":                    NPC_MERCENARY,
+	"MERCENARY_CAPTAIN":            NPC_MERCENARY_CAPTAIN,
+	"MERCENARY_670":                NPC_MERCENARY_670,
+	"MINING_SLAVE":                 NPC_MINING_SLAVE,
+	"WATCHTOWER_WIZARD":            NPC_WATCHTOWER_WIZARD,
+	"OGRE_SHAMAN":                  NPC_OGRE_SHAMAN,
+	"SKAVID":                       NPC_SKAVID,
+	"OGRE_GUARD":                   NPC_OGRE_GUARD,
+	"OGRE_GUARD_676":               NPC_OGRE_GUARD_676,
+	"OGRE_GUARD_677":               NPC_OGRE_GUARD_677,
+	"SKAVID_678":                   NPC_SKAVID_678,
+	"SKAVID_679":                   NPC_SKAVID_679,
+	"OG":                           NPC_OG,
+	"GREW":                         NPC_GREW,
+	"TOBAN":                        NPC_TOBAN,
+	"GORAD":                        NPC_GORAD,
+	"OGRE_GUARD_684":               NPC_OGRE_GUARD_684,
+	"YANILLE_WATCHMAN_685":         NPC_YANILLE_WATCHMAN_685,
+	"OGRE_MERCHANT":                NPC_OGRE_MERCHANT,
+	"OGRE_TRADER":                  NPC_OGRE_TRADER,
+	"OGRE_TRADER_688":              NPC_OGRE_TRADER_688,
+	"OGRE_TRADER_689":              NPC_OGRE_TRADER_689,
+	"MERCENARY_690":                NPC_MERCENARY_690,
+	"CITY_GUARD":                   NPC_CITY_GUARD,
+	"MERCENARY_692":                NPC_MERCENARY_692,
+	"LAWGOF":                       NPC_LAWGOF,
+	"DWARF_694":                    NPC_DWARF_694,
+	"LOLLK":                        NPC_LOLLK,
+	"SKAVID_696":                   NPC_SKAVID_696,
+	"OGRE_GUARD_697":               NPC_OGRE_GUARD_697,
+	"NULODION":                     NPC_NULODION,
+	"DWARF_699":                    NPC_DWARF_699,
+	"AL_SHABIM":                    NPC_AL_SHABIM,
+	"BEDABIN_NOMAD":                NPC_BEDABIN_NOMAD,
+	"CAPTAIN_SIAD":                 NPC_CAPTAIN_SIAD,
+	"BEDABIN_NOMAD_GUARD":          NPC_BEDABIN_NOMAD_GUARD,
+	"OGRE_CITIZEN":                 NPC_OGRE_CITIZEN,
+	"ROCK_OF_AGES":                 NPC_ROCK_OF_AGES,
+	"OGRE_706":                     NPC_OGRE_706,
+	"SKAVID_707":                   NPC_SKAVID_707,
+	"SKAVID_708":                   NPC_SKAVID_708,
+	"SKAVID_709":                   NPC_SKAVID_709,
+	"DRAFT_MERCENARY_GUARD":        NPC_DRAFT_MERCENARY_GUARD,
+	"MINING_CART_DRIVER":           NPC_MINING_CART_DRIVER,
+	"KOLODION":                     NPC_KOLODION,
+	"KOLODION_713":                 NPC_KOLODION_713,
+	"GERTRUDE":                     NPC_GERTRUDE,
+	"SHILOP":                       NPC_SHILOP,
+	"ROWDY_GUARD":                  NPC_ROWDY_GUARD,
+	"SHANTAY_PASS_GUARD":           NPC_SHANTAY_PASS_GUARD,
+	"ROWDY_SLAVE":                  NPC_ROWDY_SLAVE,
+	"SHANTAY_PASS_GUARD_719":       NPC_SHANTAY_PASS_GUARD_719,
+	"ASSISTANT":                    NPC_ASSISTANT,
+	"DESERT_WOLF":                  NPC_DESERT_WOLF,
+	"WORKMAN":                      NPC_WORKMAN,
+	"EXAMINER":                     NPC_EXAMINER,
+	"STUDENT":                      NPC_STUDENT,
+	"STUDENT_725":                  NPC_STUDENT_725,
+	"GUIDE_726":                    NPC_GUIDE_726,
+	"STUDENT_727":                  NPC_STUDENT_727,
+	"ARCHAEOLOGICAL_EXPERT":        NPC_ARCHAEOLOGICAL_EXPERT,
+	"CIVILLIAN":                    NPC_CIVILLIAN,
+	"CIVILLIAN_730":                NPC_CIVILLIAN_730,
+	"CIVILLIAN_731":                NPC_CIVILLIAN_731,
+	"CIVILLIAN_732":                NPC_CIVILLIAN_732,
+	"MURPHY":                       NPC_MURPHY,
+	"MURPHY_734":                   NPC_MURPHY_734,
+	"SIR_RADIMUS_ERKLE":            NPC_SIR_RADIMUS_ERKLE,
+	"LEGENDS_GUILD_GUARD":          NPC_LEGENDS_GUILD_GUARD,
+	"ESCAPING_MINING_SLAVE":        NPC_ESCAPING_MINING_SLAVE,
+	"WORKMAN_738":                  NPC_WORKMAN_738,
+	"MURPHY_739":                   NPC_MURPHY_739,
+	"ECHNED_ZEKIN":                 NPC_ECHNED_ZEKIN,
+	"DONOVAN_THE_HANDYMAN":         NPC_DONOVAN_THE_HANDYMAN,
+	"PIERRE_THE_DOG_HANDLER":       NPC_PIERRE_THE_DOG_HANDLER,
+	"HOBBES_THE_BUTLER":            NPC_HOBBES_THE_BUTLER,
+	"LOUISA_THE_COOK":              NPC_LOUISA_THE_COOK,
+	"MARY_THE_MAID":                NPC_MARY_THE_MAID,
+	"STANFORD_THE_GARDENER":        NPC_STANFORD_THE_GARDENER,
+	"GUARD_747":                    NPC_GUARD_747,
+	"GUARD_DOG_748":                NPC_GUARD_DOG_748,
+	"GUARD_749":                    NPC_GUARD_749,
+	"MAN_750":                      NPC_MAN_750,
+	"ANNA_SINCLAIR":                NPC_ANNA_SINCLAIR,
+	"BOB_SINCLAIR":                 NPC_BOB_SINCLAIR,
+	"CAROL_SINCLAIR":               NPC_CAROL_SINCLAIR,
+	"DAVID_SINCLAIR":               NPC_DAVID_SINCLAIR,
+	"ELIZABETH_SINCLAIR":           NPC_ELIZABETH_SINCLAIR,
+	"FRANK_SINCLAIR":               NPC_FRANK_SINCLAIR,
+	"KOLODION_757":                 NPC_KOLODION_757,
+	"KOLODION_758":                 NPC_KOLODION_758,
+	"KOLODION_759":                 NPC_KOLODION_759,
+	"KOLODION_760":                 NPC_KOLODION_760,
+	"IRVIG_SENAY":                  NPC_IRVIG_SENAY,
+	"RANALPH_DEVERE":               NPC_RANALPH_DEVERE,
+	"POISON_SALESMAN":              NPC_POISON_SALESMAN,
+	"GUJUO":                        NPC_GUJUO,
+	"JUNGLE_FORESTER":              NPC_JUNGLE_FORESTER,
+	"UNGADULU":                     NPC_UNGADULU,
+	"UNGADULU_767":                 NPC_UNGADULU_767,
+	"DEATH_WING":                   NPC_DEATH_WING,
+	"NEZIKCHENED":                  NPC_NEZIKCHENED,
+	"DWARF_CANNON_ENGINEER":        NPC_DWARF_CANNON_ENGINEER,
+	"DWARF_COMMANDER":              NPC_DWARF_COMMANDER,
+	"VIYELDI":                      NPC_VIYELDI,
+	"NURMOF":                       NPC_NURMOF,
+	"FATIGUE_EXPERT":               NPC_FATIGUE_EXPERT,
+	"KARAMJA_WOLF":                 NPC_KARAMJA_WOLF,
+	"JUNGLE_SAVAGE":                NPC_JUNGLE_SAVAGE,
+	"OOMLIE_BIRD":                  NPC_OOMLIE_BIRD,
+	"SIDNEY_SMITH":                 NPC_SIDNEY_SMITH,
+	"SIEGFRIED_ERKLE":              NPC_SIEGFRIED_ERKLE,
+	"TEA_SELLER":                   NPC_TEA_SELLER,
+	"WILOUGH":                      NPC_WILOUGH,
+	"PHILOP":                       NPC_PHILOP,
+	"KANEL":                        NPC_KANEL,
+	"CHAMBER_GUARDIAN":             NPC_CHAMBER_GUARDIAN,
+	"SIR_RADIMUS_ERKLE_785":        NPC_SIR_RADIMUS_ERKLE_785,
+	"PIT_SCORPION":                 NPC_PIT_SCORPION,
+	"SHADOW_WARRIOR":               NPC_SHADOW_WARRIOR,
+	"FIONELLA":                     NPC_FIONELLA,
+	"BATTLE_MAGE":                  NPC_BATTLE_MAGE,
+	"BATTLE_MAGE_790":              NPC_BATTLE_MAGE_790,
+	"BATTLE_MAGE_791":              NPC_BATTLE_MAGE_791,
+	"GUNDAI":                       NPC_GUNDAI,
+	"LUNDAIL":                      NPC_LUNDAIL,
+}
@@ -0,0 +1,2389 @@
+// Code generated by idgen from the entity database. DO NOT EDIT.
+
+package ids
+
+// The ids of every scenery object definition.
+const (
+	OBJECT_TREE                           = 0    // Tree
+	OBJECT_TREE_1                         = 1    // Tree
+	OBJECT_WELL                           = 2    // Well
+	OBJECT_TABLE                          = 3    // Table
+	OBJECT_TREESTUMP                      = 4    // Treestump
+	OBJECT_LADDER                         = 5    // Ladder
+	OBJECT_LADDER_6                       = 6    // Ladder
+	OBJECT_CHAIR                          = 7    // Chair
+	OBJECT_LOGS                           = 8    // logs
+	OBJECT_LONGTABLE                      = 9    // Longtable
+	OBJECT_THRONE                         = 10   // Throne
+	OBJECT_RANGE                          = 11   // Range
+	OBJECT_GRAVESTONE                     = 12   // Gravestone
+	OBJECT_GRAVESTONE_13                  = 13   // Gravestone
+	OBJECT_BED                            = 14   // Bed
+	OBJECT_BED_15                         = 15   // Bed
+	OBJECT_BAR                            = 16   // bar
+	OBJECT_CHEST                          = 17   // Chest
+	OBJECT_CHEST_18                       = 18   // Chest
+	OBJECT_ALTAR                          = 19   // Altar
+	OBJECT_POST                           = 20   // Post
+	OBJECT_SUPPORT                        = 21   // Support
+	OBJECT_BARREL                         = 22   // barrel
+	OBJECT_BENCH                          = 23   // Bench
+	OBJECT_PORTRAIT                       = 24   // Portrait
+	OBJECT_CANDLES                        = 25   // candles
+	OBJECT_FOUNTAIN                       = 26   // fountain
+	OBJECT_LANDSCAPE                      = 27   // landscape
+	OBJECT_MILLSTONES                     = 28   // Millstones
+	OBJECT_COUNTER                        = 29   // Counter
+	OBJECT_STALL                          = 30   // Stall
+	OBJECT_TARGET                         = 31   // Target
+	OBJECT_PALMTREE                       = 32   // PalmTree
+	OBJECT_PALMTREE_33                    = 33   // PalmTree
+	OBJECT_FERN                           = 34   // Fern
+	OBJECT_CACTUS                         = 35   // Cactus
+	OBJECT_BULLRUSHES                     = 36   // Bullrushes
+	OBJECT_FLOWER                         = 37   // Flower
+	OBJECT_MUSHROOM                       = 38   // Mushroom
+	OBJECT_COFFIN                         = 39   // Coffin
+	OBJECT_COFFIN_40                      = 40   // Coffin
+	OBJECT_STAIRS                         = 41   // stairs
+	OBJECT_STAIRS_42                      = 42   // stairs
+	OBJECT_STAIRS_43                      = 43   // stairs
+	OBJECT_STAIRS_44                      = 44   // stairs
+	OBJECT_RAILING                        = 45   // railing
+	OBJECT_PILLAR                         = 46   // pillar
+	OBJECT_BOOKCASE                       = 47   // Bookcase
+	OBJECT_SINK                           = 48   // Sink
+	OBJECT_DUMMY                          = 49   // Dummy
+	OBJECT_ANVIL                          = 50   // anvil
+	OBJECT_TORCH                          = 51   // Torch
+	OBJECT_HOPPER                         = 52   // hopper
+	OBJECT_CHUTE                          = 53   // chute
+	OBJECT_CART                           = 54   // cart
+	OBJECT_SACKS                          = 55   // sacks
+	OBJECT_CUPBOARD                       = 56   // cupboard
+	OBJECT_GATE                           = 57   // Gate
+	OBJECT_GATE_58                        = 58   // gate
+	OBJECT_GATE_59                        = 59   // gate
+	OBJECT_GATE_60                        = 60   // gate
+	OBJECT_SIGNPOST                       = 61   // signpost
+	OBJECT_SIGNPOST_62                    = 62   // signpost
+	OBJECT_DOORS                          = 63   // doors
+	OBJECT_DOORS_64                       = 64   // doors
+	OBJECT_SIGNPOST_65                    = 65   // signpost
+	OBJECT_SIGNPOST_66                    = 66   // signpost
+	OBJECT_BOOKCASE_67                    = 67   // bookcase
+	OBJECT_HENGE                          = 68   // henge
+	OBJECT_DOLMEN                         = 69   // Dolmen
+	OBJECT_TREE_70                        = 70   // Tree
+	OBJECT_CUPBOARD_71                    = 71   // cupboard
+	OBJECT_WHEAT                          = 72   // Wheat
+	OBJECT_SIGN                           = 73   // sign
+	OBJECT_SAILS                          = 74   // sails
+	OBJECT_SIGN_75                        = 75   // sign
+	OBJECT_SIGN_76                        = 76   // sign
+	OBJECT_DRAIN                          = 77   // Drain
+	OBJECT_MANHOLE                        = 78   // manhole
+	OBJECT_MANHOLE_79                     = 79   // manhole
+	OBJECT_PIPE                           = 80   // pipe
+	OBJECT_CHEST_81                       = 81   // Chest
+	OBJECT_CHEST_82                       = 82   // Chest
+	OBJECT_BARREL_83                      = 83   // barrel
+	OBJECT_CUPBOARD_84                    = 84   // cupboard
+	OBJECT_CUPBOARD_85                    = 85   // cupboard
+	OBJECT_FOUNTAIN_86                    = 86   // fountain
+	OBJECT_SIGNPOST_87                    = 87   // signpost
+	OBJECT_TREE_88                        = 88   // Tree
+	OBJECT_SIGN_89                        = 89   // sign
+	OBJECT_SIGN_90                        = 90   // sign
+	OBJECT_SIGN_91                        = 91   // sign
+	OBJECT_SIGN_92                        = 92   // sign
+	OBJECT_GATE_93                        = 93   // gate
+	OBJECT_GATE_94                        = 94   // gate
+	OBJECT_SIGN_95                        = 95   // sign
+	OBJECT_SIGN_96                        = 96   // sign
+	OBJECT_FIRE                           = 97   // fire
+	OBJECT_ROCK                           = 98   // Rock
+	OBJECT_ROCK_99                        = 99   // Rock
+	OBJECT_ROCK_100                       = 100  // Rock
+	OBJECT_ROCK_101                       = 101  // Rock
+	OBJECT_ROCK_102                       = 102  // Rock
+	OBJECT_ROCK_103                       = 103  // Rock
+	OBJECT_ROCK_104                       = 104  // Rock
+	OBJECT_ROCK_105                       = 105  // Rock
+	OBJECT_ROCK_106                       = 106  // Rock
+	OBJECT_ROCK_107                       = 107  // Rock
+	OBJECT_ROCK_108                       = 108  // Rock
+	OBJECT_ROCK_109                       = 109  // Rock
+	OBJECT_ROCK_110                       = 110  // Rock
+	OBJECT_ROCK_111                       = 111  // Rock
+	OBJECT_ROCK_112                       = 112  // Rock
+	OBJECT_ROCK_113                       = 113  // Rock
+	OBJECT_ROCK_114                       = 114  // Rock
+	OBJECT_ROCK_115                       = 115  // Rock
+	OBJECT_WEB                            = 116  // web
+	OBJECT_WEB_117                        = 117  // web
+	OBJECT_FURNACE                        = 118  // furnace
+	OBJECT_COOK_S_RANGE                   = 119  // Cook's Range
+	OBJECT_MACHINE                        = 120  // Machine
+	OBJECT_SPINNING_WHEEL                 = 121  // Spinning wheel
+	OBJECT_LEVER                          = 122  // Lever
+	OBJECT_LEVER_123                      = 123  // Lever
+	OBJECT_LEVERA                         = 124  // LeverA
+	OBJECT_LEVERB                         = 125  // LeverB
+	OBJECT_LEVERC                         = 126  // LeverC
+	OBJECT_LEVERD                         = 127  // LeverD
+	OBJECT_LEVERE                         = 128  // LeverE
+	OBJECT_LEVERF                         = 129  // LeverF
+	OBJECT_LADDER_130                     = 130  // Ladder
+	OBJECT_SIGNPOST_131                   = 131  // signpost
+	OBJECT_SIGNPOST_132                   = 132  // signpost
+	OBJECT_SIGNPOST_133                   = 133  // signpost
+	OBJECT_COMPOST_HEAP                   = 134  // Compost Heap
+	OBJECT_COFFIN_135                     = 135  // Coffin
+	OBJECT_COFFIN_136                     = 136  // Coffin
+	OBJECT_GATE_137                       = 137  // gate
+	OBJECT_GATE_138                       = 138  // gate
+	OBJECT_SIGN_139                       = 139  // sign
+	OBJECT_CUPBOARD_140                   = 140  // cupboard
+	OBJECT_CUPBOARD_141                   = 141  // cupboard
+	OBJECT_DOORS_142                      = 142  // doors
+	OBJECT_TORCH_143                      = 143  // torch
+	OBJECT_ALTAR_144                      = 144  // Altar
+	OBJECT_SHIELD                         = 145  // Shield
+	OBJECT_GRILL                          = 146  // Grill
+	OBJECT_CAULDRON                       = 147  // Cauldron
+	OBJECT_GRILL_148                      = 148  // Grill
+	OBJECT_MINE_CART                      = 149  // Mine Cart
+	OBJECT_BUFFERS                        = 150  // Buffers
+	OBJECT_TRACK                          = 151  // Track
+	OBJECT_TRACK_152                      = 152  // Track
+	OBJECT_TRACK_153                      = 153  // Track
+	OBJECT_HOLE                           = 154  // Hole
+	OBJECT_SHIP                           = 155  // ship
+	OBJECT_SHIP_156                       = 156  // ship
+	OBJECT_SHIP_157                       = 157  // ship
+	OBJECT_EMERGENCY_ESCAPE_LADDER        = 158  // Emergency escape ladder
+	OBJECT_SIGN_159                       = 159  // sign
+	OBJECT_SIGN_160                       = 160  // sign
+	OBJECT_SHIP_161                       = 161  // ship
+	OBJECT_SHIP_162                       = 162  // ship
+	OBJECT_SHIP_163                       = 163  // ship
+	OBJECT_ROCKS                          = 164  // Rocks
+	OBJECT_ROCKS_165                      = 165  // Rocks
+	OBJECT_ROCKS_166                      = 166  // Rocks
+	OBJECT_ROCKS_167                      = 167  // Rocks
+	OBJECT_ROCKS_168                      = 168  // Rocks
+	OBJECT_ROCKS_169                      = 169  // Rocks
+	OBJECT_ROCKS_170                      = 170  // Rocks
+	OBJECT_ROCKS_171                      = 171  // Rocks
+	OBJECT_ROCKS_172                      = 172  // Rocks
+	OBJECT_HOPPER_173                     = 173  // hopper
+	OBJECT_CUPBOARD_174                   = 174  // cupboard
+	OBJECT_CUPBOARD_175                   = 175  // cupboard
+	OBJECT_ROCKS_176                      = 176  // Rocks
+	OBJECT_DORIC_S_ANVIL                  = 177  // Doric's anvil
+	OBJECT_POTTERY_OVEN                   = 178  // pottery oven
+	OBJECT_POTTER_S_WHEEL                 = 179  // potter's wheel
+	OBJECT_GATE_180                       = 180  // gate
+	OBJECT_GATE_181                       = 181  // gate
+	OBJECT_CRATE                          = 182  // crate
+	OBJECT_BANANA_TREE                    = 183  // Banana tree
+	OBJECT_BANANA_TREE_184                = 184  // Banana tree
+	OBJECT_CRATE_185                      = 185  // crate
+	OBJECT_CHEST_186                      = 186  // Chest
+	OBJECT_CHEST_187                      = 187  // Chest
+	OBJECT_FLOWER_188                     = 188  // Flower
+	OBJECT_SIGN_189                       = 189  // sign
+	OBJECT_SIGN_190                       = 190  // sign
+	OBJECT_POTATO                         = 191  // Potato
+	OBJECT_FISH                           = 192  // fish
+	OBJECT_FISH_193                       = 193  // fish
+	OBJECT_FISH_194                       = 194  // fish
+	OBJECT_ROCK_195                       = 195  // Rock
+	OBJECT_ROCK_196                       = 196  // Rock
+	OBJECT_ROCKS_197                      = 197  // Rocks
+	OBJECT_LADDER_198                     = 198  // Ladder
+	OBJECT_LADDER_199                     = 199  // Ladder
+	OBJECT_MONKS_ALTAR                    = 200  // Monks Altar
+	OBJECT_LADDER_201                     = 201  // Ladder
+	OBJECT_COFFIN_202                     = 202  // Coffin
+	OBJECT_COFFIN_203                     = 203  // Coffin
+	OBJECT_SMASHED_TABLE                  = 204  // Smashed table
+	OBJECT_FUNGUS                         = 205  // Fungus
+	OBJECT_SMASHED_CHAIR                  = 206  // Smashed chair
+	OBJECT_BROKEN_PILLAR                  = 207  // Broken pillar
+	OBJECT_FALLEN_TREE                    = 208  // Fallen tree
+	OBJECT_DANGER_SIGN                    = 209  // Danger Sign
+	OBJECT_ROCK_210                       = 210  // Rock
+	OBJECT_ROCK_211                       = 211  // Rock
+	OBJECT_ROCKS_212                      = 212  // Rocks
+	OBJECT_GRAVESTONE_213                 = 213  // Gravestone
+	OBJECT_BONE                           = 214  // bone
+	OBJECT_BONE_215                       = 215  // bone
+	OBJECT_CARCASS                        = 216  // carcass
+	OBJECT_ANIMALSKULL                    = 217  // animalskull
+	OBJECT_VINE                           = 218  // Vine
+	OBJECT_VINE_219                       = 219  // Vine
+	OBJECT_VINE_220                       = 220  // Vine
+	OBJECT_CHEST_221                      = 221  // Chest
+	OBJECT_CHEST_222                      = 222  // Chest
+	OBJECT_LADDER_223                     = 223  // Ladder
+	OBJECT_SHIP_224                       = 224  // ship
+	OBJECT_SHIP_225                       = 225  // ship
+	OBJECT_HOLE_226                       = 226  // hole
+	OBJECT_LADDER_227                     = 227  // Ladder
+	OBJECT_CHEST_228                      = 228  // Chest
+	OBJECT_CHEST_229                      = 229  // Chest
+	OBJECT_CHEST_230                      = 230  // Chest
+	OBJECT_CHEST_231                      = 231  // Chest
+	OBJECT_HOLE_232                       = 232  // hole
+	OBJECT_SHIP_233                       = 233  // ship
+	OBJECT_SHIP_234                       = 234  // ship
+	OBJECT_ALTAR_OF_GUTHIX                = 235  // Altar of Guthix
+	OBJECT_THE_CAULDRON_OF_THUNDER        = 236  // The Cauldron of Thunder
+	OBJECT_TREE_237                       = 237  // Tree
+	OBJECT_SHIP_238                       = 238  // ship
+	OBJECT_SHIP_239                       = 239  // ship
+	OBJECT_SHIP_240                       = 240  // ship
+	OBJECT_SHIP_241                       = 241  // ship
+	OBJECT_SHIP_242                       = 242  // ship
+	OBJECT_SHIP_243                       = 243  // ship
+	OBJECT_LADDER_244                     = 244  // Ladder
+	OBJECT_DRAMEN_TREE                    = 245  // Dramen Tree
+	OBJECT_HOPPER_246                     = 246  // hopper
+	OBJECT_CHEST_247                      = 247  // Chest
+	OBJECT_CHEST_248                      = 248  // Chest
+	OBJECT_LADDER_249                     = 249  // Ladder
+	OBJECT_SIGN_250                       = 250  // sign
+	OBJECT_SIGN_251                       = 251  // sign
+	OBJECT_GATE_252                       = 252  // gate
+	OBJECT_GATE_253                       = 253  // gate
+	OBJECT_GATE_254                       = 254  // gate
+	OBJECT_DOOR_MAT                       = 255  // Door mat
+	OBJECT_GATE_256                       = 256  // gate
+	OBJECT_CAULDRON_257                   = 257  // Cauldron
+	OBJECT_CUPBOARD_258                   = 258  // cupboard
+	OBJECT_CUPBOARD_259                   = 259  // cupboard
+	OBJECT_GATE_260                       = 260  // gate
+	OBJECT_FISH_261                       = 261  // fish
+	OBJECT_SIGN_262                       = 262  // sign
+	OBJECT_CUPBOARD_263                   = 263  // cupboard
+	OBJECT_CUPBOARD_264                   = 264  // cupboard
+	OBJECT_CHEST_265                      = 265  // Chest
+	OBJECT_CHEST_266                      = 266  // Chest
+	OBJECT_SIGN_267                       = 267  // sign
+	OBJECT_SIGNPOST_268                   = 268  // signpost
+	OBJECT_ROCKSLIDE                      = 269  // Rockslide
+	OBJECT_LADDER_270                     = 270  // Ladder
+	OBJECT_FISH_271                       = 271  // fish
+	OBJECT_BARREL_272                     = 272  // barrel
+	OBJECT_TABLE_273                      = 273  // table
+	OBJECT_FIREPLACE                      = 274  // Fireplace
+	OBJECT_EGG                            = 275  // Egg
+	OBJECT_EGGS                           = 276  // Eggs
+	OBJECT_STALAGMITES                    = 277  // Stalagmites
+	OBJECT_STOOL                          = 278  // Stool
+	OBJECT_BENCH_279                      = 279  // Bench
+	OBJECT_TABLE_280                      = 280  // table
+	OBJECT_TABLE_281                      = 281  // table
+	OBJECT_FOUNTAIN_OF_HEROS              = 282  // fountain of heros
+	OBJECT_BUSH                           = 283  // bush
+	OBJECT_HEDGE                          = 284  // hedge
+	OBJECT_FLOWER_285                     = 285  // flower
+	OBJECT_PLANT                          = 286  // plant
+	OBJECT_GIANT_CRYSTAL                  = 287  // Giant crystal
+	OBJECT_SIGN_288                       = 288  // sign
+	OBJECT_SIGN_289                       = 289  // sign
+	OBJECT_CRATE_290                      = 290  // crate
+	OBJECT_CRATE_291                      = 291  // crate
+	OBJECT_SHIP_292                       = 292  // ship
+	OBJECT_SHIP_293                       = 293  // ship
+	OBJECT_BEEHIVE                        = 294  // beehive
+	OBJECT_LADDER_295                     = 295  // Ladder
+	OBJECT_ALTAR_296                      = 296  // Altar
+	OBJECT_SIGN_297                       = 297  // sign
+	OBJECT_SIGNPOST_298                   = 298  // signpost
+	OBJECT_ARCHWAY                        = 299  // Archway
+	OBJECT_OBELISK_OF_WATER               = 300  // Obelisk of water
+	OBJECT_OBELISK_OF_FIRE                = 301  // Obelisk of fire
+	OBJECT_SAND_PIT                       = 302  // sand pit
+	OBJECT_OBELISK_OF_AIR                 = 303  // Obelisk of air
+	OBJECT_OBELISK_OF_EARTH               = 304  // Obelisk of earth
+	OBJECT_GATE_305                       = 305  // gate
+	OBJECT_OAK_TREE                       = 306  // Oak Tree
+	OBJECT_WILLOW_TREE                    = 307  // Willow Tree
+	OBJECT_MAPLE_TREE                     = 308  // Maple Tree
+	OBJECT_YEW_TREE                       = 309  // Yew Tree
+	OBJECT_TREE_310                       = 310  // Tree
+	OBJECT_GATE_311                       = 311  // gate
+	OBJECT_SIGN_312                       = 312  // sign
+	OBJECT_FLAX                           = 313  // flax
+	OBJECT_LARGE_TREESTUMP                = 314  // Large treestump
+	OBJECT_ROCKS_315                      = 315  // Rocks
+	OBJECT_LEVER_316                      = 316  // Lever
+	OBJECT_LEVER_317                      = 317  // Lever
+	OBJECT_LEVER_318                      = 318  // Lever
+	OBJECT_GATE_319                       = 319  // gate
+	OBJECT_SHIP_320                       = 320  // ship
+	OBJECT_SHIP_321                       = 321  // ship
+	OBJECT_BAKERS_STALL                   = 322  // Bakers Stall
+	OBJECT_SILK_STALL                     = 323  // Silk Stall
+	OBJECT_FUR_STALL                      = 324  // Fur Stall
+	OBJECT_SILVER_STALL                   = 325  // Silver Stall
+	OBJECT_SPICES_STALL                   = 326  // Spices Stall
+	OBJECT_GEMS_STALL                     = 327  // gems Stall
+	OBJECT_CRATE_328                      = 328  // crate
+	OBJECT_CRATE_329                      = 329  // crate
+	OBJECT_SIGN_330                       = 330  // sign
+	OBJECT_STAIRS_331                     = 331  // stairs
+	OBJECT_CHEST_332                      = 332  // Chest
+	OBJECT_CHEST_333                      = 333  // Chest
+	OBJECT_CHEST_334                      = 334  // Chest
+	OBJECT_CHEST_335                      = 335  // Chest
+	OBJECT_CHEST_336                      = 336  // Chest
+	OBJECT_CHEST_337                      = 337  // Chest
+	OBJECT_CHEST_338                      = 338  // Chest
+	OBJECT_CHEST_339                      = 339  // Chest
+	OBJECT_CHEST_340                      = 340  // Chest
+	OBJECT_EMPTY_STALL                    = 341  // empty stall
+	OBJECT_STAIRS_342                     = 342  // stairs
+	OBJECT_HOPPER_343                     = 343  // hopper
+	OBJECT_SIGNPOST_344                   = 344  // signpost
+	OBJECT_SIGN_345                       = 345  // sign
+	OBJECT_GATE_346                       = 346  // gate
+	OBJECT_GATE_347                       = 347  // gate
+	OBJECT_LEVER_348                      = 348  // Lever
+	OBJECT_LEVER_349                      = 349  // Lever
+	OBJECT_PIPE_350                       = 350  // pipe
+	OBJECT_FISH_351                       = 351  // fish
+	OBJECT_FISH_352                       = 352  // fish
+	OBJECT_FISH_353                       = 353  // fish
+	OBJECT_FISH_354                       = 354  // fish
+	OBJECT_VINE_355                       = 355  // Vine
+	OBJECT_GATE_356                       = 356  // gate
+	OBJECT_GATE_357                       = 357  // gate
+	OBJECT_GATE_358                       = 358  // gate
+	OBJECT_STAIRS_359                     = 359  // stairs
+	OBJECT_BROKEN_CART                    = 360  // broken cart
+	OBJECT_LEVER_361                      = 361  // Lever
+	OBJECT_CLOCK_POLE_BLUE                = 362  // clock pole blue
+	OBJECT_CLOCK_POLE_RED                 = 363  // clock pole red
+	OBJECT_CLOCK_POLE_PURPLE              = 364  // clock pole purple
+	OBJECT_CLOCK_POLE_BLACK               = 365  // clock pole black
+	OBJECT_WALLCLOCKFACE                  = 366  // wallclockface
+	OBJECT_LEVER_BRACKET                  = 367  // Lever Bracket
+	OBJECT_LEVER_368                      = 368  // Lever
+	OBJECT_STAIRS_369                     = 369  // stairs
+	OBJECT_STAIRS_370                     = 370  // stairs
+	OBJECT_GATE_371                       = 371  // gate
+	OBJECT_GATE_372                       = 372  // gate
+	OBJECT_LEVER_373                      = 373  // Lever
+	OBJECT_LEVER_374                      = 374  // Lever
+	OBJECT_FOODTROUGH                     = 375  // Foodtrough
+	OBJECT_FISH_376                       = 376  // fish
+	OBJECT_SPEARWALL                      = 377  // spearwall
+	OBJECT_HORNEDSKULL                    = 378  // hornedskull
+	OBJECT_CHEST_379                      = 379  // Chest
+	OBJECT_CHEST_380                      = 380  // Chest
+	OBJECT_GUARDSCUPBOARD                 = 381  // guardscupboard
+	OBJECT_GUARDSCUPBOARD_382             = 382  // guardscupboard
+	OBJECT_COAL_TRUCK                     = 383  // Coal truck
+	OBJECT_SHIP_384                       = 384  // ship
+	OBJECT_SHIP_385                       = 385  // ship
+	OBJECT_SHIP_386                       = 386  // ship
+	OBJECT_TREE_387                       = 387  // Tree
+	OBJECT_BALLISTA                       = 388  // Ballista
+	OBJECT_LARGESPEAR                     = 389  // largespear
+	OBJECT_SPIRIT_TREE                    = 390  // spirit tree
+	OBJECT_YOUNG_SPIRIT_TREE              = 391  // young spirit Tree
+	OBJECT_GATE_392                       = 392  // gate
+	OBJECT_WALL                           = 393  // wall
+	OBJECT_TREE_394                       = 394  // tree
+	OBJECT_TREE_395                       = 395  // tree
+	OBJECT_FERN_396                       = 396  // Fern
+	OBJECT_FERN_397                       = 397  // Fern
+	OBJECT_FERN_398                       = 398  // Fern
+	OBJECT_FERN_399                       = 399  // Fern
+	OBJECT_FLY_TRAP                       = 400  // fly trap
+	OBJECT_FERN_401                       = 401  // Fern
+	OBJECT_FERN_402                       = 402  // Fern
+	OBJECT_PLANT_403                      = 403  // plant
+	OBJECT_PLANT_404                      = 404  // plant
+	OBJECT_PLANT_405                      = 405  // plant
+	OBJECT_STONE_HEAD                     = 406  // stone head
+	OBJECT_DEAD_TREE                      = 407  // dead Tree
+	OBJECT_SACKS_408                      = 408  // sacks
+	OBJECT_KHAZARD_OPEN_CHEST             = 409  // khazard open Chest
+	OBJECT_KHAZARD_SHUT_CHEST             = 410  // khazard shut Chest
+	OBJECT_DOORFRAME                      = 411  // doorframe
+	OBJECT_SEWER_VALVE                    = 412  // Sewer valve
+	OBJECT_SEWER_VALVE_2                  = 413  // Sewer valve 2
+	OBJECT_SEWER_VALVE_3                  = 414  // Sewer valve 3
+	OBJECT_SEWER_VALVE_4                  = 415  // Sewer valve 4
+	OBJECT_SEWER_VALVE_5                  = 416  // Sewer valve 5
+	OBJECT_CAVE_ENTRANCE                  = 417  // Cave entrance
+	OBJECT_LOG_BRIDGE                     = 418  // Log bridge
+	OBJECT_LOG_BRIDGE_419                 = 419  // Log bridge
+	OBJECT_TREE_PLATFORM                  = 420  // tree platform
+	OBJECT_TREE_PLATFORM_421              = 421  // tree platform
+	OBJECT_GATE_422                       = 422  // gate
+	OBJECT_TREE_PLATFORM_423              = 423  // tree platform
+	OBJECT_TREE_PLATFORM_424              = 424  // tree platform
+	OBJECT_LOG_BRIDGE_425                 = 425  // Log bridge
+	OBJECT_LOG_BRIDGE_426                 = 426  // Log bridge
+	OBJECT_TREE_PLATFORM_427              = 427  // tree platform
+	OBJECT_TREE_PLATFORM_428              = 428  // tree platform
+	OBJECT_TRIBAL_BREW                    = 429  // Tribal brew
+	OBJECT_PINEAPPLE_TREE                 = 430  // Pineapple tree
+	OBJECT_PINEAPPLE_TREE_431             = 431  // Pineapple tree
+	OBJECT_LOG_RAFT                       = 432  // log raft
+	OBJECT_LOG_RAFT_433                   = 433  // log raft
+	OBJECT_TOMB_OF_HAZEEL                 = 434  // Tomb of hazeel
+	OBJECT_RANGE_435                      = 435  // range
+	OBJECT_BOOKCASE_436                   = 436  // Bookcase
+	OBJECT_CARNILLEAN_CHEST               = 437  // Carnillean Chest
+	OBJECT_CARNILLEAN_CHEST_438           = 438  // Carnillean Chest
+	OBJECT_CRATE_439                      = 439  // crate
+	OBJECT_BUTLERS_CUPBOARD               = 440  // Butlers cupboard
+	OBJECT_BUTLERS_CUPBOARD_441           = 441  // Butlers cupboard
+	OBJECT_GATE_442                       = 442  // gate
+	OBJECT_GATE_443                       = 443  // gate
+	OBJECT_CATTLE_FURNACE                 = 444  // Cattle furnace
+	OBJECT_ARDOUNGE_WALL                  = 445  // Ardounge wall
+	OBJECT_ARDOUNGE_WALL_CORNER           = 446  // Ardounge wall corner
+	OBJECT_DUG_UP_SOIL                    = 447  // Dug up soil
+	OBJECT_PILE_OF_MUD                    = 448  // Pile of mud
+	OBJECT_LARGE_SEWER_PIPE               = 449  // large Sewer pipe
+	OBJECT_ARDOUNGE_WALL_GATEWAY          = 450  // Ardounge wall gateway
+	OBJECT_CUPBOARD_451                   = 451  // cupboard
+	OBJECT_CUPBOARD_452                   = 452  // cupboard
+	OBJECT_FISHING_CRANE                  = 453  // Fishing crane
+	OBJECT_ROWBOAT                        = 454  // Rowboat
+	OBJECT_DAMAGED_ROWBOAT                = 455  // Damaged Rowboat
+	OBJECT_BARREL_456                     = 456  // barrel
+	OBJECT_GATE_457                       = 457  // gate
+	OBJECT_LADDER_458                     = 458  // Ladder
+	OBJECT_FISHING_CRANE_459              = 459  // Fishing crane
+	OBJECT_FISHING_CRANE_460              = 460  // Fishing crane
+	OBJECT_WATERFALL                      = 461  // Waterfall
+	OBJECT_LEAFLESSTREE                   = 462  // leaflessTree
+	OBJECT_LEAFLESSTREE_463               = 463  // leaflessTree
+	OBJECT_LOG_RAFT_464                   = 464  // log raft
+	OBJECT_DOORS_465                      = 465  // doors
+	OBJECT_WELL_466                       = 466  // Well
+	OBJECT_TOMB_OF_GLARIAL                = 467  // Tomb of glarial
+	OBJECT_WATERFALL_468                  = 468  // Waterfall
+	OBJECT_WATERFALL_469                  = 469  // Waterfall
+	OBJECT_BOOKCASE_470                   = 470  // Bookcase
+	OBJECT_DOORS_471                      = 471  // doors
+	OBJECT_DOORS_472                      = 472  // doors
+	OBJECT_STONE_STAND                    = 473  // Stone stand
+	OBJECT_STONE_STAND_474                = 474  // Stone stand
+	OBJECT_STONE_STAND_475                = 475  // Stone stand
+	OBJECT_STONE_STAND_476                = 476  // Stone stand
+	OBJECT_STONE_STAND_477                = 477  // Stone stand
+	OBJECT_STONE_STAND_478                = 478  // Stone stand
+	OBJECT_GLARIAL_S_GRAVESTONE           = 479  // Glarial's Gravestone
+	OBJECT_GATE_480                       = 480  // gate
+	OBJECT_CRATE_481                      = 481  // crate
+	OBJECT_LEAFLESSTREE_482               = 482  // leaflessTree
+	OBJECT_STATUE_OF_GLARIAL              = 483  // Statue of glarial
+	OBJECT_CHALICE_OF_ETERNITY            = 484  // Chalice of eternity
+	OBJECT_CHALICE_OF_ETERNITY_485        = 485  // Chalice of eternity
+	OBJECT_DOORS_486                      = 486  // doors
+	OBJECT_LEVER_487                      = 487  // Lever
+	OBJECT_LEVER_488                      = 488  // Lever
+	OBJECT_LOG_RAFT_REMAINS               = 489  // log raft remains
+	OBJECT_TREE_490                       = 490  // Tree
+	OBJECT_RANGE_491                      = 491  //  Range
+	OBJECT_CRATE_492                      = 492  // crate
+	OBJECT_FISH_493                       = 493  // fish
+	OBJECT_WATCH_TOWER                    = 494  // Watch tower
+	OBJECT_SIGNPOST_495                   = 495  // signpost
+	OBJECT_ROCKS_496                      = 496  // Rocks
+	OBJECT_DOORS_497                      = 497  // doors
+	OBJECT_ROPE_LADDER                    = 498  // Rope ladder
+	OBJECT_CUPBOARD_499                   = 499  // cupboard
+	OBJECT_CUPBOARD_500                   = 500  // cupboard
+	OBJECT_ROPE_LADDER_501                = 501  // Rope ladder
+	OBJECT_COOKING_POT                    = 502  // Cooking pot
+	OBJECT_GALLOW                         = 503  // Gallow
+	OBJECT_GATE_504                       = 504  // gate
+	OBJECT_CRATE_505                      = 505  // crate
+	OBJECT_CUPBOARD_506                   = 506  // cupboard
+	OBJECT_CUPBOARD_507                   = 507  // cupboard
+	OBJECT_GATE_508                       = 508  // gate
+	OBJECT_CUPBOARD_509                   = 509  // cupboard
+	OBJECT_CUPBOARD_510                   = 510  // cupboard
+	OBJECT_SIGN_511                       = 511  // sign
+	OBJECT_GRAND_TREE                     = 512  // grand tree
+	OBJECT_GATE_513                       = 513  // gate
+	OBJECT_GATE_514                       = 514  // gate
+	OBJECT_ROCKS_515                      = 515  // Rocks
+	OBJECT_ROCKS_516                      = 516  // Rocks
+	OBJECT_ROCKS_517                      = 517  // Rocks
+	OBJECT_ROCKS_518                      = 518  // Rocks
+	OBJECT_ROCKS_519                      = 519  // Rocks
+	OBJECT_ROCKS_520                      = 520  // Rocks
+	OBJECT_ROCKS_521                      = 521  // Rocks
+	OBJECT_ROCKS_522                      = 522  // Rocks
+	OBJECT_ROCKS_523                      = 523  // Rocks
+	OBJECT_LOG_BRIDGE_524                 = 524  // Log bridge
+	OBJECT_WATCH_TOWER_525                = 525  // Watch tower
+	OBJECT_LOG_BRIDGE_526                 = 526  // Log bridge
+	OBJECT_CLIMBING_ROCKS                 = 527  // climbing rocks
+	OBJECT_LEDGE                          = 528  // Ledge
+	OBJECT_LEDGE_529                      = 529  // Ledge
+	OBJECT_LOG                            = 530  // log
+	OBJECT_LOG_531                        = 531  // log
+	OBJECT_ROCKS_532                      = 532  // Rocks
+	OBJECT_ROCKS_533                      = 533  // Rocks
+	OBJECT_ROCKS_534                      = 534  // Rocks
+	OBJECT_ROCKS_535                      = 535  // Rocks
+	OBJECT_ROCKS_536                      = 536  // Rocks
+	OBJECT_ROCKS_537                      = 537  // Rocks
+	OBJECT_ROCKS_538                      = 538  // Rocks
+	OBJECT_ROCKS_539                      = 539  // Rocks
+	OBJECT_ROCKS_540                      = 540  // Rocks
+	OBJECT_ROCKS_541                      = 541  // Rocks
+	OBJECT_ROCKS_542                      = 542  // Rocks
+	OBJECT_ROCKS_543                      = 543  // Rocks
+	OBJECT_ROCKS_544                      = 544  // Rocks
+	OBJECT_ROCKS_545                      = 545  // Rocks
+	OBJECT_ROCKS_546                      = 546  // Rocks
+	OBJECT_ROCKS_547                      = 547  // Rocks
+	OBJECT_ROCKS_548                      = 548  // Rocks
+	OBJECT_ROCKS_549                      = 549  // Rocks
+	OBJECT_ROCKS_550                      = 550  // Rocks
+	OBJECT_ROCKS_551                      = 551  // Rocks
+	OBJECT_ROCKS_552                      = 552  // Rocks
+	OBJECT_PALMTREE_553                   = 553  // PalmTree
+	OBJECT_SCORCHED_EARTH                 = 554  // Scorched Earth
+	OBJECT_ROCKS_555                      = 555  // Rocks
+	OBJECT_SIGN_556                       = 556  // sign
+	OBJECT_FISH_557                       = 557  // fish
+	OBJECT_ROCKY_WALKWAY                  = 558  // Rocky Walkway
+	OBJECT_ROCKY_WALKWAY_559              = 559  // Rocky Walkway
+	OBJECT_ROCKY_WALKWAY_560              = 560  // Rocky Walkway
+	OBJECT_ROCKY_WALKWAY_561              = 561  // Rocky Walkway
+	OBJECT_FIGHT_DUMMY                    = 562  // fight Dummy
+	OBJECT_GATE_563                       = 563  // gate
+	OBJECT_JUNGLE_VINE                    = 564  // Jungle Vine
+	OBJECT_STATUE                         = 565  // statue
+	OBJECT_SIGN_566                       = 566  // sign
+	OBJECT_GRAND_TREE_567                 = 567  // grand tree
+	OBJECT_GRAND_TREE_568                 = 568  // grand tree
+	OBJECT_GRAND_TREE_569                 = 569  // grand tree
+	OBJECT_GRAND_TREE_570                 = 570  // grand tree
+	OBJECT_GRAND_TREE_571                 = 571  // grand tree
+	OBJECT_HILLSIDE_ENTRANCE              = 572  // Hillside Entrance
+	OBJECT_TREE_573                       = 573  // tree
+	OBJECT_LOG_BRIDGE_574                 = 574  // Log bridge
+	OBJECT_TREE_PLATFORM_575              = 575  // Tree platform
+	OBJECT_TREE_PLATFORM_576              = 576  // Tree platform
+	OBJECT_METALIC_DUNGEON_GATE           = 577  // Metalic Dungeon Gate
+	OBJECT_LOG_BRIDGE_578                 = 578  // Log bridge
+	OBJECT_LOG_BRIDGE_579                 = 579  // Log bridge
+	OBJECT_WATCH_TOWER_580                = 580  // Watch tower
+	OBJECT_WATCH_TOWER_581                = 581  // Watch tower
+	OBJECT_SHALLOW_WATER                  = 582  // Shallow water
+	OBJECT_DOORS_583                      = 583  // Doors
+	OBJECT_GRAND_TREE_584                 = 584  // grand tree
+	OBJECT_TREE_LADDER                    = 585  // Tree Ladder
+	OBJECT_TREE_LADDER_586                = 586  // Tree Ladder
+	OBJECT_BLURBERRYS_COCKTAIL_BAR        = 587  // blurberrys cocktail bar
+	OBJECT_GEM_ROCKS                      = 588  // Gem Rocks
+	OBJECT_GIANNES_PLACE                  = 589  // Giannes place
+	OBJECT_ROPESWING                      = 590  // ropeswing
+	OBJECT_NET                            = 591  // net
+	OBJECT_FRAME                          = 592  // Frame
+	OBJECT_TREE_593                       = 593  // Tree
+	OBJECT_TREE_594                       = 594  // Tree
+	OBJECT_TREE_595                       = 595  // Tree
+	OBJECT_CART_596                       = 596  // cart
+	OBJECT_FENCE                          = 597  // fence
+	OBJECT_BEAM                           = 598  // beam
+	OBJECT_SIGN_599                       = 599  // Sign
+	OBJECT_SIGN_600                       = 600  // Sign
+	OBJECT_SIGN_601                       = 601  // Sign
+	OBJECT_SIGN_602                       = 602  // Sign
+	OBJECT_SIGN_603                       = 603  // Sign
+	OBJECT_SIGN_604                       = 604  // Sign
+	OBJECT_SIGN_605                       = 605  // Sign
+	OBJECT_SIGN_606                       = 606  // Sign
+	OBJECT_SIGN_607                       = 607  // Sign
+	OBJECT_SIGN_608                       = 608  // Sign
+	OBJECT_ROOT                           = 609  // Root
+	OBJECT_ROOT_610                       = 610  // Root
+	OBJECT_METAL_GATE                     = 611  // Metal Gate
+	OBJECT_METAL_GATE_612                 = 612  // Metal Gate
+	OBJECT_A_FARM_CART                    = 613  // A farm cart
+	OBJECT_LEDGE_614                      = 614  // Ledge
+	OBJECT_LEDGE_615                      = 615  // Ledge
+	OBJECT_LADDER_616                     = 616  // Ladder
+	OBJECT_CAGE                           = 617  // cage
+	OBJECT_GLIDER                         = 618  // glider
+	OBJECT_CUPBOARD_619                   = 619  // cupboard
+	OBJECT_CUPBOARD_620                   = 620  // cupboard
+	OBJECT_STAIRS_621                     = 621  // stairs
+	OBJECT_GLIDER_622                     = 622  // glider
+	OBJECT_GATE_623                       = 623  // gate
+	OBJECT_GATE_624                       = 624  // gate
+	OBJECT_CHAOS_ALTAR                    = 625  // chaos altar
+	OBJECT_GNOME_STRONGHOLD_GATE          = 626  // Gnome stronghold gate
+	OBJECT_ROPESWING_627                  = 627  // ropeswing
+	OBJECT_ROPESWING_628                  = 628  // ropeswing
+	OBJECT_STAIRS_629                     = 629  // stairs
+	OBJECT_STAIRS_630                     = 630  // stairs
+	OBJECT_CHEST_631                      = 631  // Chest
+	OBJECT_CHEST_632                      = 632  // Chest
+	OBJECT_PILE_OF_RUBBLE                 = 633  // Pile of rubble
+	OBJECT_STONE_STAND_634                = 634  // Stone stand
+	OBJECT_WATCH_TOWER_635                = 635  // Watch tower
+	OBJECT_PILE_OF_RUBBLE_636             = 636  // Pile of rubble
+	OBJECT_ROOT_637                       = 637  // Root
+	OBJECT_ROOT_638                       = 638  // Root
+	OBJECT_ROOT_639                       = 639  // Root
+	OBJECT_SIGN_640                       = 640  // Sign
+	OBJECT_HAMMOCK                        = 641  // Hammock
+	OBJECT_GOAL                           = 642  // Goal
+	OBJECT_STONE_TILE                     = 643  // stone tile
+	OBJECT_CHEST_644                      = 644  // Chest
+	OBJECT_CHEST_645                      = 645  // Chest
+	OBJECT_WATCH_TOWER_646                = 646  // Watch tower
+	OBJECT_NET_647                        = 647  // net
+	OBJECT_WATCH_TOWER_648                = 648  // Watch tower
+	OBJECT_WATCH_TOWER_649                = 649  // Watch tower
+	OBJECT_ROPESWING_650                  = 650  // ropeswing
+	OBJECT_BUMPY_DIRT                     = 651  // Bumpy Dirt
+	OBJECT_PIPE_652                       = 652  // pipe
+	OBJECT_NET_653                        = 653  // net
+	OBJECT_PIPE_654                       = 654  // pipe
+	OBJECT_LOG_655                        = 655  // log
+	OBJECT_PIPE_656                       = 656  // pipe
+	OBJECT_PIPE_657                       = 657  // pipe
+	OBJECT_HANDHOLDS                      = 658  // Handholds
+	OBJECT_LADDER_659                     = 659  // Ladder
+	OBJECT_GATE_660                       = 660  // gate
+	OBJECT_STRONGHOLD_SPIRIT_TREE         = 661  // stronghold spirit Tree
+	OBJECT_TREE_662                       = 662  // Tree
+	OBJECT_TREE_663                       = 663  // Tree
+	OBJECT_TREE_664                       = 664  // Tree
+	OBJECT_SPIKED_PIT                     = 665  // Spiked pit
+	OBJECT_SPIKED_PIT_666                 = 666  // Spiked pit
+	OBJECT_CAVE                           = 667  // Cave
+	OBJECT_STONE_PEBBLE                   = 668  // stone pebble
+	OBJECT_PILE_OF_RUBBLE_669             = 669  // Pile of rubble
+	OBJECT_PILE_OF_RUBBLE_670             = 670  // Pile of rubble
+	OBJECT_PIPE_671                       = 671  // pipe
+	OBJECT_PIPE_672                       = 672  // pipe
+	OBJECT_STONE                          = 673  // Stone
+	OBJECT_STONE_674                      = 674  // Stone
+	OBJECT_ROPESWING_675                  = 675  // ropeswing
+	OBJECT_LOG_676                        = 676  // log
+	OBJECT_NET_677                        = 677  // net
+	OBJECT_LEDGE_678                      = 678  // Ledge
+	OBJECT_HANDHOLDS_679                  = 679  // Handholds
+	OBJECT_LOG_680                        = 680  // log
+	OBJECT_LOG_681                        = 681  // log
+	OBJECT_ROTTEN_GALLOWS                 = 682  // Rotten Gallows
+	OBJECT_PILE_OF_RUBBLE_683             = 683  // Pile of rubble
+	OBJECT_ROPESWING_684                  = 684  // ropeswing
+	OBJECT_ROPESWING_685                  = 685  // ropeswing
+	OBJECT_OCKS                           = 686  // ocks
+	OBJECT_TREE_687                       = 687  // Tree
+	OBJECT_WELL_STACKED_ROCKS             = 688  // Well stacked rocks
+	OBJECT_TOMB_DOLMEN                    = 689  // Tomb Dolmen
+	OBJECT_HANDHOLDS_690                  = 690  // Handholds
+	OBJECT_BRIDGE_BLOCKADE                = 691  // Bridge Blockade
+	OBJECT_LOG_BRIDGE_692                 = 692  // Log Bridge
+	OBJECT_HANDHOLDS_693                  = 693  // Handholds
+	OBJECT_TREE_694                       = 694  // Tree
+	OBJECT_TREE_695                       = 695  // Tree
+	OBJECT_WET_ROCKS                      = 696  // Wet rocks
+	OBJECT_SMASHED_TABLE_697              = 697  // Smashed table
+	OBJECT_CRUDE_RAFT                     = 698  // Crude Raft
+	OBJECT_DACONIA_ROCK                   = 699  // Daconia rock
+	OBJECT_STATUE_700                     = 700  // statue
+	OBJECT_STEPPING_STONES                = 701  // Stepping stones
+	OBJECT_GATE_702                       = 702  // gate
+	OBJECT_GATE_703                       = 703  // gate
+	OBJECT_GATE_704                       = 704  // gate
+	OBJECT_PIPE_705                       = 705  // pipe
+	OBJECT_ROPESWING_706                  = 706  // ropeswing
+	OBJECT_STONE_707                      = 707  // Stone
+	OBJECT_LEDGE_708                      = 708  // Ledge
+	OBJECT_VINE_709                       = 709  // Vine
+	OBJECT_ROCKS_710                      = 710  // Rocks
+	OBJECT_WOODEN_GATE                    = 711  // Wooden Gate
+	OBJECT_WOODEN_GATE_712                = 712  // Wooden Gate
+	OBJECT_STONE_BRIDGE                   = 713  // Stone bridge
+	OBJECT_STONE_BRIDGE_714               = 714  // Stone bridge
+	OBJECT_STONE_BRIDGE_715               = 715  // Stone bridge
+	OBJECT_STONE_BRIDGE_716               = 716  // Stone bridge
+	OBJECT_STONE_PLATFORM                 = 717  // Stone platform
+	OBJECT_FENCE_718                      = 718  // fence
+	OBJECT_ROCKS_719                      = 719  // Rocks
+	OBJECT_STONE_BRIDGE_720               = 720  // Stone bridge
+	OBJECT_STONE_BRIDGE_721               = 721  // Stone bridge
+	OBJECT_GATE_OF_IBAN                   = 722  // Gate of Iban
+	OBJECT_WOODEN_DOOR                    = 723  // Wooden Door
+	OBJECT_TOMB_DOLMEN_724                = 724  // Tomb Dolmen
+	OBJECT_CAVE_ENTRANCE_725              = 725  // Cave entrance
+	OBJECT_OLD_BRIDGE                     = 726  // Old bridge
+	OBJECT_OLD_BRIDGE_727                 = 727  // Old bridge
+	OBJECT_CRUMBLED_ROCK                  = 728  // Crumbled rock
+	OBJECT_STALAGMITE                     = 729  // stalagmite
+	OBJECT_STALAGMITE_730                 = 730  // stalagmite
+	OBJECT_ROCKS_731                      = 731  // Rocks
+	OBJECT_LEDGE_732                      = 732  // Ledge
+	OBJECT_LEVER_733                      = 733  // Lever
+	OBJECT_STALACTITE                     = 734  // stalactite
+	OBJECT_STALACTITE_735                 = 735  // stalactite
+	OBJECT_STALACTITE_736                 = 736  // stalactite
+	OBJECT_ROCKS_737                      = 737  // Rocks
+	OBJECT_ROCKS_738                      = 738  // Rocks
+	OBJECT_ROCKS_739                      = 739  // Rocks
+	OBJECT_ROCKS_740                      = 740  // Rocks
+	OBJECT_ROCKS_741                      = 741  // Rocks
+	OBJECT_ROCKS_742                      = 742  // Rocks
+	OBJECT_ROCKS_743                      = 743  // Rocks
+	OBJECT_ROCKS_744                      = 744  // Rocks
+	OBJECT_ROCKS_745                      = 745  // Rocks
+	OBJECT_ROCKS_746                      = 746  // Rocks
+	OBJECT_ROCKS_747                      = 747  // Rocks
+	OBJECT_ROCKS_748                      = 748  // Rocks
+	OBJECT_ROCKS_749                      = 749  // Rocks
+	OBJECT_LEDGE_750                      = 750  // Ledge
+	OBJECT_LEDGE_751                      = 751  // Ledge
+	OBJECT_LEDGE_752                      = 752  // Ledge
+	OBJECT_LEDGE_753                      = 753  // Ledge
+	OBJECT_SWAMP                          = 754  // Swamp
+	OBJECT_SWAMP_755                      = 755  // Swamp
+	OBJECT_ROCKS_756                      = 756  // Rocks
+	OBJECT_ROCKS_757                      = 757  // Rocks
+	OBJECT_ROCKS_758                      = 758  // Rocks
+	OBJECT_ROCKS_759                      = 759  // Rocks
+	OBJECT_ROCKS_760                      = 760  // Rocks
+	OBJECT_ROCKS_761                      = 761  // Rocks
+	OBJECT_ROCKS_762                      = 762  // Rocks
+	OBJECT_ROCKS_763                      = 763  // Rocks
+	OBJECT_ROCKS_764                      = 764  // Rocks
+	OBJECT_ROCKS_765                      = 765  // Rocks
+	OBJECT_ROCKS_766                      = 766  // Rocks
+	OBJECT_PILE_OF_MUD_767                = 767  // Pile of mud
+	OBJECT_TRAVEL_CART                    = 768  // Travel Cart
+	OBJECT_TRAVEL_CART_769                = 769  // Travel Cart
+	OBJECT_ROCKS_770                      = 770  // Rocks
+	OBJECT_STALACTITE_771                 = 771  // stalactite
+	OBJECT_ROCKS_772                      = 772  // Rocks
+	OBJECT_ROCKS_773                      = 773  // Rocks
+	OBJECT_ROCKS_774                      = 774  // Rocks
+	OBJECT_SIGN_775                       = 775  // sign
+	OBJECT_LADDER_776                     = 776  // Ladder
+	OBJECT_GRILL_777                      = 777  // Grill
+	OBJECT_SPIKED_PIT_778                 = 778  // Spiked pit
+	OBJECT_SIGNPOST_779                   = 779  // signpost
+	OBJECT_SHIP_780                       = 780  // Ship
+	OBJECT_SHIP_781                       = 781  // Ship
+	OBJECT_GRILL_782                      = 782  // Grill
+	OBJECT_SACKS_783                      = 783  // sacks
+	OBJECT_ZAMORAKIAN_TEMPLE              = 784  // Zamorakian Temple
+	OBJECT_GRILL_785                      = 785  // Grill
+	OBJECT_GRILL_786                      = 786  // Grill
+	OBJECT_GRILL_787                      = 787  // Grill
+	OBJECT_GRILL_788                      = 788  // Grill
+	OBJECT_GRILL_789                      = 789  // Grill
+	OBJECT_GRILL_790                      = 790  // Grill
+	OBJECT_GRILL_791                      = 791  // Grill
+	OBJECT_ROCKS_792                      = 792  // Rocks
+	OBJECT_ROCKS_793                      = 793  // Rocks
+	OBJECT_TOMB_DOORS                     = 794  // Tomb Doors
+	OBJECT_SWAMP_795                      = 795  // Swamp
+	OBJECT_ROCKS_796                      = 796  // Rocks
+	OBJECT_ROCKS_797                      = 797  // Rocks
+	OBJECT_STALACTITE_798                 = 798  // stalactite
+	OBJECT_STALACTITE_799                 = 799  // stalactite
+	OBJECT_SPIKED_PIT_800                 = 800  // Spiked pit
+	OBJECT_LEVER_801                      = 801  // Lever
+	OBJECT_CAGE_802                       = 802  // Cage
+	OBJECT_CAGE_803                       = 803  // Cage
+	OBJECT_ROCKS_804                      = 804  // Rocks
+	OBJECT_SPEAR_TRAP                     = 805  // Spear trap
+	OBJECT_ROCKS_806                      = 806  // Rocks
+	OBJECT_ROCKS_807                      = 807  // Rocks
+	OBJECT_ROCKS_808                      = 808  // Rocks
+	OBJECT_ROCKS_809                      = 809  // Rocks
+	OBJECT_ROCKS_810                      = 810  // Rocks
+	OBJECT_ROCKS_811                      = 811  // Rocks
+	OBJECT_LEDGE_812                      = 812  // Ledge
+	OBJECT_FURNACE_813                    = 813  // Furnace
+	OBJECT_WELL_814                       = 814  // Well
+	OBJECT_PASSAGE                        = 815  // Passage
+	OBJECT_PASSAGE_816                    = 816  // Passage
+	OBJECT_PASSAGE_817                    = 817  // Passage
+	OBJECT_STALAGMITE_818                 = 818  // stalagmite
+	OBJECT_ROCKS_819                      = 819  // Rocks
+	OBJECT_ROCKS_820                      = 820  // Rocks
+	OBJECT_ROCKS_821                      = 821  // Rocks
+	OBJECT_ROCKS_822                      = 822  // Rocks
+	OBJECT_ROCKS_823                      = 823  // Rocks
+	OBJECT_ROCKS_824                      = 824  // Rocks
+	OBJECT_PASSAGE_825                    = 825  // Passage
+	OBJECT_SNAP_TRAP                      = 826  // snap trap
+	OBJECT_WOODEN_PLANKS                  = 827  // Wooden planks
+	OBJECT_PASSAGE_828                    = 828  // Passage
+	OBJECT_PASSAGE_829                    = 829  // Passage
+	OBJECT_FLAMES_OF_ZAMORAK              = 830  // Flames of zamorak
+	OBJECT_PLATFORM                       = 831  // Platform
+	OBJECT_ROCK_832                       = 832  // Rock
+	OBJECT_ROCK_833                       = 833  // Rock
+	OBJECT_ROCK_834                       = 834  // Rock
+	OBJECT_ROCK_835                       = 835  // Rock
+	OBJECT_WALL_GRILL                     = 836  // wall grill
+	OBJECT_LEDGE_837                      = 837  // Ledge
+	OBJECT_WALL_GRILL_838                 = 838  // wall grill
+	OBJECT_DUG_UP_SOIL_839                = 839  // Dug up soil
+	OBJECT_DUG_UP_SOIL_840                = 840  // Dug up soil
+	OBJECT_PILE_OF_MUD_841                = 841  // Pile of mud
+	OBJECT_STALAGMITE_842                 = 842  // stalagmite
+	OBJECT_PILE_OF_MUD_843                = 843  // Pile of mud
+	OBJECT_PILE_OF_MUD_844                = 844  // Pile of mud
+	OBJECT_PILE_OF_MUD_845                = 845  // Pile of mud
+	OBJECT_PILE_OF_MUD_846                = 846  // Pile of mud
+	OBJECT_PILE_OF_MUD_847                = 847  // Pile of mud
+	OBJECT_SPIKED_PIT_848                 = 848  // Spiked pit
+	OBJECT_ROCKS_849                      = 849  // Rocks
+	OBJECT_ROCKS_850                      = 850  // Rocks
+	OBJECT_ROCKS_851                      = 851  // Rocks
+	OBJECT_ROCKS_852                      = 852  // Rocks
+	OBJECT_ROCKS_853                      = 853  // Rocks
+	OBJECT_ROCKS_854                      = 854  // Rocks
+	OBJECT_ROCKS_855                      = 855  // Rocks
+	OBJECT_ROCKS_856                      = 856  // Rocks
+	OBJECT_ROCKS_857                      = 857  // Rocks
+	OBJECT_ROCKS_858                      = 858  // Rocks
+	OBJECT_ROCKS_859                      = 859  // Rocks
+	OBJECT_ROCKS_860                      = 860  // Rocks
+	OBJECT_LEDGE_861                      = 861  // Ledge
+	OBJECT_LEDGE_862                      = 862  // Ledge
+	OBJECT_LEDGE_863                      = 863  // Ledge
+	OBJECT_LEDGE_864                      = 864  // Ledge
+	OBJECT_LEDGE_865                      = 865  // Ledge
+	OBJECT_LEDGE_866                      = 866  // Ledge
+	OBJECT_BOULDER                        = 867  // Boulder
+	OBJECT_CRATE_868                      = 868  // crate
+	OBJECT_DOOR                           = 869  // Door
+	OBJECT_PLATFORM_870                   = 870  // Platform
+	OBJECT_CAGE_REMAINS                   = 871  // Cage remains
+	OBJECT_LEDGE_872                      = 872  // Ledge
+	OBJECT_PASSAGE_873                    = 873  // Passage
+	OBJECT_PASSAGE_874                    = 874  // Passage
+	OBJECT_GATE_OF_ZAMORAK                = 875  // Gate of Zamorak
+	OBJECT_ROCKS_876                      = 876  // Rocks
+	OBJECT_BRIDGE_SUPPORT                 = 877  // Bridge support
+	OBJECT_TOMB_OF_IBAN                   = 878  // Tomb of Iban
+	OBJECT_CLAWS_OF_IBAN                  = 879  // Claws of Iban
+	OBJECT_BARREL_880                     = 880  // barrel
+	OBJECT_ROCK_881                       = 881  // Rock
+	OBJECT_ROCKS_882                      = 882  // Rocks
+	OBJECT_ROCKS_883                      = 883  // Rocks
+	OBJECT_SWAMP_884                      = 884  // Swamp
+	OBJECT_CHEST_885                      = 885  // Chest
+	OBJECT_STONE_BRIDGE_886               = 886  // Stone bridge
+	OBJECT_CAGE_887                       = 887  // cage
+	OBJECT_CAGE_888                       = 888  // cage
+	OBJECT_STONE_STEPS                    = 889  // Stone steps
+	OBJECT_PILE_OF_MUD_890                = 890  // Pile of mud
+	OBJECT_STONE_BRIDGE_891               = 891  // Stone bridge
+	OBJECT_STONE_BRIDGE_892               = 892  // Stone bridge
+	OBJECT_STONE_BRIDGE_893               = 893  // Stone bridge
+	OBJECT_STONE_BRIDGE_894               = 894  // Stone bridge
+	OBJECT_STONE_BRIDGE_895               = 895  // Stone bridge
+	OBJECT_STONE_BRIDGE_896               = 896  // Stone bridge
+	OBJECT_STONE_BRIDGE_897               = 897  // Stone bridge
+	OBJECT_STONE_BRIDGE_898               = 898  // Stone bridge
+	OBJECT_STONE_BRIDGE_899               = 899  // Stone bridge
+	OBJECT_STONE_BRIDGE_900               = 900  // Stone bridge
+	OBJECT_STONE_BRIDGE_901               = 901  // Stone bridge
+	OBJECT_STONE_BRIDGE_902               = 902  // Stone bridge
+	OBJECT_STONE_BRIDGE_903               = 903  // Stone bridge
+	OBJECT_STONE_BRIDGE_904               = 904  // Stone bridge
+	OBJECT_STONE_BRIDGE_905               = 905  // Stone bridge
+	OBJECT_STONE_BRIDGE_906               = 906  // Stone bridge
+	OBJECT_STONE_BRIDGE_907               = 907  // Stone bridge
+	OBJECT_STONE_BRIDGE_908               = 908  // Stone bridge
+	OBJECT_STONE_BRIDGE_909               = 909  // Stone bridge
+	OBJECT_STONE_BRIDGE_910               = 910  // Stone bridge
+	OBJECT_CHEST_911                      = 911  // Chest
+	OBJECT_CHEST_912                      = 912  // Chest
+	OBJECT_PIT_OF_THE_DAMNED              = 913  // Pit of the Damned
+	OBJECT_OPEN_DOOR                      = 914  // Open Door
+	OBJECT_SIGNPOST_915                   = 915  // signpost
+	OBJECT_STONE_GATE                     = 916  // Stone Gate
+	OBJECT_CHEST_917                      = 917  // Chest
+	OBJECT_ZODIAC                         = 918  // Zodiac
+	OBJECT_CHEST_919                      = 919  // Chest
+	OBJECT_LADDER_920                     = 920  // Ladder
+	OBJECT_STONE_STEPS_921                = 921  // Stone steps
+	OBJECT_ROCK_922                       = 922  // Rock
+	OBJECT_ROCK_923                       = 923  // Rock
+	OBJECT_ROCK_924                       = 924  // Rock
+	OBJECT_TELESCOPE                      = 925  // Telescope
+	OBJECT_GATE_926                       = 926  // Gate
+	OBJECT_SACKS_927                      = 927  // sacks
+	OBJECT_LADDER_928                     = 928  // Ladder
+	OBJECT_CHEST_929                      = 929  // Chest
+	OBJECT_CHEST_930                      = 930  // Chest
+	OBJECT_BOOKCASE_931                   = 931  // Bookcase
+	OBJECT_IRON_GATE                      = 932  // Iron Gate
+	OBJECT_LADDER_933                     = 933  // Ladder
+	OBJECT_CHEST_934                      = 934  // Chest
+	OBJECT_CHEST_935                      = 935  // Chest
+	OBJECT_CHEST_936                      = 936  // Chest
+	OBJECT_CHEST_937                      = 937  // Chest
+	OBJECT_ROCKSLIDE_938                  = 938  // Rockslide
+	OBJECT_ALTAR_939                      = 939  // Altar
+	OBJECT_COLUMN                         = 940  // column
+	OBJECT_GRAVE_OF_SCORPIUS              = 941  // Grave of Scorpius
+	OBJECT_BANK_CHEST                     = 942  // Bank Chest
+	OBJECT_DWARF_MULTICANNON              = 943  // dwarf multicannon
+	OBJECT_DISTURBED_SAND                 = 944  // Disturbed sand
+	OBJECT_DISTURBED_SAND_945             = 945  // Disturbed sand
+	OBJECT_DWARF_MULTICANNON_BASE         = 946  // dwarf multicannon base
+	OBJECT_DWARF_MULTICANNON_STAND        = 947  // dwarf multicannon stand
+	OBJECT_DWARF_MULTICANNON_BARRELS      = 948  // dwarf multicannon barrels
+	OBJECT_CAVE_949                       = 949  // Cave
+	OBJECT_CAVE_950                       = 950  // Cave
+	OBJECT_FENCE_951                      = 951  // fence
+	OBJECT_SIGNPOST_952                   = 952  // signpost
+	OBJECT_ROCKS_953                      = 953  // Rocks
+	OBJECT_ROCKS_954                      = 954  // Rocks
+	OBJECT_CAVE_ENTRANCE_955              = 955  // Cave entrance
+	OBJECT_CHEST_956                      = 956  // Chest
+	OBJECT_CHEST_957                      = 957  // Chest
+	OBJECT_WOODEN_DOORS                   = 958  // Wooden Doors
+	OBJECT_PEDESTAL                       = 959  // Pedestal
+	OBJECT_BUSH_960                       = 960  // bush
+	OBJECT_BUSH_961                       = 961  // bush
+	OBJECT_STANDARD                       = 962  // Standard
+	OBJECT_MINING_CAVE                    = 963  // Mining Cave
+	OBJECT_MINING_CAVE_964                = 964  // Mining Cave
+	OBJECT_ROCKS_965                      = 965  // Rocks
+	OBJECT_LIFT                           = 966  // Lift
+	OBJECT_MINING_BARREL                  = 967  // Mining Barrel
+	OBJECT_HOLE_968                       = 968  // Hole
+	OBJECT_HOLE_969                       = 969  // Hole
+	OBJECT_CAVE_970                       = 970  // Cave
+	OBJECT_CAVE_971                       = 971  // Cave
+	OBJECT_CAVE_972                       = 972  // Cave
+	OBJECT_COUNTER_973                    = 973  // Counter
+	OBJECT_TRACK_974                      = 974  // Track
+	OBJECT_CAVE_975                       = 975  // Cave
+	OBJECT_MINE_CART_976                  = 976  // Mine Cart
+	OBJECT_LIFT_PLATFORM                  = 977  // Lift Platform
+	OBJECT_CHEST_978                      = 978  // Chest
+	OBJECT_CHEST_979                      = 979  // Chest
+	OBJECT_WATCH_TOWER_980                = 980  // Watch tower
+	OBJECT_LADDER_981                     = 981  // Ladder
+	OBJECT_CAVE_ENTRANCE_982              = 982  // Cave entrance
+	OBJECT_PILE_OF_MUD_983                = 983  // Pile of mud
+	OBJECT_CAVE_984                       = 984  // Cave
+	OBJECT_LADDER_985                     = 985  // Ladder
+	OBJECT_CRATE_986                      = 986  // crate
+	OBJECT_CRATE_987                      = 987  // crate
+	OBJECT_GATE_988                       = 988  // Gate
+	OBJECT_GATE_989                       = 989  // Gate
+	OBJECT_BUSH_990                       = 990  // bush
+	OBJECT_BUSH_991                       = 991  // bush
+	OBJECT_BUSH_992                       = 992  // bush
+	OBJECT_BUSH_993                       = 993  // bush
+	OBJECT_MULTICANNON                    = 994  // multicannon
+	OBJECT_ROCKS_995                      = 995  // Rocks
+	OBJECT_ROCKS_996                      = 996  // Rocks
+	OBJECT_LADDER_997                     = 997  // Ladder
+	OBJECT_CAVE_ENTRANCE_998              = 998  // Cave entrance
+	OBJECT_COUNTER_999                    = 999  // Counter
+	OBJECT_CHEST_1000                     = 1000 // Chest
+	OBJECT_CHEST_1001                     = 1001 // Chest
+	OBJECT_CHEST_1002                     = 1002 // Chest
+	OBJECT_CHEST_1003                     = 1003 // Chest
+	OBJECT_BOOKCASE_1004                  = 1004 // Bookcase
+	OBJECT_CAPTAINS_CHEST                 = 1005 // Captains Chest
+	OBJECT_EXPERIMENTAL_ANVIL             = 1006 // Experimental Anvil
+	OBJECT_ROCKS_1007                     = 1007 // Rocks
+	OBJECT_ROCKS_1008                     = 1008 // Rocks
+	OBJECT_COLUMN_1009                    = 1009 // Column
+	OBJECT_WALL_1010                      = 1010 // Wall
+	OBJECT_WALL_1011                      = 1011 // Wall
+	OBJECT_WALL_1012                      = 1012 // Wall
+	OBJECT_WALL_1013                      = 1013 // Wall
+	OBJECT_LEVER_1014                     = 1014 // Lever
+	OBJECT_LEVER_1015                     = 1015 // Lever
+	OBJECT_WALL_1016                      = 1016 // Wall
+	OBJECT_LADDER_1017                    = 1017 // Ladder
+	OBJECT_WALL_1018                      = 1018 // Wall
+	OBJECT_GATE_1019                      = 1019 // Gate
+	OBJECT_GATE_1020                      = 1020 // Gate
+	OBJECT_LADDER_1021                    = 1021 // Ladder
+	OBJECT_SHOCK                          = 1022 // shock
+	OBJECT_DESK                           = 1023 // Desk
+	OBJECT_CAVE_1024                      = 1024 // Cave
+	OBJECT_MINING_CART                    = 1025 // Mining Cart
+	OBJECT_ROCK_OF_DALGROTH               = 1026 // Rock of Dalgroth
+	OBJECT_ENTRANCE                       = 1027 // entrance
+	OBJECT_DRIED_CACTUS                   = 1028 // Dried Cactus
+	OBJECT_CLIMBING_ROCKS_1029            = 1029 // climbing rocks
+	OBJECT_ROCKS_1030                     = 1030 // Rocks
+	OBJECT_LIGHTNING                      = 1031 // lightning
+	OBJECT_CRUDE_DESK                     = 1032 // Crude Desk
+	OBJECT_HEAVY_METAL_GATE               = 1033 // Heavy Metal Gate
+	OBJECT_COUNTER_1034                   = 1034 // Counter
+	OBJECT_CRUDE_BED                      = 1035 // Crude bed
+	OBJECT_FLAMES                         = 1036 // flames
+	OBJECT_CARVED_ROCK                    = 1037 // Carved Rock
+	OBJECT_USE                            = 1038 // USE
+	OBJECT_CRATE_1039                     = 1039 // crate
+	OBJECT_CRATE_1040                     = 1040 // crate
+	OBJECT_BARREL_1041                    = 1041 // barrel
+	OBJECT_BRICK                          = 1042 // Brick
+	OBJECT_BRICK_1043                     = 1043 // Brick
+	OBJECT_BRICK_1044                     = 1044 // Brick
+	OBJECT_BRICK_1045                     = 1045 // Brick
+	OBJECT_BRICK_1046                     = 1046 // Brick
+	OBJECT_BRICK_1047                     = 1047 // Brick
+	OBJECT_BARRIER                        = 1048 // Barrier
+	OBJECT_BURIED_SKELETON                = 1049 // buried skeleton
+	OBJECT_BRICK_1050                     = 1050 // Brick
+	OBJECT_BRICK_1051                     = 1051 // Brick
+	OBJECT_SPECIMEN_TRAY                  = 1052 // Specimen tray
+	OBJECT_WINCH                          = 1053 // winch
+	OBJECT_CRATE_1054                     = 1054 // crate
+	OBJECT_CRATE_1055                     = 1055 // crate
+	OBJECT_URN                            = 1056 // Urn
+	OBJECT_BURIED_SKELETON_1057           = 1057 // buried skeleton
+	OBJECT_PANNING_POINT                  = 1058 // panning point
+	OBJECT_ROCKS_1059                     = 1059 // Rocks
+	OBJECT_SIGNPOST_1060                  = 1060 // signpost
+	OBJECT_SIGNPOST_1061                  = 1061 // signpost
+	OBJECT_SIGNPOST_1062                  = 1062 // signpost
+	OBJECT_SIGNPOST_1063                  = 1063 // signpost
+	OBJECT_SIGNPOST_1064                  = 1064 // signpost
+	OBJECT_SOIL                           = 1065 // soil
+	OBJECT_SOIL_1066                      = 1066 // soil
+	OBJECT_SOIL_1067                      = 1067 // soil
+	OBJECT_GATE_1068                      = 1068 // Gate
+	OBJECT_SHIP_1069                      = 1069 // ship
+	OBJECT_BARREL_1070                    = 1070 // barrel
+	OBJECT_LEAK                           = 1071 // Leak
+	OBJECT_BUSH_1072                      = 1072 // bush
+	OBJECT_BUSH_1073                      = 1073 // bush
+	OBJECT_CUPBOARD_1074                  = 1074 // cupboard
+	OBJECT_SACKS_1075                     = 1075 // sacks
+	OBJECT_SACKS_1076                     = 1076 // sacks
+	OBJECT_LEAK_1077                      = 1077 // Leak
+	OBJECT_CUPBOARD_1078                  = 1078 // cupboard
+	OBJECT_WROUGHT_MITHRIL_GATES          = 1079 // Wrought Mithril Gates
+	OBJECT_LEGENDS_HALL_DOORS             = 1080 // Legends Hall Doors
+	OBJECT_CAMP_BED                       = 1081 // Camp bed
+	OBJECT_BARREL_1082                    = 1082 // barrel
+	OBJECT_BARREL_1083                    = 1083 // barrel
+	OBJECT_CHEST_1084                     = 1084 // Chest
+	OBJECT_CHEST_1085                     = 1085 // Chest
+	OBJECT_DENSE_JUNGLE_TREE              = 1086 // Dense Jungle Tree
+	OBJECT_JUNGLE_TREE_STUMP              = 1087 // Jungle tree stump
+	OBJECT_SIGNPOST_1088                  = 1088 // signpost
+	OBJECT_GATE_1089                      = 1089 // gate
+	OBJECT_BOOKCASE_1090                  = 1090 // Bookcase
+	OBJECT_DENSE_JUNGLE_TREE_1091         = 1091 // Dense Jungle Tree
+	OBJECT_DENSE_JUNGLE_TREE_1092         = 1092 // Dense Jungle Tree
+	OBJECT_SPRAY                          = 1093 // Spray
+	OBJECT_SPRAY_1094                     = 1094 // Spray
+	OBJECT_WINCH_1095                     = 1095 // winch
+	OBJECT_BRICK_1096                     = 1096 // Brick
+	OBJECT_ROPE                           = 1097 // Rope
+	OBJECT_ROPE_1098                      = 1098 // Rope
+	OBJECT_DENSE_JUNGLE_PALM              = 1099 // Dense Jungle Palm
+	OBJECT_DENSE_JUNGLE_PALM_1100         = 1100 // Dense Jungle Palm
+	OBJECT_TRAWLER_NET                    = 1101 // Trawler net
+	OBJECT_TRAWLER_NET_1102               = 1102 // Trawler net
+	OBJECT_BRICK_1103                     = 1103 // Brick
+	OBJECT_CHEST_1104                     = 1104 // Chest
+	OBJECT_CHEST_1105                     = 1105 // Chest
+	OBJECT_TRAWLER_CATCH                  = 1106 // Trawler catch
+	OBJECT_YOMMI_TREE                     = 1107 // Yommi Tree
+	OBJECT_GROWN_YOMMI_TREE               = 1108 // Grown Yommi Tree
+	OBJECT_CHOPPED_YOMMI_TREE             = 1109 // Chopped Yommi Tree
+	OBJECT_TRIMMED_YOMMI_TREE             = 1110 // Trimmed Yommi Tree
+	OBJECT_TOTEM_POLE                     = 1111 // Totem Pole
+	OBJECT_BABY_YOMMI_TREE                = 1112 // Baby Yommi Tree
+	OBJECT_FERTILE_EARTH                  = 1113 // Fertile earth
+	OBJECT_ROCK_HEWN_STAIRS               = 1114 // Rock Hewn Stairs
+	OBJECT_HANGING_ROPE                   = 1115 // Hanging rope
+	OBJECT_ROCKS_1116                     = 1116 // Rocks
+	OBJECT_BOULDER_1117                   = 1117 // Boulder
+	OBJECT_DWARF_MULTICANNON_1118         = 1118 // dwarf multicannon
+	OBJECT_DWARF_MULTICANNON_BASE_1119    = 1119 // dwarf multicannon base
+	OBJECT_DWARF_MULTICANNON_STAND_1120   = 1120 // dwarf multicannon stand
+	OBJECT_DWARF_MULTICANNON_BARRELS_1121 = 1121 // dwarf multicannon barrels
+	OBJECT_ROCK_1122                      = 1122 // rock
+	OBJECT_ROCK_HEWN_STAIRS_1123          = 1123 // Rock Hewn Stairs
+	OBJECT_ROCK_HEWN_STAIRS_1124          = 1124 // Rock Hewn Stairs
+	OBJECT_ROCK_HEWN_STAIRS_1125          = 1125 // Rock Hewn Stairs
+	OBJECT_COMPOST_HEAP_1126              = 1126 // Compost Heap
+	OBJECT_BEEHIVE_1127                   = 1127 // beehive
+	OBJECT_DRAIN_1128                     = 1128 // Drain
+	OBJECT_WEB_1129                       = 1129 // web
+	OBJECT_FOUNTAIN_1130                  = 1130 // fountain
+	OBJECT_SINCLAIR_CREST                 = 1131 // Sinclair Crest
+	OBJECT_BARREL_1132                    = 1132 // barrel
+	OBJECT_BARREL_1133                    = 1133 // barrel
+	OBJECT_BARREL_1134                    = 1134 // barrel
+	OBJECT_BARREL_1135                    = 1135 // barrel
+	OBJECT_BARREL_1136                    = 1136 // barrel
+	OBJECT_BARREL_1137                    = 1137 // barrel
+	OBJECT_FLOUR_BARREL                   = 1138 // Flour Barrel
+	OBJECT_SACKS_1139                     = 1139 // sacks
+	OBJECT_GATE_1140                      = 1140 // gate
+	OBJECT_DEAD_YOMMI_TREE                = 1141 // Dead Yommi Tree
+	OBJECT_CLAWSPELL                      = 1142 // clawspell
+	OBJECT_ROCKS_1143                     = 1143 // Rocks
+	OBJECT_CRATE_1144                     = 1144 // crate
+	OBJECT_CAVERNOUS_OPENING              = 1145 // Cavernous Opening
+	OBJECT_ANCIENT_LAVA_FURNACE           = 1146 // Ancient Lava Furnace
+	OBJECT_SPELLCHARGE                    = 1147 // Spellcharge
+	OBJECT_ROCKS_1148                     = 1148 // Rocks
+	OBJECT_CUPBOARD_1149                  = 1149 // cupboard
+	OBJECT_SACKS_1150                     = 1150 // sacks
+	OBJECT_ROCK_1151                      = 1151 // Rock
+	OBJECT_SARADOMIN_STONE                = 1152 // Saradomin stone
+	OBJECT_GUTHIX_STONE                   = 1153 // Guthix stone
+	OBJECT_ZAMORAK_STONE                  = 1154 // Zamorak stone
+	OBJECT_MAGICAL_POOL                   = 1155 // Magical pool
+	OBJECT_WOODEN_BEAM                    = 1156 // Wooden Beam
+	OBJECT_ROPE_DOWN_INTO_DARKNESS        = 1157 // Rope down into darkness
+	OBJECT_CAVE_ENTRANCE_1158             = 1158 // Cave entrance
+	OBJECT_CAVE_ENTRANCE_1159             = 1159 // Cave entrance
+	OBJECT_ANCIENT_WOODEN_DOORS           = 1160 // Ancient Wooden Doors
+	OBJECT_TABLE_1161                     = 1161 // Table
+	OBJECT_CRUDE_BED_1162                 = 1162 // Crude bed
+	OBJECT_TALL_REEDS                     = 1163 // Tall Reeds
+	OBJECT_GOBLIN_FOOT_PRINTS             = 1164 // Goblin foot prints
+	OBJECT_DARK_METAL_GATE                = 1165 // Dark Metal Gate
+	OBJECT_MAGICAL_POOL_1166              = 1166 // Magical pool
+	OBJECT_ROPE_UP                        = 1167 // Rope Up
+	OBJECT_HALF_BURIED_REMAINS            = 1168 // Half buried remains
+	OBJECT_TOTEM_POLE_1169                = 1169 // Totem Pole
+	OBJECT_TOTEM_POLE_1170                = 1170 // Totem Pole
+	OBJECT_COMFY_BED                      = 1171 // Comfy bed
+	OBJECT_ROTTEN_YOMMI_TREE              = 1172 // Rotten Yommi Tree
+	OBJECT_ROTTEN_YOMMI_TREE_1173         = 1173 // Rotten Yommi Tree
+	OBJECT_ROTTEN_YOMMI_TREE_1174         = 1174 // Rotten Yommi Tree
+	OBJECT_ROTTEN_TOTEM_POLE              = 1175 // Rotten Totem Pole
+	OBJECT_LEAFY_PALM_TREE                = 1176 // Leafy Palm Tree
+	OBJECT_GRAND_VIZIERS_DESK             = 1177 // Grand Viziers Desk
+	OBJECT_STRANGE_BARREL                 = 1178 // Strange Barrel
+	OBJECT_SHIP_1179                      = 1179 // ship
+	OBJECT_SHIP_1180                      = 1180 // ship
+	OBJECT_SHIP_1181                      = 1181 // ship
+	OBJECT_DIGSITE_BED                    = 1182 // digsite bed
+	OBJECT_TEA_STALL                      = 1183 // Tea stall
+	OBJECT_BOULDER_1184                   = 1184 // Boulder
+	OBJECT_BOULDER_1185                   = 1185 // Boulder
+	OBJECT_DAMAGED_EARTH                  = 1186 // Damaged Earth
+	OBJECT_LADDER_1187                    = 1187 // Ladder
+	OBJECT_LADDER_1188                    = 1188 // Ladder
+)
+
+// Objects Maps the name of every scenery object constant, without any prefix, to its id.
+var Objects = map[string]int{
+	"TREE":                           OBJECT_TREE,
+	"TREE_1":                         OBJECT_TREE_1,
+	"WELL":                           OBJECT_WELL,
+	"TABLE":                          OBJECT_TABLE,
+	"TREESTUMP":                      OBJECT_TREESTUMP,
+	"LADDER":                         OBJECT_LADDER,
+	"LADDER_6":                       OBJECT_LADDER_6,
+	"CHAIR":                          OBJECT_CHAIR,
+	"LOGS":                           OBJECT_LOGS,
+	"LONGTABLE":                      OBJECT_LONGTABLE,
+	"THRONE":                         OBJECT_THRONE,
+	"RANGE":                          OBJECT_RANGE,
+	"GRAVESTONE":                     OBJECT_GRAVESTONE,
+	"GRAVESTONE_13":                  OBJECT_GRAVESTONE_13,
+	"BED":                            OBJECT_BED,
+	"BED_15":                         OBJECT_BED_15,
+	"BAR":                            OBJECT_BAR,
+	"CHEST":                          OBJECT_CHEST,
+	"CHEST_18":                       OBJECT_CHEST_18,
+	"ALTAR":                          OBJECT_ALTAR,
+	"POST":                           OBJECT_POST,
+	"SUPPORT":                        OBJECT_SUPPORT,
+	"BARREL":                         OBJECT_BARREL,
+	"BENCH":                          OBJECT_BENCH,
+	"PORTRAIT":                       OBJECT_PORTRAIT,
+	"CANDLES":                        OBJECT_CANDLES,
+	"FOUNTAIN":                       OBJECT_FOUNTAIN,
+	"LANDSCAPE":                      OBJECT_LANDSCAPE,
+	"MILLSTONES":                     OBJECT_MILLSTONES,
+	"COUNTER":                        OBJECT_COUNTER,
+	"STALL":                          OBJECT_STALL,
+	"TARGET":                         OBJECT_TARGET,
+	"PALMTREE":                       OBJECT_PALMTREE,
+	"PALMTREE_33":                    OBJECT_PALMTREE_33,
+	"FERN":                           OBJECT_FERN,
+	"CACTUS":                         OBJECT_CACTUS,
+	"BULLRUSHES":                     OBJECT_BULLRUSHES,
+	"FLOWER":                         OBJECT_FLOWER,
+	"MUSHROOM":                       OBJECT_MUSHROOM,
+	"COFFIN":                         OBJECT_COFFIN,
+	"COFFIN_40":                      OBJECT_COFFIN_40,
+	"STAIRS":                         OBJECT_STAIRS,
+	"STAIRS_42":                      OBJECT_STAIRS_42,
+	"STAIRS_43":                      OBJECT_STAIRS_43,
+	"STAIRS_44":                      OBJECT_STAIRS_44,
+	"RAILING":                        OBJECT_RAILING,
+	"PILLAR":                         OBJECT_PILLAR,
+	"BOOKCASE":                       OBJECT_BOOKCASE,
+	"SINK":                           OBJECT_SINK,
+	"DUMMY":                          OBJECT_DUMMY,
+	"ANVIL":                          OBJECT_ANVIL,
+	"TORCH":                          OBJECT_TORCH,
+	"HOPPER":                         OBJECT_HOPPER,
+	"CHUTE":                          OBJECT_CHUTE,
+	"CART":                           OBJECT_CART,
+	"SACKS":                          OBJECT_SACKS,
+	"CUPBOARD":                       OBJECT_CUPBOARD,
+	"GATE":                           OBJECT_GATE,
+	"GATE_58":                        OBJECT_GATE_58,
+	"GATE_59":                        OBJECT_GATE_59,
+	"GATE_60":                        OBJECT_GATE_60,
+	"SIGNPOST":                       OBJECT_SIGNPOST,
+	"SIGNPOST_62":                    OBJECT_SIGNPOST_62,
+	"DOORS":                          OBJECT_DOORS,
+	"DOORS_64":                       OBJECT_DOORS_64,
+	"SIGNPOST_65":                    OBJECT_SIGNPOST_65,
+	"SIGNPOST_66":                    OBJECT_SIGNPOST_66,
+	"BOOKCASE_67":                    OBJECT_BOOKCASE_67,
+	"HENGE":                          OBJECT_HENGE,
+	"DOLMEN":                         OBJECT_DOLMEN,
+	"TREE_70":                        OBJECT_TREE_70,
+	"CUPBOARD_71":                    OBJECT_CUPBOARD_71,
+	"WHEAT":                          OBJECT_WHEAT,
+	"SIGN":                           OBJECT_SIGN,
+	"SAILS":                          OBJECT_SAILS,
+	"SIGN_75":                        OBJECT_SIGN_75,
+	"SIGN_76":                        OBJECT_SIGN_76,
+	"DRAIN":                          OBJECT_DRAIN,
+	"MANHOLE":                        OBJECT_MANHOLE,
+	"MANHOLE_79":                     OBJECT_MANHOLE_79,
+	"PIPE":                           OBJECT_PIPE,
+	"CHEST_81":                       OBJECT_CHEST_81,
+	"CHEST_82":                       OBJECT_CHEST_82,
+	"BARREL_83":                      OBJECT_BARREL_83,
+	"CUPBOARD_84":                    OBJECT_CUPBOARD_84,
+	"CUPBOARD_85":                    OBJECT_CUPBOARD_85,
+	"FOUNTAIN_86":                    OBJECT_FOUNTAIN_86,
+	"SIGNPOST_87":                    OBJECT_SIGNPOST_87,
+	"TREE_88":                        OBJECT_TREE_88,
+	"SIGN_89":                        OBJECT_SIGN_89,
+	"SIGN_90":                        OBJECT_SIGN_90,
+	"SIGN_91":                        OBJECT_SIGN_91,
+	"SIGN_92":                        OBJECT_SIGN_92,
+	"GATE_93":                        OBJECT_GATE_93,
+	"GATE_94":                        OBJECT_GATE_94,
+	"SIGN_95":                        OBJECT_SIGN_95,
+	"SIGN_96":                        OBJECT_SIGN_96,
+	"FIRE":                           OBJECT_FIRE,
+	"ROCK":                           OBJECT_ROCK,
+	"ROCK_99":                        OBJECT_ROCK_99,
+	"ROCK_100":                       OBJECT_ROCK_100,
+	"ROCK_101":                       OBJECT_ROCK_101,
+	"ROCK_102":                       OBJECT_ROCK_102,
+	"ROCK_103":                       OBJECT_ROCK_103,
+	"ROCK_104":                       OBJECT_ROCK_104,
+	"ROCK_105":                       OBJECT_ROCK_105,
+	"ROCK_106":                       OBJECT_ROCK_106,
+	"ROCK_107":                       OBJECT_ROCK_107,
+	"ROCK_108":                       OBJECT_ROCK_108,
+	"ROCK_109":                       OBJECT_ROCK_109,
+	"ROCK_110":                       OBJECT_ROCK_110,
+	"ROCK_111":                       OBJECT_ROCK_111,
+	"ROCK_112":                       OBJECT_ROCK_112,
+	"ROCK_113":                       OBJECT_ROCK_113,
+	"ROCK_114":                       OBJECT_ROCK_114,
+	"ROCK_115":                       OBJECT_ROCK_115,
+	"WEB":                            OBJECT_WEB,
+	"WEB_117":                        OBJECT_WEB_117,
+	"FURNACE":                        OBJECT_FURNACE,
+	"COOK_S_RANGE":                   OBJECT_COOK_S_RANGE,
+	"MACHINE":                        OBJECT_MACHINE,
+	"SPINNING_WHEEL":                 OBJECT_SPINNING_WHEEL,
+	"LEVER":                          OBJECT_LEVER,
+	"LEVER_123":                      OBJECT_LEVER_123,
+	"LEVERA":                         OBJECT_LEVERA,
+	"LEVERB":                         OBJECT_LEVERB,
+	"LEVERC":                         OBJECT_LEVERC,
+	"LEVERD":                         OBJECT_LEVERD,
+	"LEVERE":                         OBJECT_LEVERE,
+	"LEVERF":                         OBJECT_LEVERF,
+	"LADDER_130":                     OBJECT_LADDER_130,
+	"SIGNPOST_131":                   OBJECT_SIGNPOST_131,
+	"SIGNPOST_132":                   OBJECT_SIGNPOST_132,
+	"SIGNPOST_133":                   OBJECT_SIGNPOST_133,
+	"COMPOST_HEAP":                   OBJECT_COMPOST_HEAP,
+	"COFFIN_135":                     OBJECT_COFFIN_135,
+	"COFFIN_136":                     OBJECT_COFFIN_136,
+	"GATE_137":                       OBJECT_GATE_137,
+	"GATE_138":                       OBJECT_GATE_138,
+	"SIGN_139":                       OBJECT_SIGN_139,
+	"CUPBOARD_140":                   OBJECT_CUPBOARD_140,
+	"CUPBOARD_141":                   OBJECT_CUPBOARD_141,
+	"DOORS_142":                      OBJECT_DOORS_142,
+	"TORCH_143":                      OBJECT_TORCH_143,
+	"ALTAR_144":                      OBJECT_ALTAR_144,
+	"SHIELD":                         OBJECT_SHIELD,
+	"GRILL":                          OBJECT_GRILL,
+	"CAULDRON":                       OBJECT_CAULDRON,
+	"GRILL_148":                      OBJECT_GRILL_148,
+	"MINE_CART":                      OBJECT_MINE_CART,
+	"BUFFERS":                        OBJECT_BUFFERS,
+	"TRACK":                          OBJECT_TRACK,
+	"TRACK_152":                      OBJECT_TRACK_152,
+	"TRACK_153":                      OBJECT_TRACK_153,
+	"HOLE":                           OBJECT_HOLE,
+	"SHIP":                           OBJECT_SHIP,
+	"SHIP_156":                       OBJECT_SHIP_156,
+	"SHIP_157":                       OBJECT_SHIP_157,
+	"EMERGENCY_ESCAPE_LADDER":        OBJECT_EMERGENCY_ESCAPE_LADDER,
+	"SIGN_159":                       OBJECT_SIGN_159,
+	"SIGN_160":                       OBJECT_SIGN_160,
+	"SHIP_161":                       OBJECT_SHIP_161,
+	"SHIP_162":                       OBJECT_SHIP_162,
+	"SHIP_163":                       OBJECT_SHIP_163,
+	"ROCKS":                          OBJECT_ROCKS,
+	"ROCKS_165":                      OBJECT_ROCKS_165,
+	"ROCKS_166":                      OBJECT_ROCKS_166,
+	"ROCKS_167":                      OBJECT_ROCKS_167,
+	"ROCKS_168":                      OBJECT_ROCKS_168,
+	"ROCKS_169":                      OBJECT_ROCKS_169,
+	"ROCKS_170":                      OBJECT_ROCKS_170,
+	"ROCKS_171":                      OBJECT_ROCKS_171,
+	"ROCKS_172":                      OBJECT_ROCKS_172,
+	"HOPPER_173":                     OBJECT_HOPPER_173,
+	"CUPBOARD_174":                   OBJECT_CUPBOARD_174,
+	"CUPBOARD_175":                   OBJECT_CUPBOARD_175,
+	"ROCKS_176":                      OBJECT_ROCKS_176,
+	"DORIC_S_ANVIL":                  OBJECT_DORIC_S_ANVIL,
+	"POTTERY_OVEN":                   OBJECT_POTTERY_OVEN,
+	"POTTER_S_WHEEL":                 OBJECT_POTTER_S_WHEEL,
+	"GATE_180":                       OBJECT_GATE_180,
+	"GATE_181":                       OBJECT_GATE_181,
+	"CRATE":                          OBJECT_CRATE,
+	"BANANA_TREE":                    OBJECT_BANANA_TREE,
+	"BANANA_TREE_184":                OBJECT_BANANA_TREE_184,
+	"CRATE_185":                      OBJECT_CRATE_185,
+	"CHEST_186":                      OBJECT_CHEST_186,
+	"CHEST_187":                      OBJECT_CHEST_187,
+	"FLOWER_188":                     OBJECT_FLOWER_188,
+	"SIGN_189":                       OBJECT_SIGN_189,
+	"SIGN_190":                       OBJECT_SIGN_190,
+	"POTATO":                         OBJECT_POTATO,
+	"FISH":                           OBJECT_FISH,
+	"FISH_193":                       OBJECT_FISH_193,
+	"FISH_194":                       OBJECT_FISH_194,
+	"ROCK_195":                       OBJECT_ROCK_195,
+	"ROCK_196":                       OBJECT_ROCK_196,
+	"ROCKS_197":                      OBJECT_ROCKS_197,
+	"LADDER_198":                     OBJECT_LADDER_198,
+	"LADDER_199":                     OBJECT_LADDER_199,
+	"MONKS_ALTAR":                    OBJECT_MONKS_ALTAR,
+	"LADDER_201":                     OBJECT_LADDER_201,
+	"COFFIN_202":                     OBJECT_COFFIN_202,
+	"COFFIN_203":                     OBJECT_COFFIN_203,
+	"SMASHED_TABLE":                  OBJECT_SMASHED_TABLE,
+	"FUNGUS":                         OBJECT_FUNGUS,
+	"SMASHED_CHAIR":                  OBJECT_SMASHED_CHAIR,
+	"BROKEN_PILLAR":                  OBJECT_BROKEN_PILLAR,
+	"FALLEN_TREE":                    OBJECT_FALLEN_TREE,
+	"DANGER_SIGN":                    OBJECT_DANGER_SIGN,
+	"ROCK_210":                       OBJECT_ROCK_210,
+	"ROCK_211":                       OBJECT_ROCK_211,
+	"ROCKS_212":                      OBJECT_ROCKS_212,
+	"GRAVESTONE_213":                 OBJECT_GRAVESTONE_213,
+	"BONE":                           OBJECT_BONE,
+	"BONE_215":                       OBJECT_BONE_215,
+	"CARCASS":                        OBJECT_CARCASS,
+	"ANIMALSKULL":                    OBJECT_ANIMALSKULL,
+	"VINE":                           OBJECT_VINE,
+	"VINE_219":                       OBJECT_VINE_219,
+	"VINE_220":                       OBJECT_VINE_220,
+	"CHEST_221":                      OBJECT_CHEST_221,
+	"CHEST_222":                      OBJECT_CHEST_222,
+	"LADDER_223":                     OBJECT_LADDER_223,
+	"SHIP_224":                       OBJECT_SHIP_224,
+	"SHIP_225":                       OBJECT_SHIP_225,
+	"HOLE_226":                       OBJECT_HOLE_226,
+	"LADDER_227":                     OBJECT_LADDER_227,
+	"CHEST_228":                      OBJECT_CHEST_228,
+	"CHEST_229":                      OBJECT_CHEST_229,
+	"CHEST_230":                      OBJECT_CHEST_230,
+	"CHEST_231":                      OBJECT_CHEST_231,
+	"HOLE_232":                       OBJECT_HOLE_232,
+	"SHIP_233":                       OBJECT_SHIP_233,
+	"SHIP_234":                       OBJECT_SHIP_234,
+	"ALTAR_OF_GUTHIX":                OBJECT_ALTAR_OF_GUTHIX,
+	"THE_CAULDRON_OF_THUNDER":        OBJECT_THE_CAULDRON_OF_THUNDER,
+	"TREE_237":                       OBJECT_TREE_237,
+	"SHIP_238":                       OBJECT_SHIP_238,
+	"SHIP_239":                       OBJECT_SHIP_239,
+	"SHIP_240":                       OBJECT_SHIP_240,
+	"SHIP_241":                       OBJECT_SHIP_241,
+	"SHIP_242":                       OBJECT_SHIP_242,
+	"SHIP_243":                       OBJECT_SHIP_243,
+	"LADDER_244":                     OBJECT_LADDER_244,
+	"DRAMEN_TREE":                    OBJECT_DRAMEN_TREE,
+	"HOPPER_246":                     OBJECT_HOPPER_246,
+	"CHEST_247":                      OBJECT_CHEST_247,
+	"CHEST_248":                      OBJECT_CHEST_248,
+	"LADDER_249":                     OBJECT_LADDER_249,
+	"SIGN_250":                       OBJECT_SIGN_250,
+	"SIGN_251":                       OBJECT_SIGN_251,
+	"GATE_252":                       OBJECT_GATE_252,
+	"GATE_253":                       OBJECT_GATE_253,
+	"GATE_254":                       OBJECT_GATE_254,
+	"DOOR_MAT":                       OBJECT_DOOR_MAT,
+	"GATE_256":                       OBJECT_GATE_256,
+	"CAULDRON_257":                   OBJECT_CAULDRON_257,
+	"CUPBOARD_258":                   OBJECT_CUPBOARD_258,
+	"CUPBOARD_259":                   OBJECT_CUPBOARD_259,
+	"GATE_260":                       OBJECT_GATE_260,
+	"FISH_261":                       OBJECT_FISH_261,
+	"SIGN_262":                       OBJECT_SIGN_262,
+	"CUPBOARD_263":                   OBJECT_CUPBOARD_263,
+	"CUPBOARD_264":                   OBJECT_CUPBOARD_264,
+	"CHEST_265":                      OBJECT_CHEST_265,
+	"CHEST_266":                      OBJECT_CHEST_266,
+	"SIGN_267":                       OBJECT_SIGN_267,
+	"SIGNPOST_268":                   OBJECT_SIGNPOST_268,
+	"ROCKSLIDE":                      OBJECT_ROCKSLIDE,
+	"LADDER_270":                     OBJECT_LADDER_270,
+	"FISH_271":                       OBJECT_FISH_271,
+	"BARREL_272":                     OBJECT_BARREL_272,
+	"TABLE_273":                      OBJECT_TABLE_273,
+	"FIREPLACE":                      OBJECT_FIREPLACE,
+	"EGG":                            OBJECT_EGG,
+	"EGGS":                           OBJECT_EGGS,
+	"STALAGMITES":                    OBJECT_STALAGMITES,
+	"STOOL":                          OBJECT_STOOL,
+	"BENCH_279":                      OBJECT_BENCH_279,
+	"TABLE_280":                      OBJECT_TABLE_280,
+	"TABLE_281":                      OBJECT_TABLE_281,
+	"FOUNTAIN_OF_HEROS":              OBJECT_FOUNTAIN_OF_HEROS,
+	"BUSH":                           OBJECT_BUSH,
+	"HEDGE":                          OBJECT_HEDGE,
+	"FLOWER_285":                     OBJECT_FLOWER_285,
+	"PLANT":                          OBJECT_PLANT,
+	"GIANT_CRYSTAL":                  OBJECT_GIANT_CRYSTAL,
+	"SIGN_288":                       OBJECT_SIGN_288,
+	"SIGN_289":                       OBJECT_SIGN_289,
+	"CRATE_290":                      OBJECT_CRATE_290,
+	"CRATE_291":                      OBJECT_CRATE_291,
+	"SHIP_292":                       OBJECT_SHIP_292,
+	"SHIP_293":                       OBJECT_SHIP_293,
+	"BEEHIVE":                        OBJECT_BEEHIVE,
+	"LADDER_295":                     OBJECT_LADDER_295,
+	"ALTAR_296":                      OBJECT_ALTAR_296,
+	"SIGN_297":                       OBJECT_SIGN_297,
+	"SIGNPOST_298":                   OBJECT_SIGNPOST_298,
+	"ARCHWAY":                        OBJECT_ARCHWAY,
+	"OBELISK_OF_WATER":               OBJECT_OBELISK_OF_WATER,
+	"OBELISK_OF_FIRE":                OBJECT_OBELISK_OF_FIRE,
+	"SAND_PIT":                       OBJECT_SAND_PIT,
+	"OBELISK_OF_AIR":                 OBJECT_OBELISK_OF_AIR,
+	"OBELISK_OF_EARTH":               OBJECT_OBELISK_OF_EARTH,
+	"GATE_305":                       OBJECT_GATE_305,
+	"OAK_TREE":                       OBJECT_OAK_TREE,
+	"WILLOW_TREE":                    OBJECT_WILLOW_TREE,
+	"MAPLE_TREE":                     OBJECT_MAPLE_TREE,
+	"YEW_TREE":                       OBJECT_YEW_TREE,
+	"TREE_310":                       OBJECT_TREE_310,
+	"GATE_311":                       OBJECT_GATE_311,
+	"SIGN_312":                       OBJECT_SIGN_312,
+	"FLAX":                           OBJECT_FLAX,
+	"LARGE_TREESTUMP":                OBJECT_LARGE_TREESTUMP,
+	"ROCKS_315":                      OBJECT_ROCKS_315,
+	"LEVER_316":                      OBJECT_LEVER_316,
+	"LEVER_317":                      OBJECT_LEVER_317,
+	"LEVER_318":                      OBJECT_LEVER_318,
+	"GATE_319":                       OBJECT_GATE_319,
+	"SHIP_320":                       OBJECT_SHIP_320,
+	"SHIP_321":                       OBJECT_SHIP_321,
+	"BAKERS_STALL":                   OBJECT_BAKERS_STALL,
+	"SILK_STALL":                     OBJECT_SILK_STALL,
+	"FUR_STALL":                      OBJECT_FUR_STALL,
+	"SILVER_STALL":                   OBJECT_SILVER_STALL,
+	"SPICES_STALL":                   OBJECT_SPICES_STALL,
+	"GEMS_STALL":                     OBJECT_GEMS_STALL,
+	"CRATE_328":                      OBJECT_CRATE_328,
+	"CRATE_329":                      OBJECT_CRATE_329,
+	"SIGN_330":                       OBJECT_SIGN_330,
+	"STAIRS_331":                     OBJECT_STAIRS_331,
+	"CHEST_332":                      OBJECT_CHEST_332,
+	"CHEST_333":                      OBJECT_CHEST_333,
+	"CHEST_334":                      OBJECT_CHEST_334,
+	"CHEST_335":                      OBJECT_CHEST_335,
+	"CHEST_336":                      OBJECT_CHEST_336,
+	"CHEST_337":                      OBJECT_CHEST_337,
+	"CHEST_338":                      OBJECT_CHEST_338,
+	"CHEST_339":                      OBJECT_CHEST_339,
+	"CHEST_340":                      OBJECT_CHEST_340,
+	"EMPTY_STALL":                    OBJECT_EMPTY_STALL,
+	"STAIRS_342":                     OBJECT_STAIRS_342,
+	"HOPPER_343":                     OBJECT_HOPPER_343,
+	"SIGNPOST_344":                   OBJECT_SIGNPOST_344,
+	"SIGN_345":                       OBJECT_SIGN_345,
+	"GATE_346":                       OBJECT_GATE_346,
+	"GATE_347":                       OBJECT_GATE_347,
+	"LEVER_348":                      OBJECT_LEVER_348,
+	"LEVER_349":                      OBJECT_LEVER_349,
+	"PIPE_350":                       OBJECT_PIPE_350,
+	"FISH_351":                       OBJECT_FISH_351,
+	"FISH_352":                       OBJECT_FISH_352,
+	"FISH_353":                       OBJECT_FISH_353,
+	"FISH_354":                       OBJECT_FISH_354,
+	"VINE_355":                       OBJECT_VINE_355,
+	"GATE_356":                       OBJECT_GATE_356,
+	"GATE_357":                       OBJECT_GATE_357,
+	"GATE_358":                       OBJECT_GATE_358,
+	"STAIRS_359":                     OBJECT_STAIRS_359,
+	"BROKEN_CART":                    OBJECT_BROKEN_CART,
+	"LEVER_361":                      OBJECT_LEVER_361,
+	"CLOCK_POLE_BLUE":                OBJECT_CLOCK_POLE_BLUE,
+	"CLOCK_POLE_RED":                 OBJECT_CLOCK_POLE_RED,
+	"CLOCK_POLE_PURPLE":              OBJECT_CLOCK_POLE_PURPLE,
+	"CLOCK_POLE_BLACK":               OBJECT_CLOCK_POLE_BLACK,
+	"WALLCLOCKFACE":                  OBJECT_WALLCLOCKFACE,
+	"LEVER_BRACKET":                  OBJECT_LEVER_BRACKET,
+	"LEVER_368":                      OBJECT_LEVER_368,
+	"STAIRS_369":                     OBJECT_STAIRS_369,
+	"STAIRS_370":                     OBJECT_STAIRS_370,
+	"GATE_371":                       OBJECT_GATE_371,
+	"GATE_372":                       OBJECT_GATE_372,
+	"LEVER_373":                      OBJECT_LEVER_373,
+	"LEVER_374":                      OBJECT_LEVER_374,
+	"FOODTROUGH":                     OBJECT_FOODTROUGH,
+	"FISH_376":                       OBJECT_FISH_376,
+	"SPEARWALL":                      OBJECT_SPEARWALL,
+	"HORNEDSKULL":                    OBJECT_HORNEDSKULL,
+	"CHEST_379":                      OBJECT_CHEST_379,
+	"CHEST_380":                      OBJECT_CHEST_380,
+	"GUARDSCUPBOARD":                 OBJECT_GUARDSCUPBOARD,
+	"GUARDSCUPBOARD_382":             OBJECT_GUARDSCUPBOARD_382,
+	"COAL_TRUCK":                     OBJECT_COAL_TRUCK,
+	"SHIP_384":                       OBJECT_SHIP_384,
+	"SHIP_385":                       OBJECT_SHIP_385,
+	"SHIP_386":                       OBJECT_SHIP_386,
+	"TREE_387":                       OBJECT_TREE_387,
+	"BALLISTA":                       OBJECT_BALLISTA,
+	"LARGESPEAR":                     OBJECT_LARGESPEAR,
+	"SPIRIT_TREE":                    OBJECT_SPIRIT_TREE,
+	"YOUNG_SPIRIT_TREE":              OBJECT_YOUNG_SPIRIT_TREE,
+	"GATE_392":                       OBJECT_GATE_392,
+	"WALL":                           OBJECT_WALL,
+	"TREE_394":                       OBJECT_TREE_394,
+	"TREE_395":                       OBJECT_TREE_395,
+	"FERN_396":                       OBJECT_FERN_396,
+	"FERN_397":                       OBJECT_FERN_397,
+	"FERN_398":                       OBJECT_FERN_398,
+	"FERN_399":                       OBJECT_FERN_399,
+	"FLY_TRAP":                       OBJECT_FLY_TRAP,
+	"FERN_401":                       OBJECT_FERN_401,
+	"FERN_402":                       OBJECT_FERN_402,
+	"PLANT_403":                      OBJECT_PLANT_403,
+	"PLANT_404":                      OBJECT_PLANT_404,
+	"PLANT_405":                      OBJECT_PLANT_405,
+	"STONE_HEAD":                     OBJECT_STONE_HEAD,
+	"DEAD_TREE":                      OBJECT_DEAD_TREE,
+	"SACKS_408":                      OBJECT_SACKS_408,
+	"KHAZARD_OPEN_CHEST":             OBJECT_KHAZARD_OPEN_CHEST,
+	"KHAZARD_SHUT_CHEST":             OBJECT_KHAZARD_SHUT_CHEST,
+	"DOORFRAME":                      OBJECT_DOORFRAME,
+	"SEWER_VALVE":                    OBJECT_SEWER_VALVE,
+	"SEWER_VALVE_2":                  OBJECT_SEWER_VALVE_2,
+	"SEWER_VALVE_3":                  OBJECT_SEWER_VALVE_3,
+	"SEWER_VALVE_4":                  OBJECT_SEWER_VALVE_4,
+	"SEWER_VALVE_5":                  OBJECT_SEWER_VALVE_5,
+	"CAVE_ENTRANCE":                  OBJECT_CAVE_ENTRANCE,
+	"LOG_BRIDGE":                     OBJECT_LOG_BRIDGE,
+	"LOG_BRIDGE_419":                 OBJECT_LOG_BRIDGE_419,
+	"TREE_PLATFORM":                  OBJECT_TREE_PLATFORM,
+	"TREE_PLATFORM_421":              OBJECT_TREE_PLATFORM_421,
+	"GATE_422":                       OBJECT_GATE_422,
+	"TREE_PLATFORM_423":              OBJECT_TREE_PLATFORM_423,
+	"TREE_PLATFORM_424":              OBJECT_TREE_PLATFORM_424,
+	"LOG_BRIDGE_425":                 OBJECT_LOG_BRIDGE_425,
+	"LOG_BRIDGE_426":                 OBJECT_LOG_BRIDGE_426,
+	"TREE_PLATFORM_427":              OBJECT_TREE_PLATFORM_427,
+	"TREE_PLATFORM_428":              OBJECT_TREE_PLATFORM_428,
+	"TRIBAL_BREW":                    OBJECT_TRIBAL_BREW,
+	"PINEAPPLE_TREE":                 OBJECT_PINEAPPLE_TREE,
+	"PINEAPPLE_TREE_431":             OBJECT_PINEAPPLE_TREE_431,
+	"LOG_RAFT":                       OBJECT_LOG_RAFT,
+	"LOG_RAFT_433":                   OBJECT_LOG_RAFT_433,
+	"TOMB_OF_HAZEEL":                 OBJECT_TOMB_OF_HAZEEL,
+	"RANGE_435":                      OBJECT_RANGE_435,
+	"BOOKCASE_436":                   OBJECT_BOOKCASE_436,
+	"CARNILLEAN_CHEST":               OBJECT_CARNILLEAN_CHEST,
+	"CARNILLEAN_CHEST_438":           OBJECT_CARNILLEAN_CHEST_438,
+	"CRATE_439":                      OBJECT_CRATE_439,
+	"BUTLERS_CUPBOARD":               OBJECT_BUTLERS_CUPBOARD,
+	"BUTLERS_CUPBOARD_441":           OBJECT_BUTLERS_CUPBOARD_441,
+	"GATE_442":                       OBJECT_GATE_442,
+	"GATE_443":                       OBJECT_GATE_443,
+	"CATTLE_FURNACE":                 OBJECT_CATTLE_FURNACE,
+	"ARDOUNGE_WALL":                  OBJECT_ARDOUNGE_WALL,
+	"ARDOUNGE_WALL_CORNER":           OBJECT_ARDOUNGE_WALL_CORNER,
+	"DUG_UP_SOIL":                    OBJECT_DUG_UP_SOIL,
+	"PILE_OF_MUD":                    OBJECT_PILE_OF_MUD,
+	"LARGE_SEWER_PIPE":               OBJECT_LARGE_SEWER_PIPE,
+	"ARDOUNGE_WALL_GATEWAY":          OBJECT_ARDOUNGE_WALL_GATEWAY,
+	"CUPBOARD_451":                   OBJECT_CUPBOARD_451,
+	"CUPBOARD_452":                   OBJECT_CUPBOARD_452,
+	"FISHING_CRANE":                  OBJECT_FISHING_CRANE,
+	"ROWBOAT":                        OBJECT_ROWBOAT,
+	"DAMAGED_ROWBOAT":                OBJECT_DAMAGED_ROWBOAT,
+	"BARREL_456":                     OBJECT_BARREL_456,
+	"GATE_457":                       OBJECT_GATE_457,
+	"LADDER_458":                     OBJECT_LADDER_458,
+	"FISHING_CRANE_459":              OBJECT_FISHING_CRANE_459,
+	"FISHING_CRANE_460":              OBJECT_FISHING_CRANE_460,
+	"WATERFALL":                      OBJECT_WATERFALL,
+	"LEAFLESSTREE":                   OBJECT_LEAFLESSTREE,
+	"LEAFLESSTREE_463":               OBJECT_LEAFLESSTREE_463,
+	"LOG_RAFT_464":                   OBJECT_LOG_RAFT_464,
+	"DOORS_465":                      OBJECT_DOORS_465,
+	"WELL_466":                       OBJECT_WELL_466,
+	"TOMB_OF_GLARIAL":                OBJECT_TOMB_OF_GLARIAL,
+	"WATERFALL_468":                  OBJECT_WATERFALL_468,
+	"WATERFALL_469":                  OBJECT_WATERFALL_469,
+	"BOOKCASE_470":                   OBJECT_BOOKCASE_470,
+	"DOORS_471":                      OBJECT_DOORS_471,
+	"DOORS_472":                      OBJECT_DOORS_472,
+	"STONE_STAND":                    OBJECT_STONE_STAND,
+	"STONE_STAND_474":                OBJECT_STONE_STAND_474,
+	"STONE_STAND_475":                OBJECT_STONE_STAND_475,
+	"STONE_STAND_476":                OBJECT_STONE_STAND_476,
+	"STONE_STAND_477":                OBJECT_STONE_STAND_477,
+	"STONE_STAND_478":                OBJECT_STONE_STAND_478,
+	"GLARIAL_S_GRAVESTONE":           OBJECT_GLARIAL_S_GRAVESTONE,
+	"GATE_480":                       OBJECT_GATE_480,
+	"CRATE_481":                      OBJECT_CRATE_481,
+	"LEAFLESSTREE_482":               OBJECT_LEAFLESSTREE_482,
+	"STATUE_OF_GLARIAL":              OBJECT_STATUE_OF_GLARIAL,
+	"CHALICE_OF_ETERNITY":            OBJECT_CHALICE_OF_ETERNITY,
+	"CHALICE_OF_ETERNITY_485":        OBJECT_CHALICE_OF_ETERNITY_485,
+	"DOORS_486":                      OBJECT_DOORS_486,
+	"LEVER_487":                      OBJECT_LEVER_487,
+	"LEVER_488":                      OBJECT_LEVER_488,
+	"LOG_RAFT_REMAINS":               OBJECT_LOG_RAFT_REMAINS,
+	"TREE_490":                       OBJECT_TREE_490,
+	"RANGE_491":                      OBJECT_RANGE_491,
+	"CRATE_492":                      OBJECT_CRATE_492,
+	"FISH_493":                       OBJECT_FISH_493,
+	"WATCH_TOWER":                    OBJECT_WATCH_TOWER,
+	"SIGNPOST_495":                   OBJECT_SIGNPOST_495,
+	"ROCKS_496":                      OBJECT_ROCKS_496,
+	"DOORS_497":                      OBJECT_DOORS_497,
+	"ROPE_LADDER":                    OBJECT_ROPE_LADDER,
+	"CUPBOARD_499":                   OBJECT_CUPBOARD_499,
+	"CUPBOARD_500":                   OBJECT_CUPBOARD_500,
+	"ROPE_LADDER_501":                OBJECT_ROPE_LADDER_501,
+	"COOKING_POT":                    OBJECT_COOKING_POT,
+	"GALLOW":                         OBJECT_GALLOW,
+	"GATE_504":                       OBJECT_GATE_504,
+	"CRATE_505":                      OBJECT_CRATE_505,
+	"CUPBOARD_506":                   OBJECT_CUPBOARD_506,
+	"CUPBOARD_507":                   OBJECT_CUPBOARD_507,
+	"GATE_508":                       OBJECT_GATE_508,
+	"CUPBOARD_509":                   OBJECT_CUPBOARD_509,
+	"CUPBOARD_510":                   OBJECT_CUPBOARD_510,
+	"SIGN_511":                       OBJECT_SIGN_511,
+	"GRAND_TREE":                     OBJECT_GRAND_TREE,
+	"GATE_513":                       OBJECT_GATE_513,
+	"GATE_514":                       OBJECT_GATE_514,
+	"ROCKS_515":                      OBJECT_ROCKS_515,
+	"ROCKS_516":                      OBJECT_ROCKS_516,
+	"ROCKS_517":                      OBJECT_ROCKS_517,
+	"ROCKS_518":                      OBJECT_ROCKS_518,
+	"ROCKS_519":                      OBJECT_ROCKS_519,
+	"ROCKS_520":                      OBJECT_ROCKS_520,
+	"ROCKS_521":                      OBJECT_ROCKS_521,
+	"ROCKS_522":                      OBJECT_ROCKS_522,
+	"ROCKS_523":                      OBJECT_ROCKS_523,
+	"LOG_BRIDGE_524":                 OBJECT_LOG_BRIDGE_524,
+	"WATCH_TOWER_525":                OBJECT_WATCH_TOWER_525,
+	"LOG_BRIDGE_526":                 OBJECT_LOG_BRIDGE_526,
+	"CLIMBING_ROCKS":                 OBJECT_CLIMBING_ROCKS,
+	"LEDGE":                          OBJECT_LEDGE,
+	"LEDGE_529":                      OBJECT_LEDGE_529,
+	"LOG":                            OBJECT_LOG,
+	"LOG_531":                        OBJECT_LOG_531,
+	"ROCKS_532":                      OBJECT_ROCKS_532,
+	"ROCKS_533":                      OBJECT_ROCKS_533,
+	"ROCKS_534":                      OBJECT_ROCKS_534,
+	"ROCKS_535":                      OBJECT_ROCKS_535,
+	"ROCKS_536":                      OBJECT_ROCKS_536,
+	"ROCKS_537":                      OBJECT_ROCKS_537,
+	"ROCKS_538":                      OBJECT_ROCKS_538,
+	"ROCKS_539":                      OBJECT_ROCKS_539,
+	"ROCKS_540":                      OBJECT_ROCKS_540,
+	"ROCKS_541":                      OBJECT_ROCKS_541,
+	"ROCKS_542":                      OBJECT_ROCKS_542,
+	"ROCKS_543":                      OBJECT_ROCKS_543,
+	"ROCKS_544":                      OBJECT_ROCKS_544,
+	"ROCKS_545":                      OBJECT_ROCKS_545,
+	"ROCKS_546":                      OBJECT_ROCKS_546,
+	"ROCKS_547":                      OBJECT_ROCKS_547,
+	"ROCKS_548":                      OBJECT_ROCKS_548,
+	"ROCKS_549":                      OBJECT_ROCKS_549,
+	"ROCKS_550":                      OBJECT_ROCKS_550,
+	"ROCKS_551":                      OBJECT_ROCKS_551,
+	"ROCKS_552":                      OBJECT_ROCKS_552,
+	"PALMTREE_553":                   OBJECT_PALMTREE_553,
+	"SCORCHED_EARTH":                 OBJECT_SCORCHED_EARTH,
+	"ROCKS_555":                      OBJECT_ROCKS_555,
+	"SIGN_556":                       OBJECT_SIGN_556,
+	"FISH_557":                       OBJECT_FISH_557,
+	"ROCKY_WALKWAY":                  OBJECT_ROCKY_WALKWAY,
+	"ROCKY_WALKWAY_559":              OBJECT_ROCKY_WALKWAY_559,
+	"ROCKY_WALKWAY_560":              OBJECT_ROCKY_WALKWAY_560,
+	"ROCKY_WALKWAY_561":              OBJECT_ROCKY_WALKWAY_561,
+	"FIGHT_DUMMY":                    OBJECT_FIGHT_DUMMY,
+	"GATE_563":                       OBJECT_GATE_563,
+	"JUNGLE_VINE":                    OBJECT_JUNGLE_VINE,
+	"STATUE":                         OBJECT_STATUE,
+	"SIGN_566":                       OBJECT_SIGN_566,
+	"GRAND_TREE_567":                 OBJECT_GRAND_TREE_567,
+	"GRAND_TREE_568":                 OBJECT_GRAND_TREE_568,
+	"GRAND_TREE_569":                 OBJECT_GRAND_TREE_569,
+	"GRAND_TREE_570":                 OBJECT_GRAND_TREE_570,
+	"GRAND_TREE_571":                 OBJECT_GRAND_TREE_571,
+	"HILLSIDE_ENTRANCE":              OBJECT_HILLSIDE_ENTRANCE,
+	"TREE_573":                       OBJECT_TREE_573,
+	"LOG_BRIDGE_574":                 OBJECT_LOG_BRIDGE_574,
+	"TREE_PLATFORM_575":              OBJECT_TREE_PLATFORM_575,
+	"TREE_PLATFORM_576":              OBJECT_TREE_PLATFORM_576,
+	"METALIC_DUNGEON_GATE":           OBJECT_METALIC_DUNGEON_GATE,
+	"LOG_BRIDGE_578":                 OBJECT_LOG_BRIDGE_578,
+	"LOG_BRIDGE_579":                 OBJECT_LOG_BRIDGE_579,
+	"WATCH_TOWER_580":                OBJECT_WATCH_TOWER_580,
+	"WATCH_TOWER_581":                OBJECT_WATCH_TOWER_581,
+	"SHALLOW_WATER":                  OBJECT_SHALLOW_WATER,
+	"DOORS_583":                      OBJECT_DOORS_583,
+	"GRAND_TREE_584":                 OBJECT_GRAND_TREE_584,
+	"TREE_LADDER":                    OBJECT_TREE_LADDER,
+	"TREE_LADDER_586":                OBJECT_TREE_LADDER_586,
+	"BLURBERRYS_COCKTAIL_BAR":        OBJECT_BLURBERRYS_COCKTAIL_BAR,
+	"GEM_ROCKS":                      OBJECT_GEM_ROCKS,
+	"GIANNES_PLACE":                  OBJECT_GIANNES_PLACE,
+	"ROPESWING":                      OBJECT_ROPESWING,
+	"NET":                            OBJECT_NET,
+	"FRAME":                          OBJECT_FRAME,
+	"TREE_593":                       OBJECT_TREE_593,
+	"TREE_594":                       OBJECT_TREE_594,
+	"TREE_595":                       OBJECT_TREE_595,
+	"CART_596":                       OBJECT_CART_596,
+	"FENCE":                          OBJECT_FENCE,
+	"BEAM":                           OBJECT_BEAM,
+	"SIGN_599":                       OBJECT_SIGN_599,
+	"SIGN_600":                       OBJECT_SIGN_600,
+	"SIGN_601":                       OBJECT_SIGN_601,
+	"SIGN_602":                       OBJECT_SIGN_602,
+	"SIGN_603":                       OBJECT_SIGN_603,
+	"SIGN_604":                       OBJECT_SIGN_604,
+	"SIGN_605":                       OBJECT_SIGN_605,
+	"SIGN_606":                       OBJECT_SIGN_606,
+	"SIGN_607":                       OBJECT_SIGN_607,
+	"SIGN_608":                       OBJECT_SIGN_608,
+	"ROOT":                           OBJECT_ROOT,
+	"ROOT_610":                       OBJECT_ROOT_610,
+	"METAL_GATE":                     OBJECT_METAL_GATE,
+	"METAL_GATE_612":                 OBJECT_METAL_GATE_612,
+	"A_FARM_CART":                    OBJECT_A_FARM_CART,
+	"LEDGE_614":                      OBJECT_LEDGE_614,
+	"LEDGE_615":                      OBJECT_LEDGE_615,
+	"LADDER_616":                     OBJECT_LADDER_616,
+	"CAGE":                           OBJECT_CAGE,
+	"GLIDER":                         OBJECT_GLIDER,
+	"CUPBOARD_619":                   OBJECT_CUPBOARD_619,
+	"CUPBOARD_620":                   OBJECT_CUPBOARD_620,
+	"STAIRS_621":                     OBJECT_STAIRS_621,
+	"GLIDER_622":                     OBJECT_GLIDER_622,
+	"GATE_623":                       OBJECT_GATE_623,
+	"GATE_624":                       OBJECT_GATE_624,
+	"CHAOS_ALTAR":                    OBJECT_CHAOS_ALTAR,
+	"GNOME_STRONGHOLD_GATE":          OBJECT_GNOME_STRONGHOLD_GATE,
+	"ROPESWING_627":                  OBJECT_ROPESWING_627,
+	"ROPESWING_628":                  OBJECT_ROPESWING_628,
+	"STAIRS_629":                     OBJECT_STAIRS_629,
+	"STAIRS_630":                     OBJECT_STAIRS_630,
+	"CHEST_631":                      OBJECT_CHEST_631,
+	"CHEST_632":                      OBJECT_CHEST_632,
+	"PILE_OF_RUBBLE":                 OBJECT_PILE_OF_RUBBLE,
+	"STONE_STAND_634":                OBJECT_STONE_STAND_634,
+	"WATCH_TOWER_635":                OBJECT_WATCH_TOWER_635,
+	"PILE_OF_RUBBLE_636":             OBJECT_PILE_OF_RUBBLE_636,
+	"ROOT_637":                       OBJECT_ROOT_637,
+	"ROOT_638":                       OBJECT_ROOT_638,
+	"ROOT_639":                       OBJECT_ROOT_639,
+	"SIGN_640":                       OBJECT_SIGN_640,
+	"HAMMOCK":                        OBJECT_HAMMOCK,
+	"GOAL":                           OBJECT_GOAL,
+	"STONE_TILE":                     OBJECT_STONE_TILE,
+	"CHEST_644":                      OBJECT_CHEST_644,
+	"CHEST_645":                      OBJECT_CHEST_645,
+	"WATCH_TOWER_646":                OBJECT_WATCH_TOWER_646,
+	"NET_647":                        OBJECT_NET_647,
+	"WATCH_TOWER_648":                OBJECT_WATCH_TOWER_648,
+	"WATCH_TOWER_649":                OBJECT_WATCH_TOWER_649,
+	"ROPESWING_650":                  OBJECT_ROPESWING_650,
+	"BUMPY_DIRT":                     OBJECT_BUMPY_DIRT,
+	"PIPE_652":                       OBJECT_PIPE_652,
+	"NET_653":                        OBJECT_NET_653,
+	"PIPE_654":                       OBJECT_PIPE_654,
+	"LOG_655":                        OBJECT_LOG_655,
+	"PIPE_656":                       OBJECT_PIPE_656,
+	"PIPE_657":                       OBJECT_PIPE_657,
+	"HANDHOLDS":                      OBJECT_HANDHOLDS,
+	"LADDER_659":                     OBJECT_LADDER_659,
+	"GATE_660":                       OBJECT_GATE_660,
+	"STRONGHOLD_SPIRIT_TREE":         OBJECT_STRONGHOLD_SPIRIT_TREE,
+	"TREE_662":                       OBJECT_TREE_662,
+	"TREE_663":                       OBJECT_TREE_663,
+	"TREE_664":                       OBJECT_TREE_664,
+	"SPIKED_PIT":                     OBJECT_SPIKED_PIT,
+	"SPIKED_PIT_666":                 OBJECT_SPIKED_PIT_666,
+	"CAVE":                           OBJECT_CAVE,
+	"STONE_PEBBLE":                   OBJECT_STONE_PEBBLE,
+	"PILE_OF_RUBBLE_669":             OBJECT_PILE_OF_RUBBLE_669,
+	"PILE_OF_RUBBLE_670":             OBJECT_PILE_OF_RUBBLE_670,
+	"PIPE_671":                       OBJECT_PIPE_671,
+	"PIPE_672":                       OBJECT_PIPE_672,
+	"STONE":                          OBJECT_STONE,
+	"STONE_674":                      OBJECT_STONE_674,
+	"ROPESWING_675":                  OBJECT_ROPESWING_675,
+	"LOG_676":                        OBJECT_LOG_676,
+	"NET_677":                        OBJECT_NET_677,
+	"LEDGE_678":                      OBJECT_LEDGE_678,
+	"HANDHOLDS_679":                  OBJECT_HANDHOLDS_679,
+	"LOG_680":                        OBJECT_LOG_680,
+	"LOG_681":                        OBJECT_LOG_681,
+	"ROTTEN_GALLOWS":                 OBJECT_ROTTEN_GALLOWS,
+	"PILE_OF_RUBBLE_683":             OBJECT_PILE_OF_RUBBLE_683,
+	"ROPESWING_684":                  OBJECT_ROPESWING_684,
+	"ROPESWING_685":                  OBJECT_ROPESWING_685,
+	"OCKS":                           OBJECT_OCKS,
+	"TREE_687":                       OBJECT_TREE_687,
+	"WELL_STACKED_ROCKS":             OBJECT_WELL_STACKED_ROCKS,
+	"TOMB_DOLMEN":                    OBJECT_TOMB_DOLMEN,
+	"HANDHOLDS_690":                  OBJECT_HANDHOLDS_690,
+	"BRIDGE_BLOCKADE":                OBJECT_BRIDGE_BLOCKADE,
+	"LOG_BRIDGE_692":                 OBJECT_LOG_BRIDGE_692,
+	"HANDHOLDS_693":                  OBJECT_HANDHOLDS_693,
+	"TREE_694":                       OBJECT_TREE_694,
+	"TREE_695":                       OBJECT_TREE_695,
+	"WET_ROCKS":                      OBJECT_WET_ROCKS,
+	"SMASHED_TABLE_697":              OBJECT_SMASHED_TABLE_697,
+	"CRUDE_RAFT":                     OBJECT_CRUDE_RAFT,
+	"DACONIA_ROCK":                   OBJECT_DACONIA_ROCK,
+	"STATUE_700":                     OBJECT_STATUE_700,
+	"STEPPING_STONES":                OBJECT_STEPPING_STONES,
+	"GATE_702":                       OBJECT_GATE_702,
+	"GATE_703":                       OBJECT_GATE_703,
+	"GATE_704":                       OBJECT_GATE_704,
+	"PIPE_705":                       OBJECT_PIPE_705,
+	"ROPESWING_706":                  OBJECT_ROPESWING_706,
+	"STONE_707":                      OBJECT_STONE_707,
+	"LEDGE_708":                      OBJECT_LEDGE_708,
+	"VINE_709":                       OBJECT_VINE_709,
+	"ROCKS_710":                      OBJECT_ROCKS_710,
+	"WOODEN_GATE":                    OBJECT_WOODEN_GATE,
+	"WOODEN_GATE_712":                OBJECT_WOODEN_GATE_712,
+	"STONE_BRIDGE":                   OBJECT_STONE_BRIDGE,
+	"STONE_BRIDGE_714":               OBJECT_STONE_BRIDGE_714,
+	"STONE_BRIDGE_715":               OBJECT_STONE_BRIDGE_715,
+	"STONE_BRIDGE_716":               OBJECT_STONE_BRIDGE_716,
+	"STONE_PLATFORM":                 OBJECT_STONE_PLATFORM,
+	"FENCE_718":                      OBJECT_FENCE_718,
+	"ROCKS_719":                      OBJECT_ROCKS_719,
+	"STONE_BRIDGE_720":               OBJECT_STONE_BRIDGE_720,
+	"STONE_BRIDGE_721":               OBJECT_STONE_BRIDGE_721,
+	"GATE_OF_IBAN":                   OBJECT_GATE_OF_IBAN,
+	"WOODEN_DOOR":                    OBJECT_WOODEN_DOOR,
+	"TOMB_DOLMEN_724":                OBJECT_TOMB_DOLMEN_724,
+	"CAVE_ENTRANCE_725":              OBJECT_CAVE_ENTRANCE_725,
+	"OLD_BRIDGE":                     OBJECT_OLD_BRIDGE,
+	"OLD_BRIDGE_727":                 OBJECT_OLD_BRIDGE_727,
+	"CRUMBLED_ROCK":                  OBJECT_CRUMBLED_ROCK,
+	"STALAGMITE":                     OBJECT_STALAGMITE,
+	"STALAGMITE_730":                 OBJECT_STALAGMITE_730,
+	"ROCKS_731":                      OBJECT_ROCKS_731,
+	"LEDGE_732":                      OBJECT_LEDGE_732,
+	"LEVER_733":                      OBJECT_LEVER_733,
+	"STALACTITE":                     OBJECT_STALACTITE,
+	"STALACTITE_735":                 OBJECT_STALACTITE_735,
+	"STALACTITE_736":                 OBJECT_STALACTITE_736,
+	"ROCKS_737":                      OBJECT_ROCKS_737,
+	"ROCKS_738":                      OBJECT_ROCKS_738,
+	"ROCKS_739":                      OBJECT_ROCKS_739,
+	"ROCKS_740":                      OBJECT_ROCKS_740,
+	"ROCKS_741":                      OBJECT_ROCKS_741,
+	"ROCKS_742":                      OBJECT_ROCKS_742,
+	"ROCKS_743":                      OBJECT_ROCKS_743,
+	"ROCKS_744":                      OBJECT_ROCKS_744,
+	"ROCKS_745":                      OBJECT_ROCKS_745,
+	"ROCKS_746":                      OBJECT_ROCKS_746,
+	"ROCKS_747":                      OBJECT_ROCKS_747,
+	"ROCKS_748":                      OBJECT_ROCKS_748,
+	"ROCKS_749":                      OBJECT_ROCKS_749,
+	"LEDGE_750":                      OBJECT_LEDGE_750,
+	"LEDGE_751":                      OBJECT_LEDGE_751,
+	"LEDGE_752":                      OBJECT_LEDGE_752,
+	"LEDGE_753":                      OBJECT_LEDGE_753,
+	"SWAMP":                          OBJECT_SWAMP,
+	"SWAMP_755":                      OBJECT_SWAMP_755,
+	"ROCKS_756":                      OBJECT_ROCKS_756,
+	"ROCKS_757":                      OBJECT_ROCKS_757,
+	"ROCKS_758":                      OBJECT_ROCKS_758,
+	"ROCKS_759":                      OBJECT_ROCKS_759,
+	"ROCKS_760":                      OBJECT_ROCKS_760,
+	"ROCKS_761":                      OBJECT_ROCKS_761,
+	"ROCKS_762":                      OBJECT_ROCKS_762,
+	"ROCKS_763":                      OBJECT_ROCKS_763,
+	"ROCKS_764":                      OBJECT_ROCKS_764,
+	"ROCKS_765":                      OBJECT_ROCKS_765,
+	"ROCKS_766":                      OBJECT_ROCKS_766,
+	"PILE_OF_MUD_767":                OBJECT_PILE_OF_MUD_767,
+	"TRAVEL_CART":                    OBJECT_TRAVEL_CART,
+	"TRAVEL_CART_769":                OBJECT_TRAVEL_CART_769,
+	"ROCKS_770":                      OBJECT_ROCKS_770,
+	"STALACTITE_771":                 OBJECT_STALACTITE_771,
+	"ROCKS_772":                      OBJECT_ROCKS_772,
+	"ROCKS_773":                      OBJECT_ROCKS_773,
+	"ROCKS_774":                      OBJECT_ROCKS_774,
+	"SIGN_775":                       OBJECT_SIGN_775,
+	"LADDER_776":                     OBJECT_LADDER_776,
+	"GRILL_777":                      OBJECT_GRILL_777,
+	"SPIKED_PIT_778":                 OBJECT_SPIKED_PIT_778,
+	"SIGNPOST_779":                   OBJECT_SIGNPOST_779,
+	"SHIP_780":                       OBJECT_SHIP_780,
+	"SHIP_781":                       OBJECT_SHIP_781,
+	"GRILL_782":                      OBJECT_GRILL_782,
+	"SACKS_783":                      OBJECT_SACKS_783,
+	"ZAMORAKIAN_TEMPLE":              OBJECT_ZAMORAKIAN_TEMPLE,
+	"GRILL_785":                      OBJECT_GRILL_785,
+	"GRILL_786":                      OBJECT_GRILL_786,
+	"GRILL_787":                      OBJECT_GRILL_787,
+	"GRILL_788":                      OBJECT_GRILL_788,
+	"GRILL_789":                      OBJECT_GRILL_789,
+	"GRILL_790":                      OBJECT_GRILL_790,
+	"GRILL_791":                      OBJECT_GRILL_791,
+	"ROCKS_792":                      OBJECT_ROCKS_792,
+	"ROCKS_793":                      OBJECT_ROCKS_793,
+	"TOMB_DOORS":                     OBJECT_TOMB_DOORS,
+	"SWAMP_795":                      OBJECT_SWAMP_795,
+	"ROCKS_796":                      OBJECT_ROCKS_796,
+	"ROCKS_797":                      OBJECT_ROCKS_797,
+	"STALACTITE_798":                 OBJECT_STALACTITE_798,
+	"STALACTITE_799":                 OBJECT_STALACTITE_799,
+	"SPIKED_PIT_800":                 OBJECT_SPIKED_PIT_800,
+	"LEVER_801":                      OBJECT_LEVER_801,
+	"CAGE_802":                       OBJECT_CAGE_802,
+	"CAGE_803":                       OBJECT_CAGE_803,
+	"ROCKS_804":                      OBJECT_ROCKS_804,
+	"SPEAR_TRAP":                     OBJECT_SPEAR_TRAP,
+	"ROCKS_806":                      OBJECT_ROCKS_806,
+	"ROCKS_807":                      OBJECT_ROCKS_807,
+	"ROCKS_808":                      OBJECT_ROCKS_808,
+	"ROCKS_809":                      OBJECT_ROCKS_809,
+	"ROCKS_810":                      OBJECT_ROCKS_810,
+	"ROCKS_811":                      OBJECT_ROCKS_811,
+	"LEDGE_812":                      OBJECT_LEDGE_812,
+	"FURNACE_813":                    OBJECT_FURNACE_813,
+	"WELL_814":                       OBJECT_WELL_814,
+	"PASSAGE":                        OBJECT_PASSAGE,
+	"PASSAGE_816":                    OBJECT_PASSAGE_816,
+	"PASSAGE_817":                    OBJECT_PASSAGE_817,
+	"STALAGMITE_818":                 OBJECT_STALAGMITE_818,
+	"ROCKS_819":                      OBJECT_ROCKS_819,
+	"ROCKS_820":                      OBJECT_ROCKS_820,
+	"ROCKS_821":                      OBJECT_ROCKS_821,
+	"ROCKS_822":                      OBJECT_ROCKS_822,
+	"ROCKS_823":                      OBJECT_ROCKS_823,
+	"ROCKS_824":                      OBJECT_ROCKS_824,
+	"PASSAGE_825":                    OBJECT_PASSAGE_825,
+	"SNAP_TRAP":                      OBJECT_SNAP_TRAP,
+	"WOODEN_PLANKS":                  OBJECT_WOODEN_PLANKS,
+	"PASSAGE_828":                    OBJECT_PASSAGE_828,
+	"PASSAGE_829":                    OBJECT_PASSAGE_829,
+	"FLAMES_OF_ZAMORAK":              OBJECT_FLAMES_OF_ZAMORAK,
+	"PLATFORM":                       OBJECT_PLATFORM,
+	"ROCK_832":                       OBJECT_ROCK_832,
+	"ROCK_833":                       OBJECT_ROCK_833,
+	"ROCK_834":                       OBJECT_ROCK_834,
+	"ROCK_835":                       OBJECT_ROCK_835,
+	"WALL_GRILL":                     OBJECT_WALL_GRILL,
+	"LEDGE_837":                      OBJECT_LEDGE_837,
+	"WALL_GRILL_838":                 OBJECT_WALL_GRILL_838,
+	"DUG_UP_SOIL_839":                OBJECT_DUG_UP_SOIL_839,
+	"DUG_UP_SOIL_840":                OBJECT_DUG_UP_SOIL_840,
+	"PILE_OF_MUD_841":                OBJECT_PILE_OF_MUD_841,
+	"STALAGMITE_842":                 OBJECT_STALAGMITE_842,
+	"PILE_OF_MUD_843":                OBJECT_PILE_OF_MUD_843,
+	"PILE_OF_MUD_844":                OBJECT_PILE_OF_MUD_844,
+	"PILE_OF_MUD_845":                OBJECT_PILE_OF_MUD_845,
+	"PILE_OF_MUD_846":                OBJECT_PILE_OF_MUD_846,
+	"PILE_OF_MUD_847":                OBJECT_PILE_OF_MUD_847,
+	"SPIKED_PIT_848":                 OBJECT_SPIKED_PIT_848,
+	"ROCKS_849":                      OBJECT_ROCKS_849,
+	"ROCKS_850":                      OBJECT_ROCKS_850,
+	"ROCKS_851":                      OBJECT_ROCKS_851,
+	"ROCKS_852":                      OBJECT_ROCKS_852,
+	"ROCKS_853":                      OBJECT_ROCKS_853,
+	"ROCKS_854":                      OBJECT_ROCKS_854,
+	"ROCKS_855":                      OBJECT_ROCKS_855,
+	"ROCKS_856":                      OBJECT_ROCKS_856,
+	"ROCKS_857":                      OBJECT_ROCKS_857,
+	"ROCKS_858":                      OBJECT_ROCKS_858,
+	"ROCKS_859":                      OBJECT_ROCKS_859,
+	"ROCKS_860":                      OBJECT_ROCKS_860,
+	"LEDGE_861":                      OBJECT_LEDGE_861,
+	"LEDGE_862":                      OBJECT_LEDGE_862,
+	"LEDGE_863":                      OBJECT_LEDGE_863,
+	"LEDGE_864":                      OBJECT_LEDGE_864,
+	"LEDGE_865":                      OBJECT_LEDGE_865,
+	"LEDGE_866":                      OBJECT_LEDGE_866,
+	"BOULDER":                        OBJECT_BOULDER,
+	"CRATE_868":                      OBJECT_CRATE_868,
+	"DOOR":                           OBJECT_DOOR,
+	"PLATFORM_870":                   OBJECT_PLATFORM_870,
+	"CAGE_REMAINS":                   OBJECT_CAGE_REMAINS,
+	"LEDGE_872":                      OBJECT_LEDGE_872,
+	"PASSAGE_873":                    OBJECT_PASSAGE_873,
+	"PASSAGE_874":                    OBJECT_PASSAGE_874,
+	"GATE_OF_ZAMORAK":                OBJECT_GATE_OF_ZAMORAK,
+	"ROCKS_876":                      OBJECT_ROCKS_876,
+	"BRIDGE_SUPPORT":                 OBJECT_BRIDGE_SUPPORT,
+	"TOMB_OF_IBAN":                   OBJECT_TOMB_OF_IBAN,
+	"CLAWS_OF_IBAN":                  OBJECT_CLAWS_OF_IBAN,
+	"BARREL_880":                     OBJECT_BARREL_880,
+	"ROCK_881":                       OBJECT_ROCK_881,
+	"ROCKS_882":                      OBJECT_ROCKS_882,
+	"ROCKS_883":                      OBJECT_ROCKS_883,
+	"SWAMP_884":                      OBJECT_SWAMP_884,
+	"CHEST_885":                      OBJECT_CHEST_885,
+	"STONE_BRIDGE_886":               OBJECT_STONE_BRIDGE_886,
+	"CAGE_887":                       OBJECT_CAGE_887,
+	"CAGE_888":                       OBJECT_CAGE_888,
+	"STONE_STEPS":                    OBJECT_STONE_STEPS,
+	"PILE_OF_MUD_890":                OBJECT_PILE_OF_MUD_890,
+	"STONE_BRIDGE_891":               OBJECT_STONE_BRIDGE_891,
+	"STONE_BRIDGE_892":               OBJECT_STONE_BRIDGE_892,
+	"STONE_BRIDGE_893":               OBJECT_STONE_BRIDGE_893,
+	"STONE_BRIDGE_894":               OBJECT_STONE_BRIDGE_894,
+	"STONE_BRIDGE_895":               OBJECT_STONE_BRIDGE_895,
+	"STONE_BRIDGE_896":               OBJECT_STONE_BRIDGE_896,
+	"STONE_BRIDGE_897":               OBJECT_STONE_BRIDGE_897,
+	"STONE_BRIDGE_898":               OBJECT_STONE_BRIDGE_898,
+	"STONE_BRIDGE_899":               OBJECT_STONE_BRIDGE_899,
+	"STONE_BRIDGE_900":               OBJECT_STONE_BRIDGE_900,
+	"STONE_BRIDGE_901":               OBJECT_STONE_BRIDGE_901,
+	"STONE_BRIDGE_902":               OBJECT_STONE_BRIDGE_902,
+	"STONE_BRIDGE_903":               OBJECT_STONE_BRIDGE_903,
+	"STONE_BRIDGE_904":               OBJECT_STONE_BRIDGE_904,
+	"STONE_BRIDGE_905":               OBJECT_STONE_BRIDGE_905,
+	"STONE_BRIDGE_906":               OBJECT_STONE_BRIDGE_906,
+	"STONE_BRIDGE_907":               OBJECT_STONE_BRIDGE_907,
+	"STONE_BRIDGE_908":               OBJECT_STONE_BRIDGE_908,
+	"STONE_BRIDGE_909":               OBJECT_STONE_BRIDGE_909,
+	"STONE_BRIDGE_910":               OBJECT_STONE_BRIDGE_910,
+	"CHEST_911":                      OBJECT_CHEST_911,
+	"CHEST_912":                      OBJECT_CHEST_912,
+	"PIT_OF_THE_DAMNED":              OBJECT_PIT_OF_THE_DAMNED,
+	"OPEN_DOOR":                      OBJECT_OPEN_DOOR,
+	"SIGNPOST_915":                   OBJECT_SIGNPOST_915,
+	"STONE_GATE":                     OBJECT_STONE_GATE,
+	"CHEST_917":                      OBJECT_CHEST_917,
+	"ZODIAC":                         OBJECT_ZODIAC,
+	"CHEST_919":                      OBJECT_CHEST_919,
+	"LADDER_920":                     OBJECT_LADDER_920,
+	"STONE_STEPS_921":                OBJECT_STONE_STEPS_921,
+	"ROCK_922":                       OBJECT_ROCK_922,
+	"ROCK_923":                       OBJECT_ROCK_923,
+	"ROCK_924":                       OBJECT_ROCK_924,
+	"TELESCOPE":                      OBJECT_TELESCOPE,
+	"GATE_926":                       OBJECT_GATE_926,
+	"SACKS_927":                      OBJECT_SACKS_927,
+	"LADDER_928":                     OBJECT_LADDER_928,
+	"CHEST_929":                      OBJECT_CHEST_929,
+	"CHEST_930":                      OBJECT_CHEST_930,
+	"BOOKCASE_931":                   OBJECT_BOOKCASE_931,
+	"IRON_GATE":                      OBJECT_IRON_GATE,
+	"LADDER_933":                     OBJECT_LADDER_933,
+	"CHEST_934":                      OBJECT_CHEST_934,
+	"CHEST_935":                      OBJECT_CHEST_935,
+	"CHEST_936":                      OBJECT_CHEST_936,
+	"CHEST_937":                      OBJECT_CHEST_937,
+	"ROCKSLIDE_938":                  OBJECT_ROCKSLIDE_938,
+	"ALTAR_939":                      OBJECT_ALTAR_939,
+	"COLUMN":                         OBJECT_COLUMN,
+	"GRAVE_OF_SCORPIUS":              OBJECT_GRAVE_OF_SCORPIUS,
+	"BANK_CHEST":                     OBJECT_BANK_CHEST,
+	"DWARF_MULTICANNON":              OBJECT_DWARF_MULTICANNON,
+	"DISTURBED_SAND":                 OBJECT_DISTURBED_SAND,
+	"DISTURBED_SAND_945":             OBJECT_DISTURBED_SAND_945,
+	"DWARF_MULTICANNON_BASE":         OBJECT_DWARF_MULTICANNON_BASE,
+	"DWARF_MULTICANNON_STAND":        OBJECT_DWARF_MULTICANNON_STAND,
+	"DWARF_MULTICANNON_BARRELS":      OBJECT_DWARF_MULTICANNON_BARRELS,
+	"CAVE_949":                       OBJECT_CAVE_949,
+	"CAVE_950":                       OBJECT_CAVE_950,
+	"FENCE_951":                      OBJECT_FENCE_951,
+	"SIGNPOST_952":                   OBJECT_SIGNPOST_952,
+	"ROCKS_953":                      OBJECT_ROCKS_953,
+	"ROCKS_954":                      OBJECT_ROCKS_954,
+	"CAVE_ENTRANCE_955":              OBJECT_CAVE_ENTRANCE_955,
+	"CHEST_956":                      OBJECT_CHEST_956,
+	"CHEST_957":                      OBJECT_CHEST_957,
+	"WOODEN_DOORS":                   OBJECT_WOODEN_DOORS,
+	"PEDESTAL":                       OBJECT_PEDESTAL,
+	"BUSH_960":                       OBJECT_BUSH_960,
+	"BUSH_961":                       OBJECT_BUSH_961,
+	"STANDARD":                       OBJECT_STANDARD,
+	"MINING_CAVE":                    OBJECT_MINING_CAVE,
+	"MINING_CAVE_964":                OBJECT_MINING_CAVE_964,
+	"ROCKS_965":                      OBJECT_ROCKS_965,
+	"LIFT":                           OBJECT_LIFT,
+	"MINING_BARREL":                  OBJECT_MINING_BARREL,
+	"HOLE_968":                       OBJECT_HOLE_968,
+	"HOLE_969":                       OBJECT_HOLE_969,
+	"CAVE_970":                       OBJECT_CAVE_970,
+	"CAVE_971":                       OBJECT_CAVE_971,
+	"CAVE_972":                       OBJECT_CAVE_972,
+	"COUNTER_973":                    OBJECT_COUNTER_973,
+	"TRACK_974":                      OBJECT_TRACK_974,
+	"CAVE_975":                       OBJECT_CAVE_975,
+	"MINE_CART_976":                  OBJECT_MINE_CART_976,
+	"LIFT_PLATFORM":                  OBJECT_LIFT_PLATFORM,
+	"CHEST_978":                      OBJECT_CHEST_978,
+	"CHEST_979":                      OBJECT_CHEST_979,
+	"WATCH_TOWER_980":                OBJECT_WATCH_TOWER_980,
+	"LADDER_981":                     OBJECT_LADDER_981,
+	"CAVE_ENTRANCE_982":              OBJECT_CAVE_ENTRANCE_982,
+	"PILE_OF_MUD_983":                OBJECT_PILE_OF_MUD_983,
+	"CAVE_984":                       OBJECT_CAVE_984,
+	"LADDER_985":                     OBJECT_LADDER_985,
+	"CRATE_986":                      OBJECT_CRATE_986,
+	"CRATE_987":                      OBJECT_CRATE_987,
+	"GATE_988":                       OBJECT_GATE_988,
+	"GATE_989":                       OBJECT_GATE_989,
+	"BUSH_990":                       OBJECT_BUSH_990,
+	"BUSH_991":                       OBJECT_BUSH_991,
+	"BUSH_992":                       OBJECT_BUSH_992,
+	"BUSH_993":                       OBJECT_BUSH_993,
+	"MULTICANNON":                    OBJECT_MULTICANNON,
+	"ROCKS_995":                      OBJECT_ROCKS_995,
+	"ROCKS_996":                      OBJECT_ROCKS_996,
+	"LADDER_997":                     OBJECT_LADDER_997,
+	"CAVE_ENTRANCE_998":              OBJECT_CAVE_ENTRANCE_998,
+	"COUNTER_999":                    OBJECT_COUNTER_999,
+	"CHEST_1000":                     OBJECT_CHEST_1000,
+	"CHEST_1001":                     OBJECT_CHEST_1001,
+	"CHEST_1002":                     OBJECT_CHEST_1002,
+	"CHEST_1003":                     OBJECT_CHEST_1003,
+	"BOOKCASE_1004":                  OBJECT_BOOKCASE_1004,
+	"CAPTAINS_CHEST":                 OBJECT_CAPTAINS_CHEST,
+	"EXPERIMENTAL_ANVIL":             OBJECT_EXPERIMENTAL_ANVIL,
+	"ROCKS_1007":                     OBJECT_ROCKS_1007,
+	"ROCKS_1008":                     OBJECT_ROCKS_1008,
+	"COLUMN_1009":                    OBJECT_COLUMN_1009,
+	"WALL_1010":                      OBJECT_WALL_1010,
+	"WALL_1011":                      OBJECT_WALL_1011,
+	"WALL_1012":                      OBJECT_WALL_1012,
+	"WALL_1013":                      OBJECT_WALL_1013,
+	"LEVER_1014":                     OBJECT_LEVER_1014,
+	"LEVER_1015":                     OBJECT_LEVER_1015,
+	"WALL_1016":                      OBJECT_WALL_1016,
+	"LADDER_1017":                    OBJECT_LADDER_1017,
+	"WALL_1018":                      OBJECT_WALL_1018,
+	"GATE_1019":                      OBJECT_GATE_1019,
+	"GATE_1020":                      OBJECT_GATE_1020,
+	"LADDER_1021":                    OBJECT_LADDER_1021,
+	"SHOCK":                          OBJECT_SHOCK,
+	"DESK":                           OBJECT_DESK,
+	"CAVE_1024":                      OBJECT_CAVE_1024,
+	"MINING_CART":                    OBJECT_MINING_CART,
+	"ROCK_OF_DALGROTH":               OBJECT_ROCK_OF_DALGROTH,
+	"ENTRANCE":                       OBJECT_ENTRANCE,
+	"DRIED_CACTUS":                   OBJECT_DRIED_CACTUS,
+	"CLIMBING_ROCKS_1029":            OBJECT_CLIMBING_ROCKS_1029,
+	"ROCKS_1030":                     OBJECT_ROCKS_1030,
+	"LIGHTNING":                      OBJECT_LIGHTNING,
+	"CRUDE_DESK":                     OBJECT_CRUDE_DESK,
+	"HEAVY_METAL_GATE":               OBJECT_HEAVY_METAL_GATE,
+	"COUNTER_1034":                   OBJECT_COUNTER_1034,
+	"CRUDE_BED":                      OBJECT_CRUDE_BED,
+	"FLAMES":                         OBJECT_FLAMES,
+	"CARVED_ROCK":                    OBJECT_CARVED_ROCK,
+	"USE":                            OBJECT_USE,
+	"CRATE_1039":                     OBJECT_CRATE_1039,
+	"CRATE_1040":                     OBJECT_CRATE_1040,
+	"BARREL_1041":                    OBJECT_BARREL_1041,
+	"BRICK":                          OBJECT_BRICK,
+	"BRICK_1043":                     OBJECT_BRICK_1043,
+	"BRICK_1044":                     OBJECT_BRICK_1044,
+	"BRICK_1045":                     OBJECT_BRICK_1045,
+	"BRICK_1046":                     OBJECT_BRICK_1046,
+	"BRICK_1047":                     OBJECT_BRICK_1047,
+	"BARRIER":                        OBJECT_BARRIER,
+	"BURIED_SKELETON":                OBJECT_BURIED_SKELETON,
+	"BRICK_1050":                     OBJECT_BRICK_1050,
+	"BRICK_1051":                     OBJECT_BRICK_1051,
+	"SPECIMEN_TRAY":                  OBJECT_SPECIMEN_TRAY,
+	"WINCH":                          OBJECT_WINCH,
+	"CRATE_1054":                     OBJECT_CRATE_1054,
+	"CRATE_1055":                     OBJECT_CRATE_1055,
+	"URN":                            OBJECT_URN,
+	"BURIED_SKELETON_1057":           OBJECT_BURIED_SKELETON_1057,
+	"PANNING_POINT":                  OBJECT_PANNING_POINT,
+	"ROCKS_1059":                     OBJECT_ROCKS_1059,
+	"SIGNPOST_1060":                  OBJECT_SIGNPOST_1060,
+	"SIGNPOST_1061":                  OBJECT_SIGNPOST_1061,
+	"SIGNPOST_1062":                  OBJECT_SIGNPOST_1062,
+	"SIGNPOST_1063":                  OBJECT_SIGNPOST_1063,
+	"SIGNPOST_1064":                  OBJECT_SIGNPOST_1064,
+	"SOIL":                           OBJECT_SOIL,
+	"SOIL_1066":                      OBJECT_SOIL_1066,
+	"SOIL_1067":                      OBJECT_SOIL_1067,
+	"GATE_1068":                      OBJECT_GATE_1068,
+	"SHIP_1069":                      OBJECT_SHIP_1069,
+	"BARREL_1070":                    OBJECT_BARREL_1070,
+	"LEAK":                           OBJECT_LEAK,
+	"BUSH_1072":                      OBJECT_BUSH_1072,
+	"BUSH_1073":                      OBJECT_BUSH_1073,
+	"CUPBOARD_1074":                  OBJECT_CUPBOARD_1074,
+	"SACKS_1075":                     OBJECT_SACKS_1075,
+	"SACKS_1076":                     OBJECT_SACKS_1076,
+	"LEAK_1077":                      OBJECT_LEAK_1077,
+	"CUPBOARD_1078":                  OBJECT_CUPBOARD_1078,
+	"WROUGHT_MITHRIL_GATES":          OBJECT_WROUGHT_MITHRIL_GATES,
+	"LEGENDS_HALL_DOORS":             OBJECT_LEGENDS_HALL_DOORS,
+	"CAMP_BED":                       OBJECT_CAMP_BED,
+	"BARREL_1082":                    OBJECT_BARREL_1082,
+	"BARREL_1083":                    OBJECT_BARREL_1083,
+	"CHEST_1084":                     OBJECT_CHEST_1084,
+	"CHEST_1085":                     OBJECT_CHEST_1085,
+	"DENSE_JUNGLE_TREE":              OBJECT_DENSE_JUNGLE_TREE,
+	"JUNGLE_TREE_STUMP":              OBJECT_JUNGLE_TREE_STUMP,
+	"SIGNPOST_1088":                  OBJECT_SIGNPOST_1088,
+	"GATE_1089":                      OBJECT_GATE_1089,
+	"BOOKCASE_1090":                  OBJECT_BOOKCASE_1090,
+	"DENSE_JUNGLE_TREE_1091":         OBJECT_DENSE_JUNGLE_TREE_1091,
+	"DENSE_JUNGLE_TREE_1092":         OBJECT_DENSE_JUNGLE_TREE_1092,
+	"SPRAY":                          OBJECT_SPRAY,
+	"SPRAY_1094":                     OBJECT_SPRAY_1094,
+	"WINCH_1095":                     OBJECT_WINCH_1095,
+	"BRICK_1096":                     OBJECT_BRICK_1096,
+	"ROPE":                           OBJECT_ROPE,
+	"ROPE_1098":                      OBJECT_ROPE_1098,
+	"DENSE_JUNGLE_PALM":              OBJECT_DENSE_JUNGLE_PALM,
+	"DENSE_JUNGLE_PALM_1100":         OBJECT_DENSE_JUNGLE_PALM_1100,
+	"TRAWLER_NET":                    OBJECT_TRAWLER_NET,
+	"TRAWLER_NET_1102":               OBJECT_TRAWLER_NET_1102,
+	"BRICK_1103":                     OBJECT_BRICK_1103,
+	"CHEST_1104":                     OBJECT_CHEST_1104,
+	"CHEST_1105":                     OBJECT_CHEST_1105,
+	"TRAWLER_CATCH":                  OBJECT_TRAWLER_CATCH,
+	"YOMMI_TREE":                     OBJECT_YOMMI_TREE,
+	"GROWN_YOMMI_TREE":               OBJECT_GROWN_YOMMI_TREE,
+	"CHOPPED_YOMMI_TREE":             OBJECT_CHOPPED_YOMMI_TREE,
+	"TRIMMED_YOMMI_TREE":             OBJECT_TRIMMED_YOMMI_TREE,
+	"TOTEM_POLE":                     OBJECT_TOTEM_POLE,
+	"BABY_YOMMI_TREE":                OBJECT_BABY_YOMMI_TREE,
+	"FERTILE_EARTH":                  OBJECT_FERTILE_EARTH,
+	"ROCK_HEWN_STAIRS":               OBJECT_ROCK_HEWN_STAIRS,
+	"HANGING_ROPE":                   OBJECT_HANGING_ROPE,
+	"ROCKS_1116":                     OBJECT_ROCKS_1116,
+	"BOULDER_1117":                   OBJECT_BOULDER_1117,
+	"DWARF_MULTICANNON_1118":         OBJECT_DWARF_MULTICANNON_1118,
+	"DWARF_MULTICANNON_BASE_1119":    OBJECT_DWARF_MULTICANNON_BASE_1119,
+	"DWARF_MULTICANNON_STAND_1120":   OBJECT_DWARF_MULTICANNON_STAND_1120,
+	"DWARF_MULTICANNON_BARRELS_1121": OBJECT_DWARF_MULTICANNON_BARRELS_1121,
+	"ROCK_1122":                      OBJECT_ROCK_1122,
+	"ROCK_HEWN_STAIRS_1123":          OBJECT_ROCK_HEWN_STAIRS_1123,
+	"ROCK_HEWN_STAIRS_1124":          OBJECT_ROCK_HEWN_STAIRS_1124,
+	"ROCK_HEWN_STAIRS_1125":          OBJECT_ROCK_HEWN_STAIRS_1125,
+	"COMPOST_HEAP_1126":              OBJECT_COMPOST_HEAP_1126,
+	"BEEHIVE_1127":                   OBJECT_BEEHIVE_1127,
+	"DRAIN_1128":                     OBJECT_DRAIN_1128,
+	"WEB_1129":                       OBJECT_WEB_1129,
+	"FOUNTAIN_1130":                  OBJECT_FOUNTAIN_1130,
+	"SINCLAIR_CREST":                 OBJECT_SINCLAIR_CREST,
+	"BARREL_1132":                    OBJECT_BARREL_1132,
+	"BARREL_1133":                    OBJECT_BARREL_1133,
+	"BARREL_1134":                    OBJECT_BARREL_1134,
+	"BARREL_1135":                    OBJECT_BARREL_1135,
+	"BARREL_1136":                    OBJECT_BARREL_1136,
+	"BARREL_1137":                    OBJECT_BARREL_1137,
+	"FLOUR_BARREL":                   OBJECT_FLOUR_BARREL,
+	"SACKS_1139":                     OBJECT_SACKS_1139,
+	"GATE_1140":                      OBJECT_GATE_1140,
+	"DEAD_YOMMI_TREE":                OBJECT_DEAD_YOMMI_TREE,
+	"CLAWSPELL":                      OBJECT_CLAWSPELL,
+	"ROCKS_1143":                     OBJECT_ROCKS_1143,
+	"CRATE_1144":                     OBJECT_CRATE_1144,
+	"CAVERNOUS_OPENING":              OBJECT_CAVERNOUS_OPENING,
+	"ANCIENT_LAVA_FURNACE":           OBJECT_ANCIENT_LAVA_FURNACE,
+	"SPELLCHARGE":                    OBJECT_SPELLCHARGE,
+	"ROCKS_1148":                     OBJECT_ROCKS_1148,
+	"CUPBOARD_1149":                  OBJECT_CUPBOARD_1149,
+	"SACKS_1150":                     OBJECT_SACKS_1150,
+	"ROCK_1151":                      OBJECT_ROCK_1151,
+	"SARADOMIN_STONE":                OBJECT_SARADOMIN_STONE,
+	"GUTHIX_STONE":                   OBJECT_GUTHIX_STONE,
+	"ZAMORAK_STONE":                  OBJECT_ZAMORAK_STONE,
+	"MAGICAL_POOL":                   OBJECT_MAGICAL_POOL,
+	"WOODEN_BEAM":                    OBJECT_WOODEN_BEAM,
+	"ROPE_DOWN_INTO_DARKNESS":        OBJECT_ROPE_DOWN_INTO_DARKNESS,
+	"CAVE_ENTRANCE_1158":             OBJECT_CAVE_ENTRANCE_1158,
+	"CAVE_ENTRANCE_1159":             OBJECT_CAVE_ENTRANCE_1159,
+	"ANCIENT_WOODEN_DOORS":           OBJECT_ANCIENT_WOODEN_DOORS,
+	"TABLE_1161":                     OBJECT_TABLE_1161,
+	"CRUDE_BED_1162":                 OBJECT_CRUDE_BED_1162,
+	"TALL_REEDS":                     OBJECT_TALL_REEDS,
+	"GOBLIN_FOOT_PRINTS":             OBJECT_GOBLIN_FOOT_PRINTS,
+	"DARK_METAL_GATE":                OBJECT_DARK_METAL_GATE,
+	"MAGICAL_POOL_1166":              OBJECT_MAGICAL_POOL_1166,
+	"ROPE_UP":                        OBJECT_ROPE_UP,
+	"HALF_BURIED_REMAINS":            OBJECT_HALF_BURIED_REMAINS,
+	"TOTEM_POLE_1169":                OBJECT_TOTEM_POLE_1169,
+	"TOTEM_POLE_1170":                OBJECT_TOTEM_POLE_1170,
+	"COMFY_BED":                      OBJECT_COMFY_BED,
+	"ROTTEN_YOMMI_TREE":              OBJECT_ROTTEN_YOMMI_TREE,
+	"ROTTEN_YOMMI_TREE_1173":         OBJECT_ROTTEN_YOMMI_TREE_1173,
+	"ROTTEN_YOMMI_TREE_1174":         OBJECT_ROTTEN_YOMMI_TREE_1174,
+	"ROTTEN_TOTEM_POLE":              OBJECT_ROTTEN_TOTEM_POLE,
+	"LEAFY_PALM_TREE":                OBJECT_LEAFY_PALM_TREE,
+	"GRAND_VIZIERS_DESK":             OBJECT_GRAND_VIZIERS_DESK,
+	"STRANGE_BARREL":                 OBJECT_STRANGE_BARREL,
+	"SHIP_1179":                      OBJECT_SHIP_1179,
+	"SHIP_1180":                      OBJECT_SHIP_1180,
+	"SHIP_1181":                      OBJECT_SHIP_1181,
+	"DIGSITE_BED":                    OBJECT_DIGSITE_BED,
+	"TEA_STALL":                      OBJECT_TEA_STALL,
+	"BOULDER_1184":                   OBJECT_BOULDER_1184,
+	"BOULDER_1185":                   OBJECT_BOULDER_1185,
+	"DAMAGED_EARTH":                  OBJECT_DAMAGED_EARTH,
+	"LADDER_1187":                    OBJECT_LADDER_1187,
+	"LADDER_1188":                    OBJECT_LADDER_1188,
+}
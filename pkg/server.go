@@ -52,6 +52,7 @@ type (
 		Port      int    `short:"p" long:"port" description:"The TCP port for the game to listen on, (Websocket will use the port directly above it)"`
 		Config    string `short:"c" long:"config" description:"Specify the TOML configuration file to load game settings from" default:"config.toml"`
 		UseCipher bool   `short:"e" long:"encryption" description:"Enable command opcode encryption using a variant of ISAAC to encrypt net opcodes."`
+		Invariants bool  `long:"invariants" description:"Audit every item exchange for duplication or loss, abandoning any that do not balance"`
 	}
 	Server struct {
 		port int
@@ -87,6 +88,8 @@ func main() {
 	config.TomlConfig.Crypto.HashSalt = "rscgo./GOLANG!RULES/.1994"
 	config.TomlConfig.Version = 204
 	config.TomlConfig.Port = 43594 // +1 for websockets
+	config.TomlConfig.Death.Policy = "classic"
+	config.TomlConfig.Pvp.Preset = "classic"
 
 	if _, err := flags.Parse(cliFlags); err != nil {
 		log.Warn("Error parsing command arguments:", cliFlags)
@@ -126,13 +129,28 @@ func main() {
 	}
 
 	config.Verbosity = len(cliFlags.Verbose)
+	world.SetDeathPolicy(config.DeathPolicy(), areas("safe zone", config.SafeZones()))
+	world.SetPvpRules(config.PvpPreset(), areas("multi-combat zone", config.MultiZones()))
+	world.GravestoneTicks = config.Gravestone() * world.TicksMinute / 60
+	world.DebugInvariants = cliFlags.Invariants
+	world.Sandbox.Steps = config.ScriptSteps()
+	world.Sandbox.Timeout = time.Duration(config.ScriptTimeout()) * time.Second
+	if imports := config.ScriptImports(); imports != nil {
+		world.SetScriptImports(imports)
+	} else {
+		world.SetScriptImports(world.DefaultScriptImports)
+	}
 	run(db.ConnectEntityService, func() {
 		db.DefaultPlayerService, world.DefaultPlayerService = db.NewPlayerServiceSql(), db.NewPlayerServiceSql()
+		world.DefaultLedgerService = db.NewLedgerServiceSql()
+		world.DefaultShopService = db.NewShopServiceSql()
+		world.DefaultMailService = db.NewMailServiceSql()
+		world.DefaultVarService = db.NewVarServiceSql()
 	})
 	// Three init phases after data backend is connected--Entity definitions, then tile collision bitmask loading, followed by entity spawn locations
 	// So, the order here of these three phases is important.  If you attempt to load object spawn locations during the same phase as the collision
 	// data, it will result in a world filled with objects that are not solid.  Many similar bugs possible.  Best just to leave this be.
-	run(db.LoadTileDefinitions, db.LoadObjectDefinitions, db.LoadBoundaryDefinitions, db.LoadItemDefinitions, db.LoadNpcDefinitions)
+	run(db.LoadTileDefinitions, db.LoadObjectDefinitions, db.LoadBoundaryDefinitions, db.LoadItemDefinitions, db.LoadNpcDefinitions, db.LoadShops, world.LoadWorldVars)
 	run(world.LoadCollisionData, world.RunScripts)
 	run(db.LoadObjectLocations, db.LoadNpcLocations, db.LoadItemLocations)
 
@@ -143,9 +161,10 @@ func main() {
 		log.Debug("Loaded", world.Npcs.Size(), "NPCs and", len(definitions.Npcs), "NPC definitions")
 		log.Debug("Loaded", len(definitions.ScenaryObjects), "scenary definitions, and", len(definitions.BoundaryObjects), "boundary definitions")
 		log.Debug("Loaded", world.ObjectCounter.Load(), "scenary / boundary objects")
+		log.Debug("Loaded", len(world.Shops.Names()), "shops")
 		log.Debug("Loading all game entitys took:", time.Since(start).Seconds(), "seconds")
 		if config.Verbosity >= 2 {
-			log.Debugf("Triggers[\n\t%d item actions,\n\t%d scenary actions,\n\t%d boundary actions,\n\t%d npc actions,\n\t%d item->boundary actions,\n\t%d item->scenary actions,\n\t%d attacking NPC actions,\n\t%d killing NPC actions\n];\n", len(world.ItemTriggers), len(world.ObjectTriggers), len(world.BoundaryTriggers), len(world.NpcTriggers), len(world.InvOnBoundaryTriggers), len(world.InvOnObjectTriggers), len(world.NpcAtkTriggers), len(world.NpcDeathTriggers))
+			log.Debugf("Loaded scripts version %d: %v\n", world.LiveScripts().Version, world.LiveScripts())
 		}
 	}
 	log.Debug("Listening at TCP port " + strconv.Itoa(config.Port()))// + " (TCP), " + strconv.Itoa(config.WSPort()) + " (websockets)")
@@ -416,6 +435,7 @@ func (s *Server) Bind(port int) bool {
 					sendReply(handshake.ResponseDecodeFailure, "Could not load player profile; is the dataService setup properly?")
 					continue
 				}
+				world.LoadVars(player)
 
 				if player.Reconnecting() {
 					sendReply(handshake.ResponseReconnected, "")
@@ -438,92 +458,35 @@ func (s *Server) Bind(port int) bool {
 	return false
 }
 
-func (s *Server) handlePackets(p *world.Player) {
-	go func() {
-		for {
-			select {
-			default:
-				return
-			case p1, ok := <-p.InQueue:
-				if !ok || p1 == nil {
-					return
-				}
-				if handlePacket := game.Handler(p1.Opcode); handlePacket != nil {
-					handlePacket(p, p1)
-				}
-			}
-		}
-	}()
-}
-
+//Start Binds the game to its configured ports, then runs the game engine tick pipeline once every TickMillis,
+// until the game is stopped.
 func (s *Server) Start() {
 	s.Bind(config.Port())
 	defer s.Ticker.Stop()
+	tasks.TickPhases.Budget = TickMillis
+	s.addPhases(tasks.TickPhases)
 	for range s.C {
-		tasks.TickList.Tick()
-
-		world.Players.Range(func(p *world.Player) {
-			if p == nil {
-				return
-			}
-			s.handlePackets(p)
-			p.Tickables.Call(interface{}(p))
-			
-			if fn := p.TickAction(); fn != nil && !fn() {
-				p.ResetTickAction()
-			}
-			p.TraversePath()
-		})
-		world.UpdateNPCPositions()
-
-		world.Players.Range(func(p *world.Player) {
-			if p == nil {
-				return
-			}
-			if positions := world.PlayerPositions(p); positions != nil {
-				p.SendPacket(positions)
-			}
-			if appearances := world.PlayerAppearances(p); appearances != nil {
-				p.SendPacket(appearances)
-			}
-			if npcUpdates := world.NPCPositions(p); npcUpdates != nil {
-				p.SendPacket(npcUpdates)
-			}
-			if npcUpdates := world.NpcEvents(p); npcUpdates != nil {
-				p.SendPacket(npcUpdates)
-			}
-			if objectUpdates := world.ObjectLocations(p); objectUpdates != nil {
-				p.SendPacket(objectUpdates)
-			}
-			if boundaryUpdates := world.BoundaryLocations(p); boundaryUpdates != nil {
-				p.SendPacket(boundaryUpdates)
-			}
-			if itemUpdates := world.ItemLocations(p); itemUpdates != nil {
-				p.SendPacket(itemUpdates)
-			}
-			if clearDistantChunks := world.ClearDistantChunks(p); clearDistantChunks != nil {
-				p.SendPacket(clearDistantChunks)
-			}
-		})
-
-		world.Players.Range(func(p *world.Player) {
-			if p == nil {
-				return
-			}
-			p.PostTickables.Call(interface{}(p))
-			p.ResetRegionRemoved()
-			p.ResetRegionMoved()
-			p.ResetSpriteUpdated()
-			p.ResetAppearanceChanged()
-			// p.Writer.Flush()
-		})
-		world.ResetNpcUpdateFlags()
-		world.Ticks.Inc()
+		tasks.TickPhases.Run()
 	}
 }
 
 //Stop This will stop the game instance, if it is running.
 func (s *Server) Stop() {
 	log.Debug("Stopping...")
+	world.FlushVars()
+	world.FlushLedger()
 	os.Exit(0)
 }
+
+//areas converts [minX, minY, maxX, maxY] lists from the config into areas of the game world, skipping any that are
+// malformed.
+func areas(kind string, zones [][]int) (areas [][2]world.Location) {
+	for _, zone := range zones {
+		if len(zone) != 4 {
+			log.Warn("Ignoring "+kind+" that is not [minX, minY, maxX, maxY]:", zone)
+			continue
+		}
+		areas = append(areas, [2]world.Location{world.NewLocation(zone[0], zone[1]), world.NewLocation(zone[2], zone[3])})
+	}
+	return
+}
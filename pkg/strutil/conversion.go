@@ -307,12 +307,12 @@ func init() {
 				s = " " + s
 			} else if remainder < 27 {
 				if i%37 == 0 {
-					s = string(remainder+64) + s
+					s = string(rune(remainder+64)) + s
 				} else {
-					s = string(remainder+96) + s
+					s = string(rune(remainder+96)) + s
 				}
 			} else {
-				s = string(remainder+21) + s
+				s = string(rune(remainder+21)) + s
 			}
 		}
 
@@ -362,9 +362,9 @@ func init() {
 			remainder := i % uint64(base)
 			i /= uint64(base)
 			if remainder >= 10 {
-				s = string(remainder+'A'-10) + s
+				s = string(rune(remainder+'A'-10)) + s
 			} else {
-				s = string(remainder+'0') + s
+				s = string(rune(remainder+'0')) + s
 			}
 		}
 		return s
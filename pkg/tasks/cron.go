@@ -0,0 +1,425 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spkaeros/rscgo/pkg/log"
+)
+
+// Calendar scheduling.
+//
+// Schedule and TickList run things every so many ticks, which drifts away from the wall clock over time and starts
+// over whenever the server restarts.  The Crontab runs jobs at calendar times instead, e.g every day at midnight, or
+// every 2 hours since the last time it ran.  It remembers when each job last ran through its CronStore, so that a
+// restart neither runs a job twice for the same time, nor forgets about one that came due while the server was down.
+// A job that was missed while the server was down runs once, as soon as it is added back.
+//
+// Specs are written the same way as in a crontab: five fields for the minute (0-59), hour (0-23), day of the month
+// (1-31), month (1-12 or jan-dec) and day of the week (0-6 or sun-sat), each either *, a value, a range a-b, a list
+// of those separated by commas, or any of those followed by /step.  As in cron, when both the day of the month and the
+// day of the week are restricted, a day matching either one will do.  The shorthands @hourly, @daily, @midnight,
+// @weekly, @monthly and @yearly are understood, and so is "@every <duration>", e.g "@every 2h".
+
+//CronSchedule Works out when a scheduled job should run.
+type CronSchedule interface {
+	//Next returns the first time after t that the job should run, or the zero time if it never will.
+	Next(t time.Time) time.Time
+}
+
+//CronStore An interface for remembering when scheduled jobs last ran, across restarts.
+type CronStore interface {
+	//CronLastRun returns the last time the named job ran, or the zero time if it never has.
+	CronLastRun(name string) time.Time
+	//CronRan records that the named job ran at t.
+	CronRan(name string, t time.Time)
+}
+
+//CronJob A callback that runs at the times described by its spec.
+type CronJob struct {
+	Name string
+	Spec string
+	//Scripted is true for jobs bound by scripts, which get replaced whenever the scripts are reloaded.
+	Scripted bool
+	schedule CronSchedule
+	fn       func()
+	// last is when the job last ran, and next is when it will run again.
+	last, next time.Time
+	// triggered is set to run the job on the next tick, regardless of its schedule.
+	triggered bool
+}
+
+//NewCronJob Returns a new job named name that calls fn at the times described by spec.
+func NewCronJob(name, spec string, fn func()) (*CronJob, error) {
+	if fn == nil {
+		return nil, errors.New("scheduled job '" + name + "' has no callback")
+	}
+	schedule, err := ParseCron(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &CronJob{Name: name, Spec: spec, schedule: schedule, fn: fn}, nil
+}
+
+//LastRun Returns when this job last ran, or the zero time if it never has.
+func (j *CronJob) LastRun() time.Time {
+	return j.last
+}
+
+//NextRun Returns when this job will next run, or the zero time if it never will.
+func (j *CronJob) NextRun() time.Time {
+	return j.next
+}
+
+//Cron A set of jobs that run at calendar times.  Jobs are ran from the game engines thread by calling Run once a tick.
+type Cron struct {
+	jobs map[string]*CronJob
+	// cancelled holds the scripted jobs that have been cancelled, by name, so that reloading the scripts does not bring
+	// them back.
+	cancelled map[string]*CronJob
+	//Store remembers when each job last ran.  If nil, it is only remembered until the server restarts.
+	Store CronStore
+	sync.Mutex
+}
+
+//NewCron Returns a new, empty set of scheduled jobs.
+func NewCron() *Cron {
+	return &Cron{jobs: make(map[string]*CronJob)}
+}
+
+//Crontab The jobs that the game engine runs at calendar times.
+var Crontab = NewCron()
+
+//Add Adds job to this set, replacing any job that has the same name, and works out when it next runs from when it
+// last ran.
+func (c *Cron) Add(job *CronJob) {
+	c.Lock()
+	defer c.Unlock()
+	c.add(job, time.Now())
+}
+
+func (c *Cron) add(job *CronJob, now time.Time) {
+	if c.Store != nil {
+		job.last = c.Store.CronLastRun(job.Name)
+	}
+	if job.last.IsZero() {
+		job.next = job.schedule.Next(now)
+	} else {
+		job.next = job.schedule.Next(job.last)
+	}
+	c.jobs[job.Name] = job
+}
+
+//Schedule Adds a job named name to this set that calls fn at the times described by spec.
+func (c *Cron) Schedule(name, spec string, fn func()) error {
+	job, err := NewCronJob(name, spec, fn)
+	if err != nil {
+		return err
+	}
+	c.Add(job)
+	return nil
+}
+
+//SwapScripted Replaces every job that was bound by scripts with the provided jobs.  Jobs with the same name as one
+// added from Go are left out, as are jobs that have been cancelled, until they are resumed.
+func (c *Cron) SwapScripted(jobs []*CronJob) {
+	c.Lock()
+	defer c.Unlock()
+	for name, job := range c.jobs {
+		if job.Scripted {
+			delete(c.jobs, name)
+		}
+	}
+	cancelled := c.cancelled
+	c.cancelled = nil
+	now := time.Now()
+	for _, job := range jobs {
+		job.Scripted = true
+		if _, ok := c.jobs[job.Name]; ok {
+			log.Warn("Scheduled job '" + job.Name + "' from the scripts has the same name as a built-in job, so it was left out")
+			continue
+		}
+		if _, ok := cancelled[job.Name]; ok {
+			c.cancel(job)
+			continue
+		}
+		c.add(job, now)
+	}
+}
+
+//Cancel Removes the named job from this set.  Jobs bound by scripts stay cancelled when the scripts are reloaded,
+// until they are resumed.  Returns true if there was such a job.
+func (c *Cron) Cancel(name string) bool {
+	c.Lock()
+	defer c.Unlock()
+	job, ok := c.jobs[name]
+	if ok {
+		delete(c.jobs, name)
+		if job.Scripted {
+			c.cancel(job)
+		}
+	}
+	return ok
+}
+
+func (c *Cron) cancel(job *CronJob) {
+	if c.cancelled == nil {
+		c.cancelled = make(map[string]*CronJob)
+	}
+	c.cancelled[job.Name] = job
+}
+
+//Resume Puts back the named job, bound by scripts, that was cancelled.  Returns true if there was such a job.
+func (c *Cron) Resume(name string) bool {
+	c.Lock()
+	defer c.Unlock()
+	job, ok := c.cancelled[name]
+	if ok {
+		delete(c.cancelled, name)
+		c.add(job, time.Now())
+	}
+	return ok
+}
+
+//Trigger Runs the named job on the next call to Run, whether it is due or not.  Its schedule is left alone.
+// Returns true if there is such a job.
+func (c *Cron) Trigger(name string) bool {
+	c.Lock()
+	defer c.Unlock()
+	job, ok := c.jobs[name]
+	if ok {
+		job.triggered = true
+	}
+	return ok
+}
+
+//Jobs Returns a copy of every job in this set, in the order they will next run.  Jobs that will never run again come
+// last.
+func (c *Cron) Jobs() []CronJob {
+	c.Lock()
+	defer c.Unlock()
+	jobs := make([]CronJob, 0, len(c.jobs))
+	for _, job := range c.jobs {
+		jobs = append(jobs, *job)
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		if jobs[i].next.IsZero() != jobs[j].next.IsZero() {
+			return jobs[j].next.IsZero()
+		}
+		if !jobs[i].next.Equal(jobs[j].next) {
+			return jobs[i].next.Before(jobs[j].next)
+		}
+		return jobs[i].Name < jobs[j].Name
+	})
+	return jobs
+}
+
+//Run Runs every job that is due at now, along with any that have been triggered, and works out when each of them runs
+// next.  A job that panics is logged, and left scheduled.
+func (c *Cron) Run(now time.Time) {
+	var due []*CronJob
+	c.Lock()
+	for _, job := range c.jobs {
+		if job.triggered {
+			job.triggered = false
+			due = append(due, job)
+			continue
+		}
+		if job.next.IsZero() || now.Before(job.next) {
+			continue
+		}
+		job.last = now
+		job.next = job.schedule.Next(now)
+		if c.Store != nil {
+			c.Store.CronRan(job.Name, now)
+		}
+		due = append(due, job)
+	}
+	c.Unlock()
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].Name < due[j].Name
+	})
+	for _, job := range due {
+		job.run()
+	}
+}
+
+func (j *CronJob) run() {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Warn("Recovered from a panic in scheduled job '"+j.Name+"':", r)
+		}
+	}()
+	j.fn()
+}
+
+//ParseCron Returns the schedule described by spec.
+func ParseCron(spec string) (CronSchedule, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "@every ") {
+		d, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("schedule '%s': %v", spec, err)
+		}
+		if d < time.Minute {
+			return nil, fmt.Errorf("schedule '%s': can not run more than once a minute", spec)
+		}
+		return everySchedule(d), nil
+	}
+	if s, ok := cronShorthands[spec]; ok {
+		spec = s
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("schedule '%s': want 5 fields, got %d", spec, len(fields))
+	}
+	var s calendarSchedule
+	for i, r := range cronFields {
+		bits, err := r.parse(fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("schedule '%s': %v", spec, err)
+		}
+		s.fields[i] = bits
+	}
+	// Sunday can be written as either 0 or 7
+	if s.fields[4]&(1<<7) != 0 {
+		s.fields[4] |= 1
+	}
+	s.anyDay = fields[2] == "*"
+	s.anyWeekday = fields[4] == "*"
+	return s, nil
+}
+
+var cronShorthands = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+}
+
+//everySchedule Runs a job once every so often, counting from the last time it ran.
+type everySchedule time.Duration
+
+func (s everySchedule) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(s))
+}
+
+//cronField The values one field of a cron spec may take.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+var cronFields = [5]cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of the month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	{name: "day of the week", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
+
+//value returns the number that s stands for in this field.
+func (f cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s '%s' is not within %d-%d", f.name, s, f.min, f.max)
+	}
+	return v, nil
+}
+
+//parse returns a bit set of every value that the field s matches.
+func (f cronField) parse(s string) (bits uint64, err error) {
+	for _, part := range strings.Split(s, ",") {
+		step := 1
+		if i := strings.IndexByte(part, '/'); i >= 0 {
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step <= 0 {
+				return 0, fmt.Errorf("%s step '%s' is not a positive number", f.name, part[i+1:])
+			}
+			part = part[:i]
+		}
+		lo, hi := f.min, f.max
+		if part != "*" {
+			bounds := strings.SplitN(part, "-", 2)
+			if lo, err = f.value(bounds[0]); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if len(bounds) == 2 {
+				if hi, err = f.value(bounds[1]); err != nil {
+					return 0, err
+				}
+			} else if step > 1 {
+				hi = f.max
+			}
+			if hi < lo {
+				return 0, fmt.Errorf("%s range '%s' runs backwards", f.name, part)
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+//calendarSchedule Runs a job at the times that match a five field cron spec.
+type calendarSchedule struct {
+	fields             [5]uint64
+	anyDay, anyWeekday bool
+}
+
+//day returns true if the job may run at some point on the day of t.
+func (s calendarSchedule) day(t time.Time) bool {
+	monthDay := s.fields[2]&(1<<uint(t.Day())) != 0
+	weekDay := s.fields[4]&(1<<uint(t.Weekday())) != 0
+	if s.anyDay || s.anyWeekday {
+		return monthDay && weekDay
+	}
+	return monthDay || weekDay
+}
+
+// cronSearch is how far ahead Next looks for a matching time before deciding that there is none, e.g for Feb 30.
+const cronSearch = 5 * 366 * 24 * time.Hour
+
+func (s calendarSchedule) Next(t time.Time) time.Time {
+	limit := t.Add(cronSearch)
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	for t.Before(limit) {
+		switch {
+		case s.fields[3]&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.day(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case s.fields[1]&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case s.fields[0]&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
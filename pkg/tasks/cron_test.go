@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+
+package tasks
+
+import (
+	"testing"
+	"time"
+)
+
+//memoryCron A CronStore that remembers last-run times in memory, for testing.
+type memoryCron map[string]time.Time
+
+func (m memoryCron) CronLastRun(name string) time.Time {
+	return m[name]
+}
+
+func (m memoryCron) CronRan(name string, t time.Time) {
+	m[name] = t
+}
+
+func date(s string) time.Time {
+	t, err := time.ParseInLocation("2006-01-02 15:04", s, time.Local)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		spec, from, want string
+	}{
+		{"0 0 * * sat", "2026-10-19 12:30", "2026-10-24 00:00"},
+		{"*/15 * * * *", "2026-10-19 12:30", "2026-10-19 12:45"},
+		{"@daily", "2026-12-31 23:59", "2027-01-01 00:00"},
+		{"30 9-17/4 * * mon-fri", "2026-10-23 17:31", "2026-10-26 09:30"},
+		{"0 0 13 * 5", "2026-10-19 00:00", "2026-10-23 00:00"},
+		{"0 12 1 feb,mar *", "2026-10-19 00:00", "2027-02-01 12:00"},
+		{"@every 2h", "2026-10-19 12:30", "2026-10-19 14:30"},
+		{"0 0 30 2 *", "2026-10-19 00:00", ""},
+	}
+	for _, test := range tests {
+		s, err := ParseCron(test.spec)
+		if err != nil {
+			t.Errorf("ParseCron(%q): %v", test.spec, err)
+			continue
+		}
+		got := s.Next(date(test.from))
+		if test.want == "" {
+			if !got.IsZero() {
+				t.Errorf("%q after %s = %v, want never", test.spec, test.from, got)
+			}
+			continue
+		}
+		if !got.Equal(date(test.want)) {
+			t.Errorf("%q after %s = %v, want %s", test.spec, test.from, got, test.want)
+		}
+	}
+	for _, spec := range []string{"* * * *", "60 * * * *", "* * * * fri-mon", "*/0 * * * *", "@every 1s", "@every soon"} {
+		if _, err := ParseCron(spec); err == nil {
+			t.Errorf("ParseCron(%q) did not fail", spec)
+		}
+	}
+}
+
+func TestCronRestart(t *testing.T) {
+	store := memoryCron{}
+	runs := 0
+	job := func() *CronJob {
+		j, err := NewCronJob("star", "@every 2h", func() { runs++ })
+		if err != nil {
+			t.Fatal(err)
+		}
+		return j
+	}
+	c := &Cron{jobs: make(map[string]*CronJob), Store: store}
+	now := date("2026-10-19 12:00")
+	c.add(job(), now)
+	c.Run(now.Add(time.Hour))
+	c.Run(now.Add(2 * time.Hour))
+	if runs != 1 || !store["star"].Equal(now.Add(2*time.Hour)) {
+		t.Fatalf("ran %d times, last at %v; want once at 14:00", runs, store["star"])
+	}
+
+	// Restarting must not run it again for the same slot, but a slot missed while down runs once.
+	c = &Cron{jobs: make(map[string]*CronJob), Store: store}
+	c.add(job(), now.Add(3*time.Hour))
+	c.Run(now.Add(3 * time.Hour))
+	if runs != 1 {
+		t.Fatal("ran again straight after restarting")
+	}
+	c = &Cron{jobs: make(map[string]*CronJob), Store: store}
+	c.add(job(), now.Add(9*time.Hour))
+	c.Run(now.Add(9 * time.Hour))
+	c.Run(now.Add(9*time.Hour + time.Minute))
+	if runs != 2 {
+		t.Fatalf("ran %d times, want the missed slots to run once", runs)
+	}
+
+	if !c.Trigger("star") || c.Trigger("moon") {
+		t.Fatal("triggered the wrong jobs")
+	}
+	c.Run(now.Add(9*time.Hour + 2*time.Minute))
+	if runs != 3 || !store["star"].Equal(now.Add(9*time.Hour)) {
+		t.Fatal("triggering did not run the job once, leaving its schedule alone")
+	}
+	if !c.Cancel("star") || len(c.Jobs()) != 0 {
+		t.Fatal("job was not cancelled")
+	}
+}
+
+func TestCronScripted(t *testing.T) {
+	c := NewCron()
+	scripted := func(names ...string) (jobs []*CronJob) {
+		for _, name := range names {
+			job, err := NewCronJob(name, "@hourly", func() {})
+			if err != nil {
+				t.Fatal(err)
+			}
+			jobs = append(jobs, job)
+		}
+		return
+	}
+	jobNames := func() (names []string) {
+		for _, job := range c.Jobs() {
+			names = append(names, job.Name)
+		}
+		return
+	}
+	if err := c.Schedule("save", "@hourly", func() {}); err != nil {
+		t.Fatal(err)
+	}
+	c.SwapScripted(scripted("save", "star"))
+	if jobs := c.Jobs(); len(jobs) != 2 || jobs[0].Scripted || !jobs[1].Scripted {
+		t.Fatalf("scripted jobs clashing with a built-in job got in: %v", jobNames())
+	}
+	c.SwapScripted(scripted("save", "star"))
+	if len(c.Jobs()) != 2 {
+		t.Fatalf("reloading lost the built-in job: %v", jobNames())
+	}
+
+	if !c.Cancel("star") {
+		t.Fatal("could not cancel a scripted job")
+	}
+	c.SwapScripted(scripted("star", "moon"))
+	if names := jobNames(); len(names) != 2 || names[0] != "moon" || names[1] != "save" {
+		t.Fatalf("reloading brought back a cancelled job: %v", names)
+	}
+	if !c.Resume("star") || c.Resume("star") || len(c.Jobs()) != 3 {
+		t.Fatalf("resuming did not bring back the cancelled job once: %v", jobNames())
+	}
+	c.Cancel("moon")
+	c.SwapScripted(scripted("star"))
+	c.SwapScripted(scripted("star", "moon"))
+	if len(c.Jobs()) != 3 {
+		t.Fatalf("a cancelled job the scripts dropped stayed cancelled once they bound it again: %v", jobNames())
+	}
+}
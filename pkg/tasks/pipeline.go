@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+package tasks
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spkaeros/rscgo/pkg/log"
+)
+
+//Phase A single named step of the game engines tick.  Phases of a pipeline always run one after the other, in the
+// order they were added, and a phase does not begin until the one before it has completely finished.  Whatever
+// work happens inside of a phase is free to run concurrently, so long as it is all finished by the time Run returns.
+type Phase struct {
+	Name string
+	Run  func()
+	PhaseStats
+}
+
+//PhaseStats Timing metrics collected about a Phase over the lifetime of the pipeline it belongs to.
+type PhaseStats struct {
+	//Last How long the phase took to run on the most recent tick
+	Last time.Duration
+	//Max The longest the phase has ever taken to run
+	Max time.Duration
+	//Total How long the phase has spent running, over all ticks
+	Total time.Duration
+	//Runs How many times the phase has been ran
+	Runs uint64
+}
+
+//Mean Returns the average time it has taken to run this phase.
+func (s PhaseStats) Mean() time.Duration {
+	if s.Runs == 0 {
+		return 0
+	}
+	return s.Total / time.Duration(s.Runs)
+}
+
+func (s *PhaseStats) record(d time.Duration) {
+	s.Last = d
+	s.Total += d
+	s.Runs++
+	if d > s.Max {
+		s.Max = d
+	}
+}
+
+//Pipeline An ordered set of named phases that together make up a single game engine tick.
+type Pipeline struct {
+	phases []*Phase
+	//Budget How long a tick may take before a warning is logged.  Zero disables the warning.
+	Budget time.Duration
+	overruns uint64
+	ticks    uint64
+	sync.RWMutex
+}
+
+//TickPhases The tick pipeline of the game engine.  The server populates this with its phases at startup.
+var TickPhases = &Pipeline{}
+
+//Add Appends a new phase named name to the end of the pipeline, which will call fn once every tick.
+func (p *Pipeline) Add(name string, fn func()) {
+	p.Lock()
+	defer p.Unlock()
+	p.phases = append(p.phases, &Phase{Name: name, Run: fn})
+}
+
+//Run Runs every phase of the pipeline to completion, in order, recording how long each of them took.
+// If the tick as a whole took longer than the pipelines Budget, logs a warning with a breakdown of where the time went.
+func (p *Pipeline) Run() {
+	p.RLock()
+	phases := p.phases
+	p.RUnlock()
+
+	times := make([]time.Duration, len(phases))
+	start := time.Now()
+	for i, phase := range phases {
+		phaseStart := time.Now()
+		phase.Run()
+		times[i] = time.Since(phaseStart)
+	}
+	elapsed := time.Since(start)
+
+	p.Lock()
+	defer p.Unlock()
+	p.ticks++
+	for i, phase := range phases {
+		phase.record(times[i])
+	}
+	if p.Budget > 0 && elapsed > p.Budget {
+		p.overruns++
+		var breakdown []string
+		for i, phase := range phases {
+			breakdown = append(breakdown, phase.Name+"="+times[i].String())
+		}
+		log.Warnf("Tick #%d took %v, which exceeds the %v tick budget! [%s]\n", p.ticks, elapsed, p.Budget, strings.Join(breakdown, ", "))
+	}
+}
+
+//Stats Returns the names of every phase in this pipeline, in order, along with a copy of their timing metrics.
+func (p *Pipeline) Stats() (names []string, stats []PhaseStats) {
+	p.RLock()
+	defer p.RUnlock()
+	for _, phase := range p.phases {
+		names = append(names, phase.Name)
+		stats = append(stats, phase.PhaseStats)
+	}
+	return
+}
+
+//Ticks Returns how many ticks this pipeline has ran.
+func (p *Pipeline) Ticks() uint64 {
+	p.RLock()
+	defer p.RUnlock()
+	return p.ticks
+}
+
+//Overruns Returns how many ticks have taken longer than the pipelines Budget to run.
+func (p *Pipeline) Overruns() uint64 {
+	p.RLock()
+	defer p.RUnlock()
+	return p.overruns
+}
@@ -14,6 +14,7 @@ import (
 	"context"
 	"sync"
 	"reflect"
+	"sort"
 	
 	`github.com/spkaeros/rscgo/pkg/config`
 	`github.com/spkaeros/rscgo/pkg/game/entity`
@@ -45,6 +46,12 @@ type (
 	playerArgStatusReturnCall = func(player entity.MobileEntity) bool
 )
 
+//ScriptContext Returns the context that script callbacks in a Scripts list are ran under, along with the function
+// that releases it once the call has returned.  The world package replaces this to hold callbacks to its script sandbox.
+var ScriptContext = func() (context.Context, context.CancelFunc) {
+	return context.WithCancel(context.Background())
+}
+
 //Task is a single func that takes no args and returns a bool to indicate whether or not it
 // should be removed from the set it belongs to upon completion
 type Task func() bool
@@ -196,12 +203,28 @@ func (s *Scripts) Tick() {
 
 func (s *Scripts) Call(v interface{}) {
 	wait := sync.WaitGroup{}
-	removing := make([]int, 0, len(s.scriptCalls))
+	var removing []int
+	removingLock := sync.Mutex{}
+	remove := func(i int) {
+		removingLock.Lock()
+		defer removingLock.Unlock()
+		removing = append(removing, i)
+	}
+	// callbacks are ran without holding the lock, as they may add more to the list, e.g a coroutine scheduling a timer
+	// while it is being woken up.  Anything added is only ever appended, so the indexes of these stay put.
 	s.RLock()
-	for i, script := range s.scriptCalls {
+	calls := s.scriptCalls[:len(s.scriptCalls):len(s.scriptCalls)]
+	s.RUnlock()
+	for i, script := range calls {
 		wait.Add(1)
-		go func(script scriptCall) {
+		go func(i int, script scriptCall) {
 			defer wait.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					remove(i)
+					log.Warn("Recovered from a panic in a script callback:", r)
+				}
+			}()
 			// Determine the type of our script callback
 			switch script.(type) {
 			// Simple function call, no input no input
@@ -213,25 +236,28 @@ func (s *Scripts) Call(v interface{}) {
 			// A function call returning its active status.
 			case StatusReturnCall:
 				if (script.(StatusReturnCall))() {
-					removing = append(removing, i)
+					remove(i)
 				}
 			// A function call taking a *world.Player as an argument and returning its active status.
 			case playerArgStatusReturnCall:
 				if (script.(playerArgStatusReturnCall))(v.(entity.MobileEntity)) {
-					removing = append(removing, i)
+					remove(i)
 				}
 			// A function call that returns two values, the first a result value, and the second an error value
 			// Upon non-nil error value, it will log the stringified err struct then remove from active list,
 			// otherwise schedules the same call to run again next tick.
 			case dualReturnCall:
-				ret, callErr := (script.(dualReturnCall))(context.Background())
+				ctx, cancel := ScriptContext()
+				defer cancel()
+				ret, callErr := (script.(dualReturnCall))(ctx)
 				if !callErr.IsNil() {
+					remove(i)
 					log.Warn("Error retVal from a dualReturnCall in the Anko ctx:", callErr.Elem())
 					return
 				}
 				if v, ok := ret.Interface().(bool); ok && v {
 					log.Debugf("%v, %v\n", v, callErr)
-					removing = append(removing, i)
+					remove(i)
 					return
 				}
 			// A function call that returns two values, the first a result value, and the second an error value
@@ -239,30 +265,30 @@ func (s *Scripts) Call(v interface{}) {
 			// Upon non-nil error value, it will log the stringified err struct then remove from active list,
 			// otherwise schedules the same call to run again next tick.
 			case singleArgDualReturnCall:
-				ret, callErr := (script.(singleArgDualReturnCall))(context.Background(), reflect.ValueOf(v))
+				ctx, cancel := ScriptContext()
+				defer cancel()
+				ret, callErr := (script.(singleArgDualReturnCall))(ctx, reflect.ValueOf(v))
 				if !callErr.IsNil() {
-					removing = append(removing, i)
+					remove(i)
 					log.Warn("Error retVal from a singleArgDualReturnCall in the Anko ctx:", callErr.String())
 					return
 				}
 				if v, ok := ret.Interface().(bool); ok && v {
-					removing = append(removing, i)
+					remove(i)
 					log.Debug(ret)
 					return
 				}
 			default: return
 			}
-		}(script)
+		}(i, script)
 	}
-	s.RUnlock()
 	wait.Wait()
 	s.Lock()
 	defer s.Unlock()
+	// Remove from the back to the front, so that the indexes yet to be removed stay valid
+	sort.Sort(sort.Reverse(sort.IntSlice(removing)))
 	for _, v := range removing {
-		s.scriptCalls = s.scriptCalls[:v]
-		if v < len(s.scriptCalls)-1 {
-			s.scriptCalls = append(s.scriptCalls[:v], s.scriptCalls[v+1:])
-		}
+		s.scriptCalls = append(s.scriptCalls[:v], s.scriptCalls[v+1:]...)
 	}
 }
 
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2020 Zachariah Knight <aeros.storkpk@gmail.com>
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted, provided that the above copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ *
+ */
+
+package main
+
+import (
+	"sync"
+	"time"
+
+	"github.com/spkaeros/rscgo/pkg/game"
+	"github.com/spkaeros/rscgo/pkg/game/net"
+	"github.com/spkaeros/rscgo/pkg/game/world"
+	"github.com/spkaeros/rscgo/pkg/tasks"
+)
+
+//tick The state shared between the phases of a single game engine tick.
+type tick struct {
+	// players is the set of players this tick is processing, taken at the start of the tick.
+	// Anybody that logs in part way through a tick will be picked up on the next one.
+	players []*world.Player
+	// outgoing holds the update packets generated for each player during the visibility phase
+	outgoing map[*world.Player][]*net.Packet
+	// frames holds the wire-ready update data for each player, generated during the encode phase
+	frames map[*world.Player][]byte
+	sync.Mutex
+}
+
+//parallel Calls fn for every player in this tick concurrently, and waits for them all to return.
+func (t *tick) parallel(fn func(*world.Player)) {
+	wait := sync.WaitGroup{}
+	for _, p := range t.players {
+		wait.Add(1)
+		go func(p *world.Player) {
+			defer wait.Done()
+			fn(p)
+		}(p)
+	}
+	wait.Wait()
+}
+
+//serial Calls fn for every player in this tick, one at a time, in server index order.
+func (t *tick) serial(fn func(*world.Player)) {
+	for _, p := range t.players {
+		fn(p)
+	}
+}
+
+//addPhases Populates the pipeline with the phases that make up a game engine tick.
+//
+// input: any reloaded scripts are swapped in, then every player's queued incoming packets are handled.  Players are handled in parallel, each players
+// packets in the order they arrived.
+//
+// logic: events queued up during the last tick, e.g players changing region, then scheduled tasks, which wake up any script coroutines whose wait is over and let them run until
+// they park again, and any calendar jobs that are due, then each players tickables and tick action.  Players are processed one at a time, in index
+// order, as actions tend to reach out and touch other players and NPCs.
+//
+// movement: every player and NPC takes their next step, in parallel.
+//
+// visibility: the view-area of every player is synchronized with the world, generating update packets, in parallel.
+//
+// encode: each players update packets are framed for the wire, in parallel.
+//
+// flush: each players framed updates are written out to the client in parallel, then everything meant to run at the
+// end of the tick is ran and per-tick state is reset.
+func (s *Server) addPhases(pipeline *tasks.Pipeline) {
+	t := &tick{}
+	pipeline.Add("input", func() {
+		world.SwapScripts()
+		t.players = world.Players.List()
+		t.outgoing = make(map[*world.Player][]*net.Packet, len(t.players))
+		t.frames = make(map[*world.Player][]byte, len(t.players))
+		t.parallel(s.handlePackets)
+	})
+	pipeline.Add("logic", func() {
+		world.EmitQueued()
+		tasks.TickList.Tick()
+		tasks.Crontab.Run(time.Now())
+		t.serial(func(p *world.Player) {
+			p.TickSkull()
+			p.Tickables.Call(interface{}(p))
+			if fn := p.TickAction(); fn != nil && !fn() {
+				p.ResetTickAction()
+			}
+		})
+	})
+	pipeline.Add("movement", func() {
+		t.parallel(func(p *world.Player) {
+			p.TraversePath()
+		})
+		world.UpdateNPCPositions()
+	})
+	pipeline.Add("visibility", func() {
+		t.parallel(func(p *world.Player) {
+			var updates []*net.Packet
+			for _, build := range []func(*world.Player) *net.Packet{world.PlayerPositions, world.PlayerAppearances,
+				world.NPCPositions, world.NpcEvents, world.ObjectLocations, world.BoundaryLocations,
+				world.ItemLocations, world.ClearDistantChunks} {
+				if update := build(p); update != nil {
+					updates = append(updates, update)
+				}
+			}
+			t.Lock()
+			defer t.Unlock()
+			t.outgoing[p] = updates
+		})
+	})
+	pipeline.Add("encode", func() {
+		t.parallel(func(p *world.Player) {
+			t.Lock()
+			updates := t.outgoing[p]
+			t.Unlock()
+			var frames []byte
+			for _, update := range updates {
+				frames = append(frames, update.Frame()...)
+			}
+			t.Lock()
+			defer t.Unlock()
+			t.frames[p] = frames
+		})
+	})
+	pipeline.Add("flush", func() {
+		t.parallel(func(p *world.Player) {
+			t.Lock()
+			frames := t.frames[p]
+			t.Unlock()
+			if len(frames) > 0 && p.Connected() {
+				p.WriteFrames(frames)
+			}
+		})
+		t.serial(func(p *world.Player) {
+			p.PostTickables.Call(interface{}(p))
+			p.ResetRegionRemoved()
+			p.ResetRegionMoved()
+			p.ResetSpriteUpdated()
+			p.ResetAppearanceChanged()
+		})
+		world.ResetNpcUpdateFlags()
+		world.Ticks.Inc()
+	})
+}
+
+//handlePackets Handles every packet that the player has sent us since the last time this was called, in the order
+// they arrived.
+func (s *Server) handlePackets(p *world.Player) {
+	for {
+		select {
+		case p1, ok := <-p.InQueue:
+			if !ok || p1 == nil {
+				return
+			}
+			if p.HandleInput(p1) {
+				continue
+			}
+			if handlePacket := game.Handler(p1.Opcode); handlePacket != nil {
+				handlePacket(p, p1)
+			}
+		default:
+			return
+		}
+	}
+}
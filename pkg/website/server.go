@@ -89,7 +89,7 @@ func render(w http.ResponseWriter, r *http.Request) {
 		// Return a 404 if the template doesn't exist or the request is for a directory
 		info, err := os.Stat(file)
 		if (err != nil && os.IsNotExist(err)) || info.IsDir() {
-			log.Warning.Printf("Website page: '%s' could not be served as HTML: (File exists:%b; File is directory:%b)\n", file, os.IsNotExist(err), !os.IsNotExist(err))
+			log.Warning.Printf("Website page: '%s' could not be served as HTML: (File exists:%v; File is directory:%v)\n", file, os.IsNotExist(err), !os.IsNotExist(err))
 			log.Error.Println(err)
 			http.NotFound(w, r)
 			return